@@ -109,10 +109,12 @@ import (
 	_ "github.com/ArableProtocol/acrechain/client/docs/statik"
 
 	"github.com/ArableProtocol/acrechain/app/ante"
+	acretransfer "github.com/ArableProtocol/acrechain/ibc/transfer"
 	"github.com/ArableProtocol/acrechain/x/erc20"
 	erc20client "github.com/ArableProtocol/acrechain/x/erc20/client"
 	erc20keeper "github.com/ArableProtocol/acrechain/x/erc20/keeper"
 	erc20types "github.com/ArableProtocol/acrechain/x/erc20/types"
+	mintclient "github.com/ArableProtocol/acrechain/x/mint/client"
 )
 
 func init() {
@@ -149,6 +151,13 @@ var (
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
 			ibcclientclient.UpdateClientProposalHandler, ibcclientclient.UpgradeProposalHandler,
 			erc20client.RegisterCoinProposalHandler, erc20client.RegisterERC20ProposalHandler, erc20client.ToggleTokenConversionProposalHandler,
+			erc20client.RevokeERC20RegistrationProposalHandler, erc20client.UpdateConversionLimitsProposalHandler,
+			erc20client.UpdateTokenPairMetadataProposalHandler,
+			erc20client.DeregisterTokenPairProposalHandler,
+			mintclient.UpdateParamsProposalHandler,
+			mintclient.CancelParamsChangeProposalHandler,
+			mintclient.RegisterIncentiveStreamProposalHandler,
+			mintclient.CancelIncentiveStreamProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -166,15 +175,16 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
-		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
-		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner},
+		authtypes.FeeCollectorName:               nil,
+		distrtypes.ModuleName:                    nil,
+		minttypes.ModuleName:                     {authtypes.Minter, authtypes.Burner},
+		minttypes.DeveloperVestingModuleAcctName: nil,
+		stakingtypes.BondedPoolName:              {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:           {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:                      {authtypes.Burner},
+		ibctransfertypes.ModuleName:              {authtypes.Minter, authtypes.Burner},
+		evmtypes.ModuleName:                      {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		erc20types.ModuleName:                    {authtypes.Minter, authtypes.Burner},
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -335,15 +345,21 @@ func NewAcreChain(
 		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, authtypes.FeeCollectorName, app.ModuleAccountAddrs(),
 	)
-	app.MintKeeper = mintkeeper.NewKeeper(
+	mintKeeper := mintkeeper.NewKeeper(
 		appCodec,
 		keys[minttypes.StoreKey],
 		app.GetSubspace(minttypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
+		&stakingKeeper,
 		app.DistrKeeper,
 		authtypes.FeeCollectorName,
 	)
+	app.MintKeeper = *mintKeeper.SetHooks(
+		minttypes.NewMultiMintHooks(
+			mintKeeper.FeeBurnHooks(),
+		),
+	)
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
 		appCodec, keys[slashingtypes.StoreKey], &stakingKeeper, app.GetSubspace(slashingtypes.ModuleName),
 	)
@@ -380,7 +396,8 @@ func NewAcreChain(
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
-		AddRoute(erc20types.RouterKey, erc20.NewErc20ProposalHandler(&app.Erc20Keeper))
+		AddRoute(erc20types.RouterKey, erc20.NewErc20ProposalHandler(&app.Erc20Keeper)).
+		AddRoute(minttypes.RouterKey, mint.NewMintProposalHandler(app.MintKeeper))
 
 	govKeeper := govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName),
@@ -399,7 +416,7 @@ func NewAcreChain(
 
 	app.Erc20Keeper = erc20keeper.NewKeeper(
 		keys[erc20types.StoreKey], appCodec, app.GetSubspace(erc20types.ModuleName),
-		app.AccountKeeper, app.BankKeeper, app.EvmKeeper,
+		app.AccountKeeper, app.BankKeeper, app.EvmKeeper, app.DistrKeeper,
 	)
 
 	app.GovKeeper = *govKeeper.SetHooks(
@@ -418,7 +435,7 @@ func NewAcreChain(
 	// transferKeeper.SendPacket -> claim.SendPacket -> recovery.SendPacket -> channel.SendPacket
 
 	// RecvPacket, message that originates from core IBC and goes down to app, the flow is the otherway
-	// channel.RecvPacket -> recovery.OnRecvPacket -> claim.OnRecvPacket -> transfer.OnRecvPacket
+	// channel.RecvPacket -> erc20.OnRecvPacket -> transfer.OnRecvPacket
 
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec,
@@ -432,17 +449,19 @@ func NewAcreChain(
 		scopedTransferKeeper,
 	)
 
-	transferModule := transfer.NewAppModule(app.TransferKeeper)
+	// MsgTransfer is served by a wrapper converting the sender's ERC20 tokens when
+	// their bank balance doesn't cover the transfer
+	transferModule := acretransfer.NewAppModule(acretransfer.NewKeeper(app.TransferKeeper, app.Erc20Keeper))
 
 	// transfer stack contains (from top to bottom):
-	// - Recovery Middleware
-	// - Airdrop Claims Middleware
+	// - ERC20 Middleware
 	// - Transfer
 
 	// create IBC module from bottom to top of stack
 	var transferStack porttypes.IBCModule
 
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
+	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
@@ -507,6 +526,8 @@ func NewAcreChain(
 		// Note: epochs' begin should be "real" start of epochs, we keep epochs beginblock at the beginning
 		feemarkettypes.ModuleName,
 		evmtypes.ModuleName,
+		// NOTE: mint must run before distribution, so that the staking rewards minted in
+		// BeginBlock are distributed in the same block
 		minttypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
@@ -897,5 +918,15 @@ func initParamsKeeper(
 	return paramsKeeper
 }
 
+// UpgradeName is the name of the upgrade that runs the store migrations of the mint
+// and erc20 modules.
+const UpgradeName = "v1.1.0"
+
 func (app *AcreApp) setupUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(
+		UpgradeName,
+		func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		},
+	)
 }
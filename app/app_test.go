@@ -8,12 +8,17 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/evmos/ethermint/encoding"
+
+	erc20types "github.com/ArableProtocol/acrechain/x/erc20/types"
+	minttypes "github.com/ArableProtocol/acrechain/x/mint/types"
 )
 
 func TestAcreChainExport(t *testing.T) {
@@ -39,3 +44,32 @@ func TestAcreChainExport(t *testing.T) {
 	_, err = app2.ExportAppStateAndValidators(false, []string{})
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
+
+func TestAcreChainUpgradeHandler(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewAcreChain(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, encoding.MakeConfig(ModuleBasics), simapp.EmptyAppOptions{})
+
+	genesisState := NewDefaultGenesisState()
+	stateBytes, err := json.MarshalIndent(genesisState, "", "  ")
+	require.NoError(t, err)
+
+	app.InitChain(
+		abci.RequestInitChain{
+			ChainId:       "bamboo_9051-2",
+			Validators:    []abci.ValidatorUpdate{},
+			AppStateBytes: stateBytes,
+		},
+	)
+	app.Commit()
+	require.True(t, app.UpgradeKeeper.HasHandler(UpgradeName))
+
+	// the upgrade migrates the modules from the versions stored before it
+	ctx := app.BaseApp.NewUncachedContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+	fromVM := app.mm.GetVersionMap()
+	fromVM[minttypes.ModuleName] = 1
+	fromVM[erc20types.ModuleName] = 2
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, fromVM)
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: UpgradeName, Height: ctx.BlockHeight()})
+	require.Equal(t, app.mm.GetVersionMap(), app.UpgradeKeeper.GetModuleVersionMap(ctx))
+}
@@ -0,0 +1,59 @@
+package transfer
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transferkeeper "github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+var _ transfertypes.MsgServer = Keeper{}
+
+// ERC20Keeper defines the expected ERC20 keeper used to convert the tokens
+// paired with a transferred coin before the transfer.
+type ERC20Keeper interface {
+	ConvertERC20Shortfall(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) (bool, error)
+}
+
+// Keeper wraps the ICS-20 transfer keeper, so that the ERC20 tokens paired
+// with a transferred coin are converted when the sender's bank balance
+// doesn't cover the transfer.
+type Keeper struct {
+	transferkeeper.Keeper
+	erc20Keeper ERC20Keeper
+}
+
+// NewKeeper creates a new transfer Keeper wrapping the ICS-20 transfer keeper.
+func NewKeeper(transferKeeper transferkeeper.Keeper, erc20Keeper ERC20Keeper) Keeper {
+	return Keeper{
+		Keeper:      transferKeeper,
+		erc20Keeper: erc20Keeper,
+	}
+}
+
+// Transfer defines a rpc handler method for MsgTransfer. It converts the
+// sender's shortfall of the transferred coin from its ERC20 representation
+// before escrowing or burning the coin. No coin is transferred when the
+// conversion trips the circuit breaker.
+func (k Keeper) Transfer(goCtx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := k.erc20Keeper.ConvertERC20Shortfall(ctx, sender, msg.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: the conversion disabled or removed the token pair, the transfer is
+	// not attempted. Return a nil error so that the token pair changes persist.
+	if !ok {
+		return nil, nil
+	}
+
+	return k.Keeper.Transfer(goCtx, msg)
+}
@@ -0,0 +1,68 @@
+package transfer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transferkeeper "github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+var _ ERC20Keeper = &MockERC20Keeper{}
+
+// MockERC20Keeper defines a mocked object that implements the ERC20Keeper
+// interface.
+type MockERC20Keeper struct {
+	mock.Mock
+}
+
+func (m *MockERC20Keeper) ConvertERC20Shortfall(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) (bool, error) {
+	args := m.Called(sender, coin)
+	return args.Bool(0), args.Error(1)
+}
+
+func TestTransferConversionFailure(t *testing.T) {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	sender := sdk.AccAddress([]byte("transfer_sender_____"))
+	coin := sdk.NewInt64Coin("aacre", 100)
+
+	erc20Keeper := &MockERC20Keeper{}
+	erc20Keeper.On("ConvertERC20Shortfall", sender, coin).Return(false, errors.New("conversion failed"))
+	k := NewKeeper(transferkeeper.Keeper{}, erc20Keeper)
+
+	msg := transfertypes.NewMsgTransfer(transfertypes.PortID, "channel-0", coin, sender.String(), "receiver", clienttypes.NewHeight(0, 100), 0)
+	_, err := k.Transfer(sdk.WrapSDKContext(ctx), msg)
+	require.EqualError(t, err, "conversion failed")
+	erc20Keeper.AssertExpectations(t)
+
+	// the conversion is not attempted for an invalid sender
+	msg.Sender = "invalid"
+	_, err = k.Transfer(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+	erc20Keeper.AssertNumberOfCalls(t, "ConvertERC20Shortfall", 1)
+}
+
+func TestTransferConversionHalted(t *testing.T) {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	sender := sdk.AccAddress([]byte("transfer_sender_____"))
+	coin := sdk.NewInt64Coin("aacre", 100)
+
+	// the conversion tripped the circuit breaker, the transfer is not
+	// attempted but the tx doesn't fail so that the disabled pair persists
+	erc20Keeper := &MockERC20Keeper{}
+	erc20Keeper.On("ConvertERC20Shortfall", sender, coin).Return(false, nil)
+	k := NewKeeper(transferkeeper.Keeper{}, erc20Keeper)
+
+	msg := transfertypes.NewMsgTransfer(transfertypes.PortID, "channel-0", coin, sender.String(), "receiver", clienttypes.NewHeight(0, 100), 0)
+	res, err := k.Transfer(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Nil(t, res)
+	erc20Keeper.AssertExpectations(t)
+}
@@ -0,0 +1,39 @@
+package transfer
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	ibctransfer "github.com/cosmos/ibc-go/v3/modules/apps/transfer"
+	transferkeeper "github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+var _ module.AppModule = AppModule{}
+
+// AppModule is the ICS-20 transfer AppModule, with MsgTransfer served by the
+// wrapping Keeper.
+type AppModule struct {
+	ibctransfer.AppModule
+	keeper Keeper
+}
+
+// NewAppModule creates a new transfer AppModule given the wrapping Keeper.
+func NewAppModule(k Keeper) AppModule {
+	return AppModule{
+		AppModule: ibctransfer.NewAppModule(k.Keeper),
+		keeper:    k,
+	}
+}
+
+// RegisterServices registers the module's services, with the MsgServer of the
+// wrapping Keeper.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	transfertypes.RegisterMsgServer(cfg.MsgServer(), am.keeper)
+	transfertypes.RegisterQueryServer(cfg.QueryServer(), am.keeper.Keeper)
+
+	m := transferkeeper.NewMigrator(am.keeper.Keeper)
+	if err := cfg.RegisterMigration(transfertypes.ModuleName, 1, m.MigrateTraces); err != nil {
+		panic(fmt.Sprintf("failed to migrate transfer app from version 1 to 2: %v", err))
+	}
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
@@ -20,6 +22,12 @@ import (
 	"github.com/ArableProtocol/acrechain/x/erc20/types"
 )
 
+// flags of the ERC20 registration commands
+const (
+	FlagTokenBehavior = "behavior"
+	FlagProbeHolder   = "probe-holder"
+)
+
 // NewTxCmd returns a root CLI command handler for erc20 transaction commands
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -33,6 +41,9 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewConvertCoinCmd(),
 		NewConvertERC20Cmd(),
+		NewRegisterERC20Cmd(),
+		NewConvertCoinBatchCmd(),
+		NewConvertERC20BatchCmd(),
 	)
 	return txCmd
 }
@@ -135,6 +146,159 @@ func NewConvertERC20Cmd() *cobra.Command {
 	return cmd
 }
 
+// NewConvertCoinBatchCmd returns a CLI command handler for converting several
+// Cosmos coins
+func NewConvertCoinBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-coin-batch [conversions-file] [receiver_hex]",
+		Short: "Convert several Cosmos coins to ERC20, either all or none of them. When the receiver [optional] is omitted, the ERC20 tokens are transferred to the sender.",
+		Long: `Convert several Cosmos coins to ERC20, either all or none of them. The conversions file contains the JSON list of the coins to convert:
+
+[
+  {"denom": "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", "amount": "1000000"},
+  {"denom": "erc20/0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd", "amount": "2500"}
+]`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := ParseCoinConversions(args[0])
+			if err != nil {
+				return err
+			}
+
+			var receiver string
+			sender := cliCtx.GetFromAddress()
+
+			if len(args) == 2 {
+				receiver = args[1]
+				if err := ethermint.ValidateAddress(receiver); err != nil {
+					return fmt.Errorf("invalid receiver hex address %w", err)
+				}
+			} else {
+				receiver = common.BytesToAddress(sender).Hex()
+			}
+
+			msg := &types.MsgConvertCoinBatch{
+				Coins:    coins,
+				Receiver: receiver,
+				Sender:   sender.String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertERC20BatchCmd returns a CLI command handler for converting several
+// ERC20 tokens
+func NewConvertERC20BatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-erc20-batch [conversions-file] [receiver]",
+		Short: "Convert several ERC20 tokens to Cosmos coins, either all or none of them. When the receiver [optional] is omitted, the Cosmos coins are transferred to the sender.",
+		Long: `Convert several ERC20 tokens to Cosmos coins, either all or none of them. The conversions file contains the JSON list of the tokens to convert:
+
+[
+  {"contract_address": "0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd", "amount": "2500"},
+  {"contract_address": "0xdAC17F958D2ee523a2206206994597C13D831ec7", "amount": "1000000"}
+]`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokens, err := ParseERC20Conversions(args[0])
+			if err != nil {
+				return err
+			}
+
+			from := common.BytesToAddress(cliCtx.GetFromAddress().Bytes())
+
+			receiver := cliCtx.GetFromAddress()
+			if len(args) == 2 {
+				receiver, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := &types.MsgConvertERC20Batch{
+				Tokens:   tokens,
+				Receiver: receiver.String(),
+				Sender:   from.Hex(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRegisterERC20Cmd returns a CLI command handler for registering an ERC20
+// token pair in exchange for the registration deposit
+func NewRegisterERC20Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-erc20 [contract-address]",
+		Short: "Register a token pair for an ERC20 token with an allowed code hash, paying the registration deposit. The token pair can be revoked by governance during its review period.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := ethermint.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			behaviorStr, err := cmd.Flags().GetString(FlagTokenBehavior)
+			if err != nil {
+				return err
+			}
+
+			behavior, err := ParseTokenBehavior(behaviorStr)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRegisterERC20WithDeposit{
+				Sender:       cliCtx.GetFromAddress().String(),
+				Erc20Address: contract,
+				Behavior:     behavior,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagTokenBehavior, "standard", "transfer behavior of the ERC20 token (standard|fee-on-transfer)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewRegisterCoinProposalCmd implements the command to submit a community-pool-spend proposal
 func NewRegisterCoinProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -264,9 +428,24 @@ func NewRegisterERC20ProposalCmd() *cobra.Command {
 				return err
 			}
 
+			behaviorStr, err := cmd.Flags().GetString(FlagTokenBehavior)
+			if err != nil {
+				return err
+			}
+
+			behavior, err := ParseTokenBehavior(behaviorStr)
+			if err != nil {
+				return err
+			}
+
+			probeHolder, err := cmd.Flags().GetString(FlagProbeHolder)
+			if err != nil {
+				return err
+			}
+
 			erc20Addr := args[0]
 			from := clientCtx.GetFromAddress()
-			content := types.NewRegisterERC20Proposal(title, description, erc20Addr)
+			content := types.NewRegisterERC20Proposal(title, description, erc20Addr, behavior, probeHolder)
 
 			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
 			if err != nil {
@@ -284,6 +463,8 @@ func NewRegisterERC20ProposalCmd() *cobra.Command {
 	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
 	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	cmd.Flags().String(FlagTokenBehavior, "standard", "transfer behavior of the ERC20 token (standard|fee-on-transfer)")
+	cmd.Flags().String(FlagProbeHolder, "", "hex address of a token holder whose balance probes the transfer behavior of the token")
 	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
 		panic(err)
 	}
@@ -293,6 +474,9 @@ func NewRegisterERC20ProposalCmd() *cobra.Command {
 	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
 		panic(err)
 	}
+	if err := cmd.MarkFlagRequired(FlagProbeHolder); err != nil {
+		panic(err)
+	}
 	return cmd
 }
 
@@ -361,3 +545,298 @@ func NewToggleTokenConversionProposalCmd() *cobra.Command {
 	}
 	return cmd
 }
+
+// NewRevokeERC20RegistrationProposalCmd implements the command to submit a revoke-erc20-registration proposal
+func NewRevokeERC20RegistrationProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "revoke-erc20-registration [erc20-address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to revoke a token pair pending review",
+		Long:    "Submit a proposal to disable the conversions of a token pair registered without governance while it is pending review, along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal revoke-erc20-registration <contract_address> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			erc20Addr := args[0]
+			content := types.NewRevokeERC20RegistrationProposal(title, description, erc20Addr)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewUpdateConversionLimitsProposalCmd implements the command to submit an update-conversion-limits proposal
+func NewUpdateConversionLimitsProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-conversion-limits [erc20-address] [max-per-conversion] [max-per-window] [max-per-address] [window]",
+		Args:    cobra.ExactArgs(5),
+		Short:   "Submit a proposal to update the conversion rate limits of a token pair",
+		Long:    "Submit a proposal to update the conversion rate limits of a token pair, along with an initial deposit. A zero limit is not enforced, and the limits are removed when all of them are zero.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal update-conversion-limits <contract_address> 1000000 10000000 2000000 24h --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			if err := ethermint.ValidateAddress(args[0]); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			amounts := make([]sdk.Int, 3)
+			for i, arg := range args[1:4] {
+				amount, ok := sdk.NewIntFromString(arg)
+				if !ok {
+					return fmt.Errorf("invalid conversion limit %s", arg)
+				}
+				amounts[i] = amount
+			}
+
+			window, err := time.ParseDuration(args[4])
+			if err != nil {
+				return err
+			}
+
+			limits := types.NewConversionLimits(common.HexToAddress(args[0]), amounts[0], amounts[1], amounts[2], window)
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewUpdateConversionLimitsProposal(title, description, limits)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewUpdateTokenPairMetadataProposalCmd implements the command to submit an update-token-pair-metadata proposal
+func NewUpdateTokenPairMetadataProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-token-pair-metadata [token] [metadata]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Submit a proposal to refresh the coin metadata of a token pair",
+		Long: `Submit a proposal to refresh the coin metadata of a token pair after a rebrand, along with an initial deposit.
+The metadata of a token pair for an ERC20 token is read again from its contract. The new metadata of a token pair for a native Cosmos coin must be supplied via a JSON file, and its name and symbol are also set on the ERC20 contract.`,
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal update-token-pair-metadata <denom> <path/to/metadata.json> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			var metadata banktypes.Metadata
+			if len(args) == 2 {
+				metadata, err = ParseMetadata(clientCtx.Codec, args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewUpdateTokenPairMetadataProposal(title, description, args[0], metadata)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewDeregisterTokenPairProposalCmd implements the command to submit a deregister-token-pair proposal
+func NewDeregisterTokenPairProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deregister-token-pair [token] [withdrawal-period]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a deregister token pair proposal",
+		Long:    "Submit a proposal to deregister a token pair along with an initial deposit. The token pair only allows conversions back to its native representation during the withdrawal period, after which it is removed.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal deregister-token-pair <denom_or_contract> 336h --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			withdrawalPeriod, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewDeregisterTokenPairProposal(title, description, args[0], withdrawalPeriod)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
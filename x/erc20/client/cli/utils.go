@@ -1,11 +1,16 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ArableProtocol/acrechain/x/erc20/types"
 )
 
 // ParseRegisterCoinProposal reads and parses a ParseRegisterCoinProposal from a file.
@@ -23,3 +28,50 @@ func ParseMetadata(cdc codec.JSONCodec, metadataFile string) (banktypes.Metadata
 
 	return metadata, nil
 }
+
+// ParseCoinConversions reads and parses the coins of a MsgConvertCoinBatch from
+// a JSON file.
+func ParseCoinConversions(conversionsFile string) (sdk.Coins, error) {
+	coins := sdk.Coins{}
+
+	contents, err := os.ReadFile(filepath.Clean(conversionsFile))
+	if err != nil {
+		return coins, err
+	}
+
+	if err = json.Unmarshal(contents, &coins); err != nil {
+		return coins, err
+	}
+
+	return coins.Sort(), nil
+}
+
+// ParseERC20Conversions reads and parses the tokens of a MsgConvertERC20Batch
+// from a JSON file.
+func ParseERC20Conversions(conversionsFile string) ([]types.ERC20Amount, error) {
+	tokens := []types.ERC20Amount{}
+
+	contents, err := os.ReadFile(filepath.Clean(conversionsFile))
+	if err != nil {
+		return tokens, err
+	}
+
+	if err = json.Unmarshal(contents, &tokens); err != nil {
+		return tokens, err
+	}
+
+	return tokens, nil
+}
+
+// ParseTokenBehavior parses the transfer behavior of an ERC20 token. Rebasing
+// tokens cannot be registered.
+func ParseTokenBehavior(behavior string) (types.TokenBehavior, error) {
+	switch behavior {
+	case "standard":
+		return types.TOKEN_BEHAVIOR_STANDARD, nil
+	case "fee-on-transfer":
+		return types.TOKEN_BEHAVIOR_FEE_ON_TRANSFER, nil
+	default:
+		return types.TOKEN_BEHAVIOR_STANDARD, fmt.Errorf("invalid token behavior '%s', expected standard or fee-on-transfer", behavior)
+	}
+}
@@ -8,7 +8,11 @@ import (
 )
 
 var (
-	RegisterCoinProposalHandler          = govclient.NewProposalHandler(cli.NewRegisterCoinProposalCmd, rest.RegisterCoinProposalRESTHandler)
-	RegisterERC20ProposalHandler         = govclient.NewProposalHandler(cli.NewRegisterERC20ProposalCmd, rest.RegisterERC20ProposalRESTHandler)
-	ToggleTokenConversionProposalHandler = govclient.NewProposalHandler(cli.NewToggleTokenConversionProposalCmd, rest.ToggleTokenConversionRESTHandler)
+	RegisterCoinProposalHandler            = govclient.NewProposalHandler(cli.NewRegisterCoinProposalCmd, rest.RegisterCoinProposalRESTHandler)
+	RegisterERC20ProposalHandler           = govclient.NewProposalHandler(cli.NewRegisterERC20ProposalCmd, rest.RegisterERC20ProposalRESTHandler)
+	ToggleTokenConversionProposalHandler   = govclient.NewProposalHandler(cli.NewToggleTokenConversionProposalCmd, rest.ToggleTokenConversionRESTHandler)
+	RevokeERC20RegistrationProposalHandler = govclient.NewProposalHandler(cli.NewRevokeERC20RegistrationProposalCmd, rest.RevokeERC20RegistrationRESTHandler)
+	UpdateConversionLimitsProposalHandler  = govclient.NewProposalHandler(cli.NewUpdateConversionLimitsProposalCmd, rest.UpdateConversionLimitsRESTHandler)
+	UpdateTokenPairMetadataProposalHandler = govclient.NewProposalHandler(cli.NewUpdateTokenPairMetadataProposalCmd, rest.UpdateTokenPairMetadataRESTHandler)
+	DeregisterTokenPairProposalHandler     = govclient.NewProposalHandler(cli.NewDeregisterTokenPairProposalCmd, rest.DeregisterTokenPairRESTHandler)
 )
@@ -2,6 +2,7 @@ package rest
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -25,11 +26,13 @@ type RegisterCoinProposalRequest struct {
 
 // RegisterERC20ProposalRequest defines a request for a new register ERC20 proposal.
 type RegisterERC20ProposalRequest struct {
-	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
-	Title        string       `json:"title" yaml:"title"`
-	Description  string       `json:"description" yaml:"description"`
-	Deposit      sdk.Coins    `json:"deposit" yaml:"deposit"`
-	ERC20Address string       `json:"erc20_address" yaml:"erc20_address"`
+	BaseReq      rest.BaseReq        `json:"base_req" yaml:"base_req"`
+	Title        string              `json:"title" yaml:"title"`
+	Description  string              `json:"description" yaml:"description"`
+	Deposit      sdk.Coins           `json:"deposit" yaml:"deposit"`
+	ERC20Address string              `json:"erc20_address" yaml:"erc20_address"`
+	Behavior     types.TokenBehavior `json:"behavior" yaml:"behavior"`
+	ProbeHolder  string              `json:"probe_holder" yaml:"probe_holder"`
 }
 
 // ToggleTokenConversionProposalRequest defines a request for a toggle token conversion proposal.
@@ -41,6 +44,44 @@ type ToggleTokenConversionProposalRequest struct {
 	Token       string       `json:"token" yaml:"token"`
 }
 
+// RevokeERC20RegistrationProposalRequest defines a request for a new revoke ERC20 registration proposal.
+type RevokeERC20RegistrationProposalRequest struct {
+	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title        string       `json:"title" yaml:"title"`
+	Description  string       `json:"description" yaml:"description"`
+	Deposit      sdk.Coins    `json:"deposit" yaml:"deposit"`
+	ERC20Address string       `json:"erc20_address" yaml:"erc20_address"`
+}
+
+// UpdateConversionLimitsProposalRequest defines a request for a new update conversion limits proposal.
+type UpdateConversionLimitsProposalRequest struct {
+	BaseReq     rest.BaseReq           `json:"base_req" yaml:"base_req"`
+	Title       string                 `json:"title" yaml:"title"`
+	Description string                 `json:"description" yaml:"description"`
+	Deposit     sdk.Coins              `json:"deposit" yaml:"deposit"`
+	Limits      types.ConversionLimits `json:"limits" yaml:"limits"`
+}
+
+// UpdateTokenPairMetadataProposalRequest defines a request for a new update token pair metadata proposal.
+type UpdateTokenPairMetadataProposalRequest struct {
+	BaseReq     rest.BaseReq       `json:"base_req" yaml:"base_req"`
+	Title       string             `json:"title" yaml:"title"`
+	Description string             `json:"description" yaml:"description"`
+	Deposit     sdk.Coins          `json:"deposit" yaml:"deposit"`
+	Token       string             `json:"token" yaml:"token"`
+	Metadata    banktypes.Metadata `json:"metadata" yaml:"metadata"`
+}
+
+// DeregisterTokenPairProposalRequest defines a request for a new deregister token pair proposal.
+type DeregisterTokenPairProposalRequest struct {
+	BaseReq          rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title            string       `json:"title" yaml:"title"`
+	Description      string       `json:"description" yaml:"description"`
+	Deposit          sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Token            string       `json:"token" yaml:"token"`
+	WithdrawalPeriod string       `json:"withdrawal_period" yaml:"withdrawal_period"`
+}
+
 func RegisterCoinProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
 	return govrest.ProposalRESTHandler{
 		SubRoute: types.ModuleName,
@@ -63,6 +104,34 @@ func ToggleTokenConversionRESTHandler(clientCtx client.Context) govrest.Proposal
 }
 
 // nolint: dupl
+func RevokeERC20RegistrationRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newRevokeERC20RegistrationHandler(clientCtx),
+	}
+}
+
+func UpdateConversionLimitsRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newUpdateConversionLimitsHandler(clientCtx),
+	}
+}
+
+func UpdateTokenPairMetadataRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newUpdateTokenPairMetadataHandler(clientCtx),
+	}
+}
+
+func DeregisterTokenPairRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newDeregisterTokenPairHandler(clientCtx),
+	}
+}
+
 func newRegisterCoinProposalHandler(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req RegisterCoinProposalRequest
@@ -114,7 +183,7 @@ func newRegisterERC20ProposalHandler(clientCtx client.Context) http.HandlerFunc
 			return
 		}
 
-		content := types.NewRegisterERC20Proposal(req.Title, req.Description, req.ERC20Address)
+		content := types.NewRegisterERC20Proposal(req.Title, req.Description, req.ERC20Address, req.Behavior, req.ProbeHolder)
 		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
 		if rest.CheckBadRequestError(w, err) {
 			return
@@ -160,3 +229,137 @@ func newToggleTokenConversionHandler(clientCtx client.Context) http.HandlerFunc
 		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
+
+// nolint: dupl
+func newRevokeERC20RegistrationHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RevokeERC20RegistrationProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewRevokeERC20RegistrationProposal(req.Title, req.Description, req.ERC20Address)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func newUpdateConversionLimitsHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateConversionLimitsProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewUpdateConversionLimitsProposal(req.Title, req.Description, req.Limits)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func newUpdateTokenPairMetadataHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateTokenPairMetadataProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewUpdateTokenPairMetadataProposal(req.Title, req.Description, req.Token, req.Metadata)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func newDeregisterTokenPairHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req DeregisterTokenPairProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		withdrawalPeriod, err := time.ParseDuration(req.WithdrawalPeriod)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewDeregisterTokenPairProposal(req.Title, req.Description, req.Token, withdrawalPeriod)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
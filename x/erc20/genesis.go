@@ -28,13 +28,34 @@ func InitGenesis(
 		k.SetTokenPair(ctx, pair)
 		k.SetDenomMap(ctx, pair.Denom, id)
 		k.SetERC20Map(ctx, pair.GetERC20Contract(), id)
+
+		if pair.IsDeregistering() {
+			k.SetWithdrawalQueueEntry(ctx, pair)
+		}
+	}
+
+	for _, limits := range data.ConversionLimits {
+		id := k.GetERC20Map(ctx, limits.GetERC20Contract())
+		k.SetConversionLimits(ctx, id, limits)
+	}
+
+	for _, t := range data.LastRegistrationTimes {
+		sender := sdk.MustAccAddressFromBech32(t.Address)
+		k.SetLastRegistrationTime(ctx, sender, t.Time)
+	}
+
+	for _, window := range data.ConversionWindows {
+		k.SetConversionWindowEntry(ctx, window)
 	}
 }
 
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:     k.GetParams(ctx),
-		TokenPairs: k.GetTokenPairs(ctx),
+		Params:                k.GetParams(ctx),
+		TokenPairs:            k.GetTokenPairs(ctx),
+		ConversionLimits:      k.GetAllConversionLimits(ctx),
+		LastRegistrationTimes: k.GetAllLastRegistrationTimes(ctx),
+		ConversionWindows:     k.GetAllConversionWindows(ctx),
 	}
 }
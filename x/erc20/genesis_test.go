@@ -89,7 +89,9 @@ func (suite *GenesisTestSuite) TestERC20InitGenesis() {
 						Enabled:       true,
 						ContractOwner: types.OWNER_MODULE,
 					},
-				}),
+				},
+				[]types.ConversionLimits{},
+			),
 		},
 	}
 
@@ -110,6 +112,28 @@ func (suite *GenesisTestSuite) TestERC20InitGenesis() {
 	}
 }
 
+func (suite *GenesisTestSuite) TestERC20InitGenesisDeregisteringPair() {
+	withdrawalEnd := suite.ctx.BlockTime().Add(time.Hour)
+	pair := types.TokenPair{
+		Erc20Address:      tests.GenerateAddress().String(),
+		Denom:             "coin",
+		ContractOwner:     types.OWNER_EXTERNAL,
+		WithdrawalEndTime: withdrawalEnd.Unix(),
+	}
+
+	genesisState := types.NewGenesisState(types.DefaultParams(), []types.TokenPair{pair}, []types.ConversionLimits{})
+	erc20.InitGenesis(suite.ctx, suite.app.Erc20Keeper, suite.app.AccountKeeper, genesisState)
+
+	// the token pair is removed at the end of its withdrawal period
+	suite.app.Erc20Keeper.EndBlocker(suite.ctx)
+	suite.Require().True(suite.app.Erc20Keeper.IsDenomRegistered(suite.ctx, pair.Denom))
+
+	ctx := suite.ctx.WithBlockTime(withdrawalEnd)
+	suite.app.Erc20Keeper.EndBlocker(ctx)
+	suite.Require().False(suite.app.Erc20Keeper.IsDenomRegistered(ctx, pair.Denom))
+	suite.Require().Empty(suite.app.Erc20Keeper.GetTokenPairs(ctx))
+}
+
 func (suite *GenesisTestSuite) TestErc20ExportGenesis() {
 	testGenCases := []struct {
 		name         string
@@ -134,7 +158,9 @@ func (suite *GenesisTestSuite) TestErc20ExportGenesis() {
 						Enabled:       true,
 						ContractOwner: types.OWNER_MODULE,
 					},
-				}),
+				},
+				[]types.ConversionLimits{},
+			),
 		},
 	}
 
@@ -155,3 +181,47 @@ func (suite *GenesisTestSuite) TestErc20ExportGenesis() {
 		// }
 	}
 }
+
+func (suite *GenesisTestSuite) TestErc20ExportGenesisLastRegistrationTimes() {
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	lastTime := suite.ctx.BlockTime().Truncate(time.Second)
+
+	genesisState := *types.DefaultGenesisState()
+	genesisState.LastRegistrationTimes = []types.LastRegistrationTime{
+		{Address: sender.String(), Time: lastTime},
+	}
+	erc20.InitGenesis(suite.ctx, suite.app.Erc20Keeper, suite.app.AccountKeeper, genesisState)
+
+	got, found := suite.app.Erc20Keeper.GetLastRegistrationTime(suite.ctx, sender)
+	suite.Require().True(found)
+	suite.Require().True(lastTime.Equal(got))
+
+	genesisExported := erc20.ExportGenesis(suite.ctx, suite.app.Erc20Keeper)
+	suite.Require().Len(genesisExported.LastRegistrationTimes, 1)
+	suite.Require().Equal(sender.String(), genesisExported.LastRegistrationTimes[0].Address)
+	suite.Require().True(lastTime.Equal(genesisExported.LastRegistrationTimes[0].Time))
+}
+
+func (suite *GenesisTestSuite) TestErc20ExportGenesisConversionWindows() {
+	contract := tests.GenerateAddress()
+	pair := types.NewTokenPair(contract, "coin", true, types.OWNER_MODULE)
+	limits := types.NewConversionLimits(contract, sdk.ZeroInt(), sdk.NewInt(1000), sdk.NewInt(100), time.Hour)
+	address := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	window := types.NewConversionWindow(suite.ctx.BlockTime().Unix())
+	window.Current = sdk.NewInt(60)
+	addressWindow := types.NewConversionWindow(suite.ctx.BlockTime().Unix())
+	addressWindow.Current = sdk.NewInt(40)
+	addressWindow.Previous = sdk.NewInt(20)
+
+	genesisState := types.NewGenesisState(types.DefaultParams(), []types.TokenPair{pair}, []types.ConversionLimits{limits})
+	genesisState.ConversionWindows = []types.ConversionWindowEntry{
+		{Erc20Address: contract.String(), Window: window},
+		{Erc20Address: contract.String(), Address: address.String(), Window: addressWindow},
+	}
+	suite.Require().NoError(genesisState.Validate())
+	erc20.InitGenesis(suite.ctx, suite.app.Erc20Keeper, suite.app.AccountKeeper, genesisState)
+
+	genesisExported := erc20.ExportGenesis(suite.ctx, suite.app.Erc20Keeper)
+	suite.Require().Equal(genesisState.ConversionWindows, genesisExported.ConversionWindows)
+}
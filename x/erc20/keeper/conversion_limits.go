@@ -0,0 +1,267 @@
+package keeper
+
+import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ArableProtocol/acrechain/x/erc20/types"
+)
+
+// UpdateConversionLimits sets the conversion rate limits of a registered token
+// pair. The limits are removed when none of them is enforced.
+func (k Keeper) UpdateConversionLimits(
+	ctx sdk.Context,
+	limits types.ConversionLimits,
+) (types.TokenPair, error) {
+	contract := limits.GetERC20Contract()
+	id := k.GetERC20Map(ctx, contract)
+	if len(id) == 0 {
+		return types.TokenPair{}, sdkerrors.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", contract.String(),
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, sdkerrors.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", contract.String(),
+		)
+	}
+
+	if limits.IsZero() {
+		k.deleteConversionLimits(ctx, id)
+		return pair, nil
+	}
+
+	limits.Erc20Address = pair.Erc20Address
+	k.SetConversionLimits(ctx, id, limits)
+	return pair, nil
+}
+
+// GetConversionLimits returns the conversion rate limits of a token pair
+func (k Keeper) GetConversionLimits(ctx sdk.Context, id []byte) (types.ConversionLimits, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionLimits)
+	bz := store.Get(id)
+	if len(bz) == 0 {
+		return types.ConversionLimits{}, false
+	}
+
+	var limits types.ConversionLimits
+	k.cdc.MustUnmarshal(bz, &limits)
+	return limits, true
+}
+
+// GetAllConversionLimits returns the conversion rate limits of all token pairs
+func (k Keeper) GetAllConversionLimits(ctx sdk.Context) []types.ConversionLimits {
+	limits := []types.ConversionLimits{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixConversionLimits)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var l types.ConversionLimits
+		k.cdc.MustUnmarshal(iterator.Value(), &l)
+
+		limits = append(limits, l)
+	}
+
+	return limits
+}
+
+// SetConversionLimits stores the conversion rate limits of a token pair
+func (k Keeper) SetConversionLimits(ctx sdk.Context, id []byte, limits types.ConversionLimits) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionLimits)
+	store.Set(id, k.cdc.MustMarshal(&limits))
+}
+
+// deleteConversionLimits removes the conversion rate limits of a token pair
+// along with the amounts converted over their rolling windows
+func (k Keeper) deleteConversionLimits(ctx sdk.Context, id []byte) {
+	store := ctx.KVStore(k.storeKey)
+	prefix.NewStore(store, types.KeyPrefixConversionLimits).Delete(id)
+	prefix.NewStore(store, types.KeyPrefixConversionWindow).Delete(id)
+
+	addressStore := prefix.NewStore(store, append(types.KeyPrefixAddressConversionWindow, id...))
+	iterator := addressStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		addressStore.Delete(key)
+	}
+}
+
+// getConversionWindow returns the amounts converted over the rolling window
+// stored under the given key
+func (k Keeper) getConversionWindow(ctx sdk.Context, keyPrefix, key []byte) (types.ConversionWindow, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return types.ConversionWindow{}, false
+	}
+
+	var window types.ConversionWindow
+	k.cdc.MustUnmarshal(bz, &window)
+	return window, true
+}
+
+// setConversionWindow stores the amounts converted over the rolling window
+// under the given key
+func (k Keeper) setConversionWindow(ctx sdk.Context, keyPrefix, key []byte, window types.ConversionWindow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	store.Set(key, k.cdc.MustMarshal(&window))
+}
+
+// GetAllConversionWindows returns the amounts converted over the rolling
+// windows of all the token pairs with conversion limits, and of all the
+// addresses that converted them
+func (k Keeper) GetAllConversionWindows(ctx sdk.Context) []types.ConversionWindowEntry {
+	entries := []types.ConversionWindowEntry{}
+
+	for _, limits := range k.GetAllConversionLimits(ctx) {
+		id := k.GetERC20Map(ctx, limits.GetERC20Contract())
+
+		if window, found := k.getConversionWindow(ctx, types.KeyPrefixConversionWindow, id); found {
+			entries = append(entries, types.ConversionWindowEntry{
+				Erc20Address: limits.Erc20Address,
+				Window:       window,
+			})
+		}
+
+		addressStore := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixAddressConversionWindow, id...))
+		iterator := addressStore.Iterator(nil, nil)
+
+		for ; iterator.Valid(); iterator.Next() {
+			var window types.ConversionWindow
+			k.cdc.MustUnmarshal(iterator.Value(), &window)
+
+			entries = append(entries, types.ConversionWindowEntry{
+				Erc20Address: limits.Erc20Address,
+				Address:      sdk.AccAddress(iterator.Key()).String(),
+				Window:       window,
+			})
+		}
+
+		iterator.Close()
+	}
+
+	return entries
+}
+
+// SetConversionWindowEntry stores the amounts converted over the rolling
+// window of a token pair, or of an address for a token pair
+func (k Keeper) SetConversionWindowEntry(ctx sdk.Context, entry types.ConversionWindowEntry) {
+	id := k.GetERC20Map(ctx, entry.GetERC20Contract())
+	if entry.Address == "" {
+		k.setConversionWindow(ctx, types.KeyPrefixConversionWindow, id, entry.Window)
+		return
+	}
+
+	address := sdk.MustAccAddressFromBech32(entry.Address)
+	k.setConversionWindow(ctx, append(types.KeyPrefixAddressConversionWindow, id...), address, entry.Window)
+}
+
+// consumeConversionLimits checks that converting the given amount of a token
+// pair for an address stays within the conversion rate limits of the token
+// pair, and records the amount on the rolling windows.
+func (k Keeper) consumeConversionLimits(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	address sdk.AccAddress,
+	amount sdk.Int,
+) error {
+	id := pair.GetID()
+	limits, found := k.GetConversionLimits(ctx, id)
+	if !found {
+		return nil
+	}
+
+	if !limits.MaxPerConversion.IsNil() && limits.MaxPerConversion.IsPositive() && amount.GT(limits.MaxPerConversion) {
+		return sdkerrors.Wrapf(
+			types.ErrConversionLimit, "conversion of %s exceeds the limit of %s per conversion for token '%s'",
+			amount, limits.MaxPerConversion, pair.Erc20Address,
+		)
+	}
+
+	if err := k.consumeConversionWindow(
+		ctx, types.KeyPrefixConversionWindow, id, limits.MaxPerWindow, limits, amount,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "token '%s'", pair.Erc20Address)
+	}
+
+	if err := k.consumeConversionWindow(
+		ctx, append(types.KeyPrefixAddressConversionWindow, id...), address, limits.MaxPerAddress, limits, amount,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "token '%s' and address %s", pair.Erc20Address, address)
+	}
+
+	return nil
+}
+
+// consumeConversionWindow adds the amount to the rolling window stored under
+// the given key, unless it exceeds the limit of the window.
+func (k Keeper) consumeConversionWindow(
+	ctx sdk.Context,
+	keyPrefix, key []byte,
+	limit sdk.Int,
+	limits types.ConversionLimits,
+	amount sdk.Int,
+) error {
+	if limit.IsNil() || !limit.IsPositive() {
+		return nil
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	window, found := k.getConversionWindow(ctx, keyPrefix, key)
+	if !found {
+		window = types.NewConversionWindow(blockTime)
+	}
+	window = window.Roll(blockTime, limits.Window)
+
+	converted := window.Amount(blockTime, limits.Window)
+	if converted.Add(amount).GT(limit) {
+		return sdkerrors.Wrapf(
+			types.ErrConversionLimit, "conversion of %s exceeds the limit of %s per %s, %s already converted",
+			amount, limit, limits.Window, converted,
+		)
+	}
+
+	window.Current = window.Current.Add(amount)
+	k.setConversionWindow(ctx, keyPrefix, key, window)
+	return nil
+}
+
+// tripCircuitBreaker disables the conversions of a token pair when a
+// conversion failed a post transfer balance check, which hints at a malicious
+// or faulty ERC20 contract. It returns true if the circuit breaker tripped.
+func (k Keeper) tripCircuitBreaker(ctx sdk.Context, pair types.TokenPair, err error) bool {
+	if !errors.Is(err, types.ErrBalanceInvariance) {
+		return false
+	}
+
+	pair.Enabled = false
+	k.SetTokenPair(ctx, pair)
+
+	k.Logger(ctx).Error(
+		"conversions disabled by the circuit breaker",
+		"coin", pair.Denom, "contract", pair.Erc20Address, "error", err.Error(),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		),
+	)
+
+	return true
+}
@@ -0,0 +1,272 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/ArableProtocol/acrechain/contracts"
+	"github.com/ArableProtocol/acrechain/x/erc20/types"
+)
+
+// requireConversionFailed checks that a conversion either failed or tripped the
+// circuit breaker, which disables the token pair without returning an error
+func (suite *KeeperTestSuite) requireConversionFailed(err error, contract common.Address, name string) {
+	if err != nil {
+		return
+	}
+
+	id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, contract.String())
+	pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+	suite.Require().True(found, name)
+	suite.Require().False(pair.Enabled, name)
+}
+
+func (suite *KeeperTestSuite) TestConversionLimits() {
+	type conversion struct {
+		amount  int64
+		offset  time.Duration
+		other   bool
+		expPass bool
+	}
+
+	testCases := []struct {
+		name        string
+		limits      func(contract common.Address) types.ConversionLimits
+		conversions []conversion
+	}{
+		{
+			"no limits",
+			func(contract common.Address) types.ConversionLimits {
+				return types.NewConversionLimits(contract, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt(), 0)
+			},
+			[]conversion{
+				{amount: 1000, expPass: true},
+				{amount: 1000, expPass: true},
+			},
+		},
+		{
+			"max per conversion",
+			func(contract common.Address) types.ConversionLimits {
+				return types.NewConversionLimits(contract, sdk.NewInt(500), sdk.ZeroInt(), sdk.ZeroInt(), 0)
+			},
+			[]conversion{
+				{amount: 500, expPass: true},
+				{amount: 501, expPass: false},
+				{amount: 500, expPass: true},
+			},
+		},
+		{
+			"max per window",
+			func(contract common.Address) types.ConversionLimits {
+				return types.NewConversionLimits(contract, sdk.ZeroInt(), sdk.NewInt(1000), sdk.ZeroInt(), time.Hour)
+			},
+			[]conversion{
+				{amount: 600, expPass: true},
+				{amount: 400, other: true, expPass: true},
+				{amount: 1, expPass: false},
+				{amount: 1000, offset: 2 * time.Hour, expPass: true},
+			},
+		},
+		{
+			"max per window - rolling window",
+			func(contract common.Address) types.ConversionLimits {
+				return types.NewConversionLimits(contract, sdk.ZeroInt(), sdk.NewInt(1000), sdk.ZeroInt(), time.Hour)
+			},
+			[]conversion{
+				{amount: 1000, expPass: true},
+				{amount: 1, offset: 30 * time.Minute, expPass: false},
+				{amount: 500, offset: 90 * time.Minute, expPass: true},
+				{amount: 1, offset: 90 * time.Minute, expPass: false},
+			},
+		},
+		{
+			"max per address",
+			func(contract common.Address) types.ConversionLimits {
+				return types.NewConversionLimits(contract, sdk.ZeroInt(), sdk.ZeroInt(), sdk.NewInt(500), time.Hour)
+			},
+			[]conversion{
+				{amount: 500, expPass: true},
+				{amount: 1, expPass: false},
+				{amount: 500, other: true, expPass: true},
+				{amount: 500, offset: 2 * time.Hour, expPass: true},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			metadata, pair := suite.setupRegisterCoin()
+			suite.Require().NotNil(metadata)
+
+			_, err := suite.app.Erc20Keeper.UpdateConversionLimits(suite.ctx, tc.limits(pair.GetERC20Contract()))
+			suite.Require().NoError(err)
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			other := sdk.AccAddress(tests.GenerateAddress().Bytes())
+			coins := sdk.NewCoins(sdk.NewCoin(cosmosTokenBase, sdk.NewInt(10000)))
+			for _, addr := range []sdk.AccAddress{sender, other} {
+				suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+				suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, addr, coins))
+			}
+
+			start := time.Unix(3600*1000, 0)
+			for i, c := range tc.conversions {
+				ctx := suite.ctx.WithBlockTime(start.Add(c.offset))
+
+				from := sender
+				if c.other {
+					from = other
+				}
+
+				msg := types.NewMsgConvertCoin(sdk.NewInt64Coin(cosmosTokenBase, c.amount), suite.address, from)
+				_, err := suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(ctx), msg)
+				if c.expPass {
+					suite.Require().NoError(err, "conversion %d", i)
+				} else {
+					suite.Require().ErrorIs(err, types.ErrConversionLimit, "conversion %d", i)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConversionLimitsDiscardedConversion() {
+	suite.SetupTest()
+	_, pair := suite.setupRegisterCoin()
+
+	limits := types.NewConversionLimits(pair.GetERC20Contract(), sdk.ZeroInt(), sdk.NewInt(100), sdk.ZeroInt(), time.Hour)
+	_, err := suite.app.Erc20Keeper.UpdateConversionLimits(suite.ctx, limits)
+	suite.Require().NoError(err)
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	msg := types.NewMsgConvertCoin(sdk.NewInt64Coin(cosmosTokenBase, 100), suite.address, sender)
+
+	// the failed conversion doesn't count towards the limits
+	_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, 100))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins))
+
+	_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestConversionLimitsEVMHook() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+	suite.ensureHooksSet()
+
+	contractAddr, err := suite.DeployContract("coin", "token", erc20Decimals)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOR_STANDARD, common.Address{})
+	suite.Require().NoError(err)
+
+	limits := types.NewConversionLimits(contractAddr, sdk.NewInt(5), sdk.ZeroInt(), sdk.ZeroInt(), 0)
+	_, err = suite.app.Erc20Keeper.UpdateConversionLimits(suite.ctx, limits)
+	suite.Require().NoError(err)
+
+	account := tests.GenerateAddress()
+	transferData := common.LeftPadBytes(big.NewInt(10).Bytes(), 32)
+	transferEvent := contracts.ERC20BurnableContract.ABI.Events["Transfer"]
+	log := ethtypes.Log{
+		Topics:  []common.Hash{transferEvent.ID, account.Hash(), types.ModuleAddress.Hash()},
+		Data:    transferData,
+		Address: contractAddr,
+	}
+	receipt := &ethtypes.Receipt{Logs: []*ethtypes.Log{&log}}
+
+	err = suite.app.Erc20Keeper.PostTxProcessing(suite.ctx, ethtypes.Message{}, receipt)
+	suite.Require().ErrorIs(err, types.ErrConversionLimit)
+
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(account.Bytes()), pair.Denom)
+	suite.Require().True(balance.IsZero())
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestCircuitBreaker() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+
+	contractAddr := suite.setupRegisterERC20Pair(contractDirectBalanceManipulation)
+	suite.Commit()
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+	suite.Commit()
+
+	coinName := types.CreateDenom(contractAddr.String())
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	msg := types.NewMsgConvertERC20(sdk.NewInt(10), sender, contractAddr, suite.address)
+	res, err := suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Nil(res)
+
+	// the conversion is discarded
+	cosmosBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, coinName)
+	suite.Require().True(cosmosBalance.IsZero())
+
+	id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, contractAddr.String())
+	pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Require().False(pair.Enabled)
+
+	events := suite.ctx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(types.EventTypeCircuitBreaker, events[0].Type)
+
+	// further conversions are disabled
+	_, err = suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrERC20TokenPairDisabled)
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestUpdateConversionLimits() {
+	suite.SetupTest()
+	_, pair := suite.setupRegisterCoin()
+	contract := pair.GetERC20Contract()
+	id := pair.GetID()
+
+	// unregistered token pair
+	limits := types.NewConversionLimits(tests.GenerateAddress(), sdk.NewInt(100), sdk.ZeroInt(), sdk.ZeroInt(), 0)
+	_, err := suite.app.Erc20Keeper.UpdateConversionLimits(suite.ctx, limits)
+	suite.Require().ErrorIs(err, types.ErrTokenPairNotFound)
+
+	limits = types.NewConversionLimits(contract, sdk.NewInt(100), sdk.NewInt(1000), sdk.NewInt(500), time.Hour)
+	_, err = suite.app.Erc20Keeper.UpdateConversionLimits(suite.ctx, limits)
+	suite.Require().NoError(err)
+
+	stored, found := suite.app.Erc20Keeper.GetConversionLimits(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(limits, stored)
+	suite.Require().Equal([]types.ConversionLimits{limits}, suite.app.Erc20Keeper.GetAllConversionLimits(suite.ctx))
+
+	// zero limits remove the limits
+	limits = types.NewConversionLimits(contract, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt(), 0)
+	_, err = suite.app.Erc20Keeper.UpdateConversionLimits(suite.ctx, limits)
+	suite.Require().NoError(err)
+
+	_, found = suite.app.Erc20Keeper.GetConversionLimits(suite.ctx, id)
+	suite.Require().False(found)
+	suite.Require().Empty(suite.app.Erc20Keeper.GetAllConversionLimits(suite.ctx))
+
+	// deleting the token pair removes the limits
+	limits = types.NewConversionLimits(contract, sdk.NewInt(100), sdk.ZeroInt(), sdk.ZeroInt(), 0)
+	_, err = suite.app.Erc20Keeper.UpdateConversionLimits(suite.ctx, limits)
+	suite.Require().NoError(err)
+
+	suite.app.Erc20Keeper.DeleteTokenPair(suite.ctx, *pair)
+	_, found = suite.app.Erc20Keeper.GetConversionLimits(suite.ctx, id)
+	suite.Require().False(found)
+}
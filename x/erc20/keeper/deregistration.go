@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ArableProtocol/acrechain/contracts"
+	"github.com/ArableProtocol/acrechain/x/erc20/types"
+)
+
+// EndBlocker removes the token pairs whose deregistration withdrawal period
+// ended.
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	for _, pair := range k.getWithdrawnTokenPairs(ctx, ctx.BlockTime().Unix()) {
+		k.removeDeregisteredTokenPair(ctx, pair)
+	}
+}
+
+// DeregisterTokenPair disables the conversions of a token pair and opens a
+// withdrawal period, during which only the conversions back to the native
+// representation of the token pair are allowed. The token pair is removed at
+// the end of the withdrawal period.
+func (k Keeper) DeregisterTokenPair(
+	ctx sdk.Context,
+	token string,
+	withdrawalPeriod time.Duration,
+) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, sdkerrors.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, sdkerrors.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	if pair.IsDeregistering() {
+		return types.TokenPair{}, sdkerrors.Wrapf(
+			types.ErrTokenPairDeregistering, "token '%s' until %d", token, pair.WithdrawalEndTime,
+		)
+	}
+
+	pair.ReviewEndTime = 0
+	pair.WithdrawalEndTime = ctx.BlockTime().Add(withdrawalPeriod).Unix()
+
+	k.SetTokenPair(ctx, pair)
+	k.SetWithdrawalQueueEntry(ctx, pair)
+	return pair, nil
+}
+
+// removeDeregisteredTokenPair removes a token pair at the end of its
+// withdrawal period and emits a report of the tokens left in escrow, along
+// with the outstanding amount of the representation they backed.
+func (k Keeper) removeDeregisteredTokenPair(ctx sdk.Context, pair types.TokenPair) {
+	escrow, outstanding := k.escrowReport(ctx, pair)
+
+	k.DeleteTokenPair(ctx, pair)
+
+	k.Logger(ctx).Info(
+		"deregistered token pair removed",
+		"coin", pair.Denom, "contract", pair.Erc20Address, "escrow", escrow, "outstanding", outstanding,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveTokenPair,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyEscrow, escrow),
+			sdk.NewAttribute(types.AttributeKeyOutstanding, outstanding),
+		),
+	)
+}
+
+// escrowReport returns the amount of the native representation of a token
+// pair escrowed by the module, and the outstanding supply of the
+// representation minted against it. The amounts are empty if the ERC20
+// contract cannot be queried, e.g. after a self-destruct.
+func (k Keeper) escrowReport(ctx sdk.Context, pair types.TokenPair) (escrow, outstanding string) {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := pair.GetERC20Contract()
+
+	var escrowAmt, outstandingAmt *big.Int
+	switch {
+	case pair.IsNativeCoin():
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		escrowAmt = k.bankKeeper.GetBalance(ctx, moduleAddr, pair.Denom).Amount.BigInt()
+		outstandingAmt = k.TotalSupply(ctx, erc20, contract)
+	case pair.IsNativeERC20():
+		escrowAmt = k.BalanceOf(ctx, erc20, contract, types.ModuleAddress)
+		outstandingAmt = k.bankKeeper.GetSupply(ctx, pair.Denom).Amount.BigInt()
+	}
+
+	if escrowAmt != nil {
+		escrow = escrowAmt.String()
+	}
+	if outstandingAmt != nil {
+		outstanding = outstandingAmt.String()
+	}
+	return escrow, outstanding
+}
+
+// getWithdrawnTokenPairs returns the token pairs whose withdrawal period ended
+// at or before the given unix time
+func (k Keeper) getWithdrawnTokenPairs(ctx sdk.Context, blockTime int64) []types.TokenPair {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWithdrawalQueue)
+	end := sdk.Uint64ToBigEndian(uint64(blockTime) + 1)
+	iterator := store.Iterator(nil, end)
+	defer iterator.Close()
+
+	var pairs []types.TokenPair
+	for ; iterator.Valid(); iterator.Next() {
+		pair, found := k.GetTokenPair(ctx, iterator.Value())
+		if !found {
+			// NOTE: shouldn't occur, as the entry is removed with the token pair
+			panic(fmt.Errorf("token pair with id %X not found", iterator.Value()))
+		}
+		pairs = append(pairs, pair)
+	}
+
+	return pairs
+}
+
+// SetWithdrawalQueueEntry queues a token pair for its removal at the end of
+// its withdrawal period
+func (k Keeper) SetWithdrawalQueueEntry(ctx sdk.Context, pair types.TokenPair) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWithdrawalQueue)
+	id := pair.GetID()
+	store.Set(withdrawalQueueKey(pair.WithdrawalEndTime, id), id)
+}
+
+// deleteWithdrawalQueueEntry removes a token pair from the withdrawal queue
+func (k Keeper) deleteWithdrawalQueueEntry(ctx sdk.Context, pair types.TokenPair) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWithdrawalQueue)
+	store.Delete(withdrawalQueueKey(pair.WithdrawalEndTime, pair.GetID()))
+}
+
+// withdrawalQueueKey returns the withdrawal queue key of a token pair, which
+// orders the entries by the end of their withdrawal period
+func withdrawalQueueKey(withdrawalEndTime int64, id []byte) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(withdrawalEndTime)), id...)
+}
@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ArableProtocol/acrechain/contracts"
+	"github.com/ArableProtocol/acrechain/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestDeregisterTokenPair() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+
+	_, err := suite.app.Erc20Keeper.DeregisterTokenPair(suite.ctx, cosmosTokenBase, time.Hour)
+	suite.Require().ErrorIs(err, types.ErrTokenPairNotFound)
+
+	_, pair := suite.setupRegisterCoin()
+	suite.Commit()
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, 100))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins))
+
+	msgCoin := types.NewMsgConvertCoin(sdk.NewInt64Coin(cosmosTokenBase, 60), suite.address, sender)
+	_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msgCoin)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	deregistered, err := suite.app.Erc20Keeper.DeregisterTokenPair(suite.ctx, cosmosTokenBase, time.Hour)
+	suite.Require().NoError(err)
+	suite.Require().True(deregistered.Enabled)
+	suite.Require().Equal(suite.ctx.BlockTime().Add(time.Hour).Unix(), deregistered.WithdrawalEndTime)
+
+	_, err = suite.app.Erc20Keeper.DeregisterTokenPair(suite.ctx, cosmosTokenBase, time.Hour)
+	suite.Require().ErrorIs(err, types.ErrTokenPairDeregistering)
+
+	// only the conversions back to the native Cosmos coin are allowed
+	_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msgCoin)
+	suite.Require().ErrorIs(err, types.ErrTokenPairDeregistering)
+
+	// governance can still halt the withdrawals
+	_, err = suite.app.Erc20Keeper.ToggleConversion(suite.ctx, cosmosTokenBase)
+	suite.Require().NoError(err)
+
+	msgERC20 := types.NewMsgConvertERC20(sdk.NewInt(10), sender, pair.GetERC20Contract(), suite.address)
+	_, err = suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msgERC20)
+	suite.Require().ErrorIs(err, types.ErrERC20TokenPairDisabled)
+
+	_, err = suite.app.Erc20Keeper.ToggleConversion(suite.ctx, cosmosTokenBase)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msgERC20)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	// the token pair is kept until the end of the withdrawal period
+	suite.app.Erc20Keeper.EndBlocker(suite.ctx)
+	suite.Require().True(suite.app.Erc20Keeper.IsDenomRegistered(suite.ctx, cosmosTokenBase))
+
+	ctx := suite.ctx.
+		WithBlockTime(time.Unix(deregistered.WithdrawalEndTime, 0)).
+		WithEventManager(sdk.NewEventManager())
+	suite.app.Erc20Keeper.EndBlocker(ctx)
+
+	suite.Require().False(suite.app.Erc20Keeper.IsDenomRegistered(ctx, cosmosTokenBase))
+	suite.Require().False(suite.app.Erc20Keeper.IsERC20Registered(ctx, pair.GetERC20Contract()))
+	suite.Require().Empty(suite.app.Erc20Keeper.GetTokenPairs(ctx))
+
+	events := ctx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(types.EventTypeRemoveTokenPair, events[0].Type)
+
+	attrs := map[string]string{}
+	for _, attr := range events[0].Attributes {
+		attrs[string(attr.Key)] = string(attr.Value)
+	}
+	suite.Require().Equal(cosmosTokenBase, attrs[types.AttributeKeyCosmosCoin])
+	suite.Require().Equal("50", attrs[types.AttributeKeyEscrow])
+	suite.Require().Equal("50", attrs[types.AttributeKeyOutstanding])
+
+	// the withdrawal queue is empty
+	suite.app.Erc20Keeper.EndBlocker(ctx)
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestDeregisterTokenPairCircuitBreaker() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+
+	contractAddr := suite.setupRegisterERC20Pair(contractDirectBalanceManipulation)
+	suite.Commit()
+
+	// the sender holds coins backed by the tokens escrowed by the module
+	sender := sdk.AccAddress(suite.address.Bytes())
+	coinName := types.CreateDenom(contractAddr.String())
+	_, err := suite.app.Erc20Keeper.CallEVM(
+		suite.ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, suite.address, contractAddr, true,
+		"mint", types.ModuleAddress, big.NewInt(10),
+	)
+	suite.Require().NoError(err)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(coinName, 10))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins))
+
+	_, err = suite.app.Erc20Keeper.DeregisterTokenPair(suite.ctx, contractAddr.String(), time.Hour)
+	suite.Require().NoError(err)
+
+	// the withdrawal trips the circuit breaker
+	msg := types.NewMsgConvertCoin(sdk.NewInt64Coin(coinName, 10), suite.address, sender)
+	res, err := suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Nil(res)
+
+	id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, contractAddr.String())
+	pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Require().True(pair.IsDeregistering())
+	suite.Require().False(pair.Enabled)
+
+	// the withdrawals are halted until governance enables them again
+	_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrERC20TokenPairDisabled)
+
+	pair, err = suite.app.Erc20Keeper.ToggleConversion(suite.ctx, contractAddr.String())
+	suite.Require().NoError(err)
+	suite.Require().True(pair.ConversionEnabled(false))
+	suite.mintFeeCollector = false
+}
@@ -6,6 +6,7 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -112,7 +113,7 @@ func (k Keeper) PostTxProcessing(
 		}
 
 		// Check that conversion for the pair is enabled. Fail
-		if !pair.Enabled {
+		if !pair.ConversionEnabled(true) {
 			// continue to allow transfers for the ERC20 in case the token pair is
 			// disabled
 			k.Logger(ctx).Debug(
@@ -122,6 +123,35 @@ func (k Keeper) PostTxProcessing(
 			continue
 		}
 
+		// Only need last 20 bytes from log.topics
+		from := common.BytesToAddress(log.Topics[1].Bytes())
+		recipient := sdk.AccAddress(from.Bytes())
+
+		// NOTE: fee-on-transfer tokens only mint the amount actually escrowed
+		if pair.IsNativeERC20() && pair.IsFeeOnTransfer() {
+			tokens, err = k.receivedFeeOnTransferTokens(ctx, pair, receipt.Logs[i:], tokens)
+			if err != nil {
+				k.tripCircuitBreaker(ctx, pair, err)
+				k.Logger(ctx).Debug(
+					"failed to process EVM hook for ER20 -> coin conversion",
+					"coin", pair.Denom, "contract", pair.Erc20Address, "error", err.Error(),
+				)
+				continue
+			}
+			// the share of a transfer much smaller than the others of the tx
+			// can round down to zero
+			if tokens.Sign() == 0 {
+				continue
+			}
+		}
+
+		// Check the conversion rate limits of the pair. The error reverts the
+		// whole EVM tx, as the tokens would otherwise remain escrowed on the
+		// module account without being converted.
+		if err := k.consumeConversionLimits(ctx, pair, recipient, sdk.NewIntFromBigInt(tokens)); err != nil {
+			return err
+		}
+
 		// create the corresponding sdk.Coin that is paired with ERC20
 		coins := sdk.Coins{{Denom: pair.Denom, Amount: sdk.NewIntFromBigInt(tokens)}}
 
@@ -131,12 +161,16 @@ func (k Keeper) PostTxProcessing(
 		case types.OWNER_MODULE:
 			_, err = k.CallEVM(ctx, erc20, types.ModuleAddress, contractAddr, true, "burn", tokens)
 		case types.OWNER_EXTERNAL:
-			err = k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
+			// the tokens transferred must back the minted coins
+			if err = k.checkNativeERC20Escrow(ctx, pair, coins[0].Amount); err == nil {
+				err = k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
+			}
 		default:
 			err = types.ErrUndefinedOwner
 		}
 
 		if err != nil {
+			k.tripCircuitBreaker(ctx, pair, err)
 			k.Logger(ctx).Debug(
 				"failed to process EVM hook for ER20 -> coin conversion",
 				"coin", pair.Denom, "contract", pair.Erc20Address, "error", err.Error(),
@@ -144,10 +178,6 @@ func (k Keeper) PostTxProcessing(
 			continue
 		}
 
-		// Only need last 20 bytes from log.topics
-		from := common.BytesToAddress(log.Topics[1].Bytes())
-		recipient := sdk.AccAddress(from.Bytes())
-
 		// transfer the tokens from ModuleAccount to sender address
 		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
 			k.Logger(ctx).Debug(
@@ -161,3 +191,56 @@ func (k Keeper) PostTxProcessing(
 
 	return nil
 }
+
+// receivedFeeOnTransferTokens returns the amount of tokens escrowed by the
+// module address for a transfer of a fee-on-transfer token, as the amount of
+// the transfer event includes the fee. The hook runs after the transfers, so
+// the balance of the module address before them is the escrow backing the coin
+// supply: the transfers of the tx left to convert, including the given one,
+// escrowed the balance in excess of the coin supply. The excess is shared among
+// these transfers in proportion to their amounts, rounded down.
+func (k Keeper) receivedFeeOnTransferTokens(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	logs []*ethtypes.Log,
+	tokens *big.Int,
+) (*big.Int, error) {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := pair.GetERC20Contract()
+
+	after := k.BalanceOf(ctx, erc20, contract, types.ModuleAddress)
+	if after == nil {
+		return nil, sdkerrors.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+	before := k.bankKeeper.GetSupply(ctx, pair.Denom).Amount.BigInt()
+
+	// amount of the transfers left to convert
+	pending := big.NewInt(0)
+	for _, log := range logs {
+		if log.Address != contract || len(log.Topics) != 3 {
+			continue
+		}
+		if log.Topics[0] != erc20.Events[types.ERC20EventTransfer].ID {
+			continue
+		}
+		if common.BytesToAddress(log.Topics[2].Bytes()) != types.ModuleAddress {
+			continue
+		}
+
+		transferEvent, err := erc20.Unpack(types.ERC20EventTransfer, log.Data)
+		if err != nil || len(transferEvent) == 0 {
+			continue
+		}
+		if amount, ok := transferEvent[0].(*big.Int); ok && amount != nil && amount.Sign() == 1 {
+			pending.Add(pending, amount)
+		}
+	}
+
+	received, err := receivedTokens(pair, before, after, pending)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: the share of the last transfer includes the rounding remainders
+	return received.Mul(received, tokens).Quo(received, pending), nil
+}
@@ -32,7 +32,7 @@ func (suite *KeeperTestSuite) TestEvmHooksRegisteredERC20() {
 		{
 			"correct execution",
 			func(contractAddr common.Address) {
-				_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOR_STANDARD, common.Address{})
 				suite.Require().NoError(err)
 
 				// Mint 10 tokens to suite.address (owner)
@@ -59,7 +59,7 @@ func (suite *KeeperTestSuite) TestEvmHooksRegisteredERC20() {
 		{
 			"wrong event",
 			func(contractAddr common.Address) {
-				_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOR_STANDARD, common.Address{})
 				suite.Require().NoError(err)
 
 				// Mint 10 tokens to suite.address (owner)
@@ -70,7 +70,7 @@ func (suite *KeeperTestSuite) TestEvmHooksRegisteredERC20() {
 		{
 			"Pair is disabled",
 			func(contractAddr common.Address) {
-				pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOR_STANDARD, common.Address{})
 				suite.Require().NoError(err)
 
 				pair.Enabled = false
@@ -87,7 +87,7 @@ func (suite *KeeperTestSuite) TestEvmHooksRegisteredERC20() {
 		{
 			"Pair is incorrectly loaded",
 			func(contractAddr common.Address) {
-				pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOR_STANDARD, common.Address{})
 				suite.Require().NoError(err)
 
 				suite.app.Erc20Keeper.DeleteTokenPair(suite.ctx, *pair)
@@ -316,7 +316,7 @@ func (suite *KeeperTestSuite) TestPostTxProcessing() {
 				suite.Require().NoError(err)
 				suite.Commit()
 
-				_, err = suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				_, err = suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOR_STANDARD, common.Address{})
 				suite.Require().NoError(err)
 
 				topics := []common.Hash{transferEvent.ID, account.Hash(), account.Hash()}
@@ -338,7 +338,11 @@ func (suite *KeeperTestSuite) TestPostTxProcessing() {
 				suite.Require().NoError(err)
 				suite.Commit()
 
-				pair, err = suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				pair, err = suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOR_STANDARD, common.Address{})
+				suite.Require().NoError(err)
+
+				// escrow the tokens transferred by the log
+				_, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, suite.address, contractAddr, true, "mint", types.ModuleAddress, big.NewInt(10))
 				suite.Require().NoError(err)
 
 				topics := []common.Hash{transferEvent.ID, account.Hash(), types.ModuleAddress.Hash()}
@@ -360,7 +364,7 @@ func (suite *KeeperTestSuite) TestPostTxProcessing() {
 				suite.Require().NoError(err)
 				suite.Commit()
 
-				pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOR_STANDARD, common.Address{})
 				suite.Require().NoError(err)
 
 				pair.ContractOwner = types.OWNER_UNSPECIFIED
@@ -385,7 +389,7 @@ func (suite *KeeperTestSuite) TestPostTxProcessing() {
 				suite.Require().NoError(err)
 				suite.Commit()
 
-				pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOR_STANDARD, common.Address{})
 				suite.Require().NoError(err)
 
 				pair.ContractOwner = types.OWNER_MODULE
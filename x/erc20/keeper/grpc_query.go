@@ -9,8 +9,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
 	ethermint "github.com/evmos/ethermint/types"
 
+	"github.com/ArableProtocol/acrechain/contracts"
 	"github.com/ArableProtocol/acrechain/x/erc20/types"
 )
 
@@ -83,3 +85,62 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 	params := k.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// Balances returns the bank and ERC20 balances of an account for every
+// registered token pair
+func (k Keeper) Balances(c context.Context, req *types.QueryBalancesRequest) (*types.QueryBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var address common.Address
+	if common.IsHexAddress(req.Address) {
+		address = common.HexToAddress(req.Address)
+	} else {
+		accAddr, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid format for address %s, should be either hex ('0x...') or bech32", req.Address,
+			)
+		}
+		address = common.BytesToAddress(accAddr)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	var balances []types.TokenPairBalance
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var pair types.TokenPair
+		if err := k.cdc.Unmarshal(value, &pair); err != nil {
+			return err
+		}
+
+		coin := k.bankKeeper.GetBalance(ctx, address.Bytes(), pair.Denom)
+
+		// the balance is zero if the contract call fails, e.g. when the
+		// contract self-destructed
+		erc20Balance := sdk.ZeroInt()
+		if balance := k.BalanceOf(ctx, erc20, pair.GetERC20Contract(), address); balance != nil {
+			erc20Balance = sdk.NewIntFromBigInt(balance)
+		}
+
+		balances = append(balances, types.TokenPairBalance{
+			TokenPair:    pair,
+			Coin:         coin,
+			Erc20Balance: erc20Balance,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBalancesResponse{
+		Balances:   balances,
+		Pagination: pageRes,
+	}, nil
+}
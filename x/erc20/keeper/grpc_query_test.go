@@ -168,3 +168,108 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestBalances() {
+	var (
+		req    *types.QueryBalancesRequest
+		expRes *types.QueryBalancesResponse
+	)
+
+	setupBalances := func() types.TokenPair {
+		_, pair := suite.setupRegisterCoin()
+
+		sender := sdk.AccAddress(suite.address.Bytes())
+		coins := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, 100))
+		suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+		suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins))
+
+		msg := types.NewMsgConvertCoin(sdk.NewInt64Coin(cosmosTokenBase, 40), suite.address, sender)
+		_, err := suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+		suite.Require().NoError(err)
+		suite.Commit()
+		return *pair
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid address",
+			func() {
+				req = &types.QueryBalancesRequest{Address: "invalid"}
+			},
+			false,
+		},
+		{
+			"no pairs registered",
+			func() {
+				req = &types.QueryBalancesRequest{Address: suite.address.String()}
+				expRes = &types.QueryBalancesResponse{Pagination: &query.PageResponse{}}
+			},
+			true,
+		},
+		{
+			"hex address",
+			func() {
+				pair := setupBalances()
+				req = &types.QueryBalancesRequest{Address: suite.address.String()}
+				expRes = &types.QueryBalancesResponse{
+					Pagination: &query.PageResponse{Total: 1},
+					Balances: []types.TokenPairBalance{
+						{TokenPair: pair, Coin: sdk.NewInt64Coin(cosmosTokenBase, 60), Erc20Balance: sdk.NewInt(40)},
+					},
+				}
+			},
+			true,
+		},
+		{
+			"bech32 address w/pagination",
+			func() {
+				pair := setupBalances()
+				req = &types.QueryBalancesRequest{
+					Address:    sdk.AccAddress(suite.address.Bytes()).String(),
+					Pagination: &query.PageRequest{Limit: 10, CountTotal: true},
+				}
+				expRes = &types.QueryBalancesResponse{
+					Pagination: &query.PageResponse{Total: 1},
+					Balances: []types.TokenPairBalance{
+						{TokenPair: pair, Coin: sdk.NewInt64Coin(cosmosTokenBase, 60), Erc20Balance: sdk.NewInt(40)},
+					},
+				}
+			},
+			true,
+		},
+		{
+			"no balances",
+			func() {
+				pair := setupBalances()
+				req = &types.QueryBalancesRequest{Address: tests.GenerateAddress().String()}
+				expRes = &types.QueryBalancesResponse{
+					Pagination: &query.PageResponse{Total: 1},
+					Balances: []types.TokenPairBalance{
+						{TokenPair: pair, Coin: sdk.NewInt64Coin(cosmosTokenBase, 0), Erc20Balance: sdk.ZeroInt()},
+					},
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.Balances(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/ArableProtocol/acrechain/contracts"
+	"github.com/ArableProtocol/acrechain/ibc"
+	"github.com/ArableProtocol/acrechain/x/erc20/types"
+)
+
+// OnRecvPacket converts the IBC vouchers received through an ICS-20 transfer
+// into their ERC20 representation, for the recipient's hex address. The
+// conversion is only performed when:
+//   - the ERC20 conversions are enabled
+//   - the packet was received on one of the auto convert channels
+//   - the received denom is registered in an enabled token pair
+//
+// A failed conversion doesn't fail the transfer: the recipient keeps the
+// received coins and can convert them with a MsgConvertCoin.
+func (k Keeper) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ack exported.Acknowledgement,
+) exported.Acknowledgement {
+	// the transfer failed, there is nothing to convert
+	if !ack.Success() {
+		return ack
+	}
+
+	params := k.GetParams(ctx)
+	if !params.EnableErc20 || !params.IsAutoConvertChannel(packet.GetDestChannel()) {
+		return ack
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return ack
+	}
+
+	_, recipient, _, _, err := ibc.GetTransferSenderRecipient(packet)
+	if err != nil {
+		return ack
+	}
+
+	amountStr, err := ibc.GetTransferAmount(packet)
+	if err != nil {
+		return ack
+	}
+	amount, ok := sdk.NewIntFromString(amountStr)
+	if !ok || !amount.IsPositive() {
+		return ack
+	}
+
+	denom := ReceivedDenom(packet, data.Denom)
+	id := k.GetTokenPairID(ctx, denom)
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found || !pair.ConversionEnabled(false) {
+		return ack
+	}
+
+	msg := types.NewMsgConvertCoin(
+		sdk.NewCoin(denom, amount),
+		common.BytesToAddress(recipient.Bytes()),
+		recipient,
+	)
+
+	// convert in a cached context, so that a failed conversion leaves the received coins untouched
+	cacheCtx, writeCache := ctx.CacheContext()
+	res, err := k.ConvertCoin(sdk.WrapSDKContext(cacheCtx), msg)
+	if err != nil {
+		k.Logger(ctx).Error(
+			"failed to convert received IBC vouchers",
+			"denom", denom,
+			"recipient", recipient.String(),
+			"error", err.Error(),
+		)
+		return ack
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	// the token pair was deleted as its contract self-destructed, or disabled
+	// by the circuit breaker
+	if res == nil {
+		return ack
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIBCAutoConvert,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.GetDestChannel()),
+		),
+	)
+
+	return ack
+}
+
+// ConvertERC20Shortfall converts the sender's ERC20 tokens paired with the
+// coin's denom into coins, so that the sender's bank balance covers the coin
+// about to be sent through IBC. Only the shortfall is converted, and nothing is
+// converted when:
+//   - the bank balance already covers the coin
+//   - the ERC20 conversions are disabled
+//   - the denom is not registered in an enabled token pair
+//   - the ERC20 balance doesn't cover the shortfall either
+//
+// An error is returned when the conversion fails. False is returned when the
+// conversion tripped the circuit breaker, or removed the token pair of a
+// self-destructed contract. The transfer must not be attempted then, but the
+// caller must not fail the tx either, so that the token pair changes persist.
+func (k Keeper) ConvertERC20Shortfall(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) (bool, error) {
+	balance := k.bankKeeper.GetBalance(ctx, sender, coin.Denom)
+	if balance.Amount.GTE(coin.Amount) {
+		return true, nil
+	}
+
+	params := k.GetParams(ctx)
+	if !params.EnableErc20 {
+		return true, nil
+	}
+
+	id := k.GetTokenPairID(ctx, coin.Denom)
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found || !pair.ConversionEnabled(true) {
+		return true, nil
+	}
+
+	shortfall := coin.Amount.Sub(balance.Amount)
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	senderHex := common.BytesToAddress(sender.Bytes())
+	balanceToken := k.BalanceOf(ctx, erc20, pair.GetERC20Contract(), senderHex)
+	if balanceToken == nil {
+		return false, sdkerrors.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+	if balanceToken.Cmp(shortfall.BigInt()) < 0 {
+		return true, nil
+	}
+
+	msg := types.NewMsgConvertERC20(shortfall, sender, pair.GetERC20Contract(), senderHex)
+	res, err := k.ConvertERC20(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return false, sdkerrors.Wrapf(err, "failed to convert %s of %s before the transfer", shortfall, pair.Erc20Address)
+	}
+
+	// the token pair was deleted as its contract self-destructed, or disabled
+	// by the circuit breaker
+	if res == nil {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// ReceivedDenom returns the denom of the coins received on this chain for an
+// ICS-20 packet, given the denom of the packet data.
+func ReceivedDenom(packet channeltypes.Packet, packetDenom string) string {
+	// the coins are returning to this chain, the denom is unwound by one hop
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), packetDenom) {
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedDenom := packetDenom[len(voucherPrefix):]
+
+		denomTrace := transfertypes.ParseDenomTrace(unprefixedDenom)
+		if denomTrace.Path != "" {
+			return denomTrace.IBCDenom()
+		}
+		return unprefixedDenom
+	}
+
+	prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), packetDenom)
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
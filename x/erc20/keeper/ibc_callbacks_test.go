@@ -0,0 +1,297 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/ArableProtocol/acrechain/contracts"
+	acretransfer "github.com/ArableProtocol/acrechain/ibc/transfer"
+	"github.com/ArableProtocol/acrechain/x/erc20/keeper"
+	"github.com/ArableProtocol/acrechain/x/erc20/types"
+	minttypes "github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+const (
+	uatomChannel0Voucher = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	autoConvertChannel   = "channel-0"
+)
+
+func (suite *KeeperTestSuite) setupRegisterReceivedVoucher() *types.TokenPair {
+	suite.SetupTest()
+
+	metadata := banktypes.Metadata{
+		Description: "ATOM IBC voucher (channel 0)",
+		Base:        uatomChannel0Voucher,
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    uatomChannel0Voucher,
+				Exponent: 0,
+			},
+		},
+		Name:    "ATOM channel-0",
+		Symbol:  "ibcATOM-0",
+		Display: uatomChannel0Voucher,
+	}
+
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, sdk.Coins{sdk.NewInt64Coin(metadata.Base, 1)})
+	suite.Require().NoError(err)
+
+	pair, err := suite.app.Erc20Keeper.RegisterCoin(suite.ctx, metadata)
+	suite.Require().NoError(err)
+	suite.Commit()
+	return pair
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	senderAddr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	recipientAddr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	amount := sdk.NewInt(100)
+
+	packetWithAmount := func(denom, destChannel, amount string) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(denom, amount, senderAddr.String(), recipientAddr.String())
+		return channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, "channel-7", transfertypes.PortID, destChannel, clienttypes.NewHeight(0, 100), 0)
+	}
+	packetFor := func(denom, destChannel string) channeltypes.Packet {
+		return packetWithAmount(denom, destChannel, amount.String())
+	}
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		packet     channeltypes.Packet
+		ack        bool
+		expConvert bool
+	}{
+		{
+			"ok - voucher converted",
+			func() {},
+			packetFor("uatom", autoConvertChannel),
+			true,
+			true,
+		},
+		{
+			"no-op - failed transfer",
+			func() {},
+			packetFor("uatom", autoConvertChannel),
+			false,
+			false,
+		},
+		{
+			"no-op - channel not enabled",
+			func() {},
+			packetFor("uatom", "channel-1"),
+			true,
+			false,
+		},
+		{
+			"no-op - unregistered denom",
+			func() {},
+			packetFor("uosmo", autoConvertChannel),
+			true,
+			false,
+		},
+		{
+			"no-op - invalid amount",
+			func() {},
+			packetWithAmount("uatom", autoConvertChannel, "-100"),
+			true,
+			false,
+		},
+		{
+			"no-op - erc20 disabled",
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.EnableErc20 = false
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			},
+			packetFor("uatom", autoConvertChannel),
+			true,
+			false,
+		},
+		{
+			"no-op - token pair disabled",
+			func() {
+				_, err := suite.app.Erc20Keeper.ToggleConversion(suite.ctx, uatomChannel0Voucher)
+				suite.Require().NoError(err)
+			},
+			packetFor("uatom", autoConvertChannel),
+			true,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			pair := suite.setupRegisterReceivedVoucher()
+
+			params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+			params.IBCAutoConvertChannels = []string{autoConvertChannel}
+			suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			tc.malleate()
+
+			// the transfer application mints the received vouchers
+			denom := keeper.ReceivedDenom(tc.packet, "uatom")
+			coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+			suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, coins))
+			suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, recipientAddr, coins))
+
+			var ack channeltypes.Acknowledgement
+			if tc.ack {
+				ack = channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+			} else {
+				ack = channeltypes.NewErrorAcknowledgement("transfer failed")
+			}
+
+			res := suite.app.Erc20Keeper.OnRecvPacket(suite.ctx, tc.packet, ack)
+			suite.Require().Equal(ack, res)
+
+			erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+			balanceToken := suite.app.Erc20Keeper.BalanceOf(suite.ctx, erc20, pair.GetERC20Contract(), common.BytesToAddress(recipientAddr.Bytes()))
+			balanceCoin := suite.app.BankKeeper.GetBalance(suite.ctx, recipientAddr, denom)
+			if tc.expConvert {
+				suite.Require().Equal(amount.BigInt(), balanceToken)
+				suite.Require().True(balanceCoin.IsZero())
+			} else {
+				suite.Require().Equal(big.NewInt(0).Int64(), balanceToken.Int64())
+				suite.Require().Equal(amount, balanceCoin.Amount)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestReceivedDenom() {
+	testCases := []struct {
+		name        string
+		sourceChan  string
+		packetDenom string
+		expDenom    string
+	}{
+		{"counterparty native denom", "channel-7", "uatom", uatomChannel0Voucher},
+		{"returning native denom", "channel-7", "transfer/channel-7/aacre", "aacre"},
+		{"returning voucher", "channel-7", "transfer/channel-7/transfer/channel-0/uatom", uatomChannel0Voucher},
+	}
+
+	for _, tc := range testCases {
+		packet := channeltypes.NewPacket(nil, 1, transfertypes.PortID, tc.sourceChan, transfertypes.PortID, autoConvertChannel, clienttypes.NewHeight(0, 100), 0)
+		suite.Require().Equal(tc.expDenom, keeper.ReceivedDenom(packet, tc.packetDenom), tc.name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertERC20Shortfall() {
+	testCases := []struct {
+		name        string
+		bankAmount  int64
+		erc20Amount int64
+		sendAmount  int64
+		malleate    func()
+		expBank     int64
+		expERC20    int64
+	}{
+		{"no-op - bank balance covers the transfer", 60, 40, 50, func() {}, 60, 40},
+		{"ok - shortfall converted", 30, 70, 50, func() {}, 50, 50},
+		{"ok - whole ERC20 balance converted", 30, 20, 50, func() {}, 50, 0},
+		{"no-op - insufficient ERC20 balance", 30, 10, 50, func() {}, 30, 10},
+		{
+			"no-op - erc20 disabled", 30, 70, 50,
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.EnableErc20 = false
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			},
+			30, 70,
+		},
+		{
+			"no-op - token pair disabled", 30, 70, 50,
+			func() {
+				_, err := suite.app.Erc20Keeper.ToggleConversion(suite.ctx, cosmosTokenBase)
+				suite.Require().NoError(err)
+			},
+			30, 70,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			_, pair := suite.setupRegisterCoin()
+			sender := sdk.AccAddress(suite.address.Bytes())
+
+			coins := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, tc.bankAmount+tc.erc20Amount))
+			suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, coins))
+			suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, sender, coins))
+			if tc.erc20Amount > 0 {
+				msg := types.NewMsgConvertCoin(sdk.NewInt64Coin(cosmosTokenBase, tc.erc20Amount), suite.address, sender)
+				_, err := suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+			}
+			tc.malleate()
+
+			ok, err := suite.app.Erc20Keeper.ConvertERC20Shortfall(suite.ctx, sender, sdk.NewInt64Coin(cosmosTokenBase, tc.sendAmount))
+			suite.Require().NoError(err)
+			suite.Require().True(ok)
+
+			erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+			balanceToken := suite.app.Erc20Keeper.BalanceOf(suite.ctx, erc20, pair.GetERC20Contract(), suite.address)
+			balanceCoin := suite.app.BankKeeper.GetBalance(suite.ctx, sender, cosmosTokenBase)
+			suite.Require().Equal(tc.expBank, balanceCoin.Amount.Int64())
+			suite.Require().Equal(tc.expERC20, balanceToken.Int64())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertERC20ShortfallCircuitBreaker() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+
+	contractAddr := suite.setupRegisterERC20Pair(contractDirectBalanceManipulation)
+	suite.Commit()
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+	suite.Commit()
+
+	// the conversion trips the circuit breaker, the transfer must not go on
+	coinName := types.CreateDenom(contractAddr.String())
+	ok, err := suite.app.Erc20Keeper.ConvertERC20Shortfall(suite.ctx, sender, sdk.NewInt64Coin(coinName, 10))
+	suite.Require().NoError(err)
+	suite.Require().False(ok)
+
+	balanceCoin := suite.app.BankKeeper.GetBalance(suite.ctx, sender, coinName)
+	suite.Require().True(balanceCoin.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestTransferCircuitBreaker() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+
+	contractAddr := suite.setupRegisterERC20Pair(contractDirectBalanceManipulation)
+	suite.Commit()
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+	suite.Commit()
+
+	// the transfer msg doesn't fail when the conversion trips the circuit
+	// breaker, so the disabled token pair persists once the tx is delivered
+	coinName := types.CreateDenom(contractAddr.String())
+	transferKeeper := acretransfer.NewKeeper(suite.app.TransferKeeper, suite.app.Erc20Keeper)
+	msg := transfertypes.NewMsgTransfer(transfertypes.PortID, "channel-0", sdk.NewInt64Coin(coinName, 10), sender.String(), "receiver", clienttypes.NewHeight(0, 100), 0)
+	_, err := transferKeeper.Transfer(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, suite.app.Erc20Keeper.GetERC20Map(suite.ctx, contractAddr))
+	suite.Require().True(found)
+	suite.Require().False(pair.Enabled)
+
+	balanceCoin := suite.app.BankKeeper.GetBalance(suite.ctx, sender, coinName)
+	suite.Require().True(balanceCoin.IsZero())
+	suite.mintFeeCollector = false
+}
@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ArableProtocol/acrechain/contracts"
+	"github.com/ArableProtocol/acrechain/x/erc20/types"
+)
+
+// RegisterInvariants registers all erc20 invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "token-pair-indexes", TokenPairIndexesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "native-coin-escrow", NativeCoinEscrowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "native-erc20-escrow", NativeERC20EscrowInvariant(k))
+}
+
+// AllInvariants runs all invariants of the erc20 module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := TokenPairIndexesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = NativeCoinEscrowInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return NativeERC20EscrowInvariant(k)(ctx)
+	}
+}
+
+// TokenPairIndexesInvariant checks that every token pair is stored under its id
+// and indexed by its ERC20 address and denom, and that every index entry points
+// to a token pair with the indexed ERC20 address or denom.
+func TokenPairIndexesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		store := ctx.KVStore(k.storeKey)
+		pairStore := prefix.NewStore(store, types.KeyPrefixTokenPair)
+		iterator := pairStore.Iterator(nil, nil)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			var pair types.TokenPair
+			k.cdc.MustUnmarshal(iterator.Value(), &pair)
+
+			id := pair.GetID()
+			if !bytes.Equal(iterator.Key(), id) {
+				count++
+				msg += fmt.Sprintf("\ttoken pair %s stored under id %X instead of %X\n", pair.Denom, iterator.Key(), id)
+			}
+			if erc20ID := k.GetERC20Map(ctx, pair.GetERC20Contract()); !bytes.Equal(erc20ID, id) {
+				count++
+				msg += fmt.Sprintf("\tERC20 %s indexed to id %X instead of %X\n", pair.Erc20Address, erc20ID, id)
+			}
+			if denomID := k.GetDenomMap(ctx, pair.Denom); !bytes.Equal(denomID, id) {
+				count++
+				msg += fmt.Sprintf("\tdenom %s indexed to id %X instead of %X\n", pair.Denom, denomID, id)
+			}
+		}
+
+		erc20Iterator := prefix.NewStore(store, types.KeyPrefixTokenPairByERC20).Iterator(nil, nil)
+		defer erc20Iterator.Close()
+
+		for ; erc20Iterator.Valid(); erc20Iterator.Next() {
+			contract := common.BytesToAddress(erc20Iterator.Key())
+			pair, found := k.GetTokenPair(ctx, erc20Iterator.Value())
+			if !found || pair.GetERC20Contract() != contract {
+				count++
+				msg += fmt.Sprintf("\tERC20 %s indexed to id %X of another token pair\n", contract, erc20Iterator.Value())
+			}
+		}
+
+		denomIterator := prefix.NewStore(store, types.KeyPrefixTokenPairByDenom).Iterator(nil, nil)
+		defer denomIterator.Close()
+
+		for ; denomIterator.Valid(); denomIterator.Next() {
+			denom := string(denomIterator.Key())
+			pair, found := k.GetTokenPair(ctx, denomIterator.Value())
+			if !found || pair.Denom != denom {
+				count++
+				msg += fmt.Sprintf("\tdenom %s indexed to id %X of another token pair\n", denom, denomIterator.Value())
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "token pair indexes",
+			fmt.Sprintf("found %d inconsistent token pair index entries\n%s", count, msg)), broken
+	}
+}
+
+// NativeCoinEscrowInvariant checks that the coins escrowed in the module account
+// back the total supply of the ERC20 representation of every native Cosmos coin.
+// The escrow is not required to equal the total supply: the contracts deployed
+// by the module let holders burn their tokens without converting them back, and
+// the coins escrowed for the burned tokens stay locked in the module account. A
+// surplus only means locked coins, while a shortfall means ERC20 tokens that
+// can't be converted back.
+func NativeCoinEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+
+		for _, pair := range k.GetTokenPairs(ctx) {
+			if !pair.IsNativeCoin() {
+				continue
+			}
+
+			escrow := k.bankKeeper.GetBalance(ctx, moduleAddr, pair.Denom).Amount
+			supply := k.TotalSupply(ctx, erc20, pair.GetERC20Contract())
+			if supply == nil || escrow.BigInt().Cmp(supply) < 0 {
+				count++
+				msg += fmt.Sprintf("\tescrowed %s%s, ERC20 %s total supply %v\n", escrow, pair.Denom, pair.Erc20Address, supply)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "native coin escrow",
+			fmt.Sprintf("found %d native coins not backing their ERC20 supply\n%s", count, msg)), broken
+	}
+}
+
+// NativeERC20EscrowInvariant checks that the ERC20 tokens escrowed in the module
+// address equal the supply of the Cosmos coin representation of every native
+// ERC20. Self-destructed contracts are skipped, as their token pairs are removed
+// on the next conversion.
+func NativeERC20EscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+		for _, pair := range k.GetTokenPairs(ctx) {
+			if !pair.IsNativeERC20() {
+				continue
+			}
+
+			contract := pair.GetERC20Contract()
+			if acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract); acc == nil || !acc.IsContract() {
+				continue
+			}
+
+			supply := k.bankKeeper.GetSupply(ctx, pair.Denom).Amount
+			escrow := k.BalanceOf(ctx, erc20, contract, types.ModuleAddress)
+			if escrow == nil {
+				count++
+				msg += fmt.Sprintf("\tfailed to query the ERC20 %s balance of the module address\n", pair.Erc20Address)
+				continue
+			}
+			if escrow.Cmp(supply.BigInt()) != 0 {
+				count++
+				msg += fmt.Sprintf("\tescrowed %v ERC20 %s, coin supply %s%s\n", escrow, pair.Erc20Address, supply, pair.Denom)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "native erc20 escrow",
+			fmt.Sprintf("found %d native ERC20s not matching their coin supply\n%s", count, msg)), broken
+	}
+}
+
+// checkNativeERC20Escrow checks that the ERC20 tokens escrowed in the module
+// address back the supply of the Cosmos coin representation of a native ERC20,
+// once the given amount of coins is minted. It is checked on conversion as
+// well as by NativeERC20EscrowInvariant, so that a contract that drains the
+// escrow trips the circuit breaker of its token pair before more coins are
+// minted against it. Only a shortfall fails the check, a surplus is reported by
+// the invariant.
+func (k Keeper) checkNativeERC20Escrow(ctx sdk.Context, pair types.TokenPair, minted sdk.Int) error {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	escrow := k.BalanceOf(ctx, erc20, pair.GetERC20Contract(), types.ModuleAddress)
+	if escrow == nil {
+		return sdkerrors.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, pair.Denom).Amount.Add(minted)
+	if escrow.Cmp(supply.BigInt()) < 0 {
+		return sdkerrors.Wrapf(
+			types.ErrBalanceInvariance,
+			"escrowed tokens do not back the coin supply - escrow: %v, supply: %s", escrow, supply,
+		)
+	}
+
+	return nil
+}
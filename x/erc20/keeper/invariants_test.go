@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ArableProtocol/acrechain/contracts"
+	"github.com/ArableProtocol/acrechain/x/erc20/keeper"
+	"github.com/ArableProtocol/acrechain/x/erc20/types"
+)
+
+// thief is the address hardcoded in the malicious contract fixtures.
+var thief = common.HexToAddress("0x4dC6ac40Af078661fc43823086E1513635Eeab14")
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	var (
+		coinPair *types.TokenPair
+		contract common.Address
+	)
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	testCases := []struct {
+		name         string
+		contractType int
+		malleate     func()
+		invariant    func(keeper.Keeper) sdk.Invariant
+		expBroken    bool
+	}{
+		{
+			"all invariants hold after conversions",
+			contractMinterBurner,
+			func() {},
+			keeper.AllInvariants,
+			false,
+		},
+		{
+			"ERC20 index of a deleted token pair",
+			contractMinterBurner,
+			func() {
+				id := suite.app.Erc20Keeper.GetERC20Map(suite.ctx, contract)
+				pair, _ := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+				suite.app.Erc20Keeper.DeleteTokenPair(suite.ctx, pair)
+				suite.app.Erc20Keeper.SetERC20Map(suite.ctx, contract, id)
+			},
+			keeper.TokenPairIndexesInvariant,
+			true,
+		},
+		{
+			"denom indexed to another token pair",
+			contractMinterBurner,
+			func() {
+				id := suite.app.Erc20Keeper.GetERC20Map(suite.ctx, contract)
+				suite.app.Erc20Keeper.SetDenomMap(suite.ctx, coinPair.Denom, id)
+			},
+			keeper.TokenPairIndexesInvariant,
+			true,
+		},
+		{
+			"token pair without index",
+			contractMinterBurner,
+			func() {
+				pair := types.NewTokenPair(common.HexToAddress("0x5dCA2483280D9727c80b5518faC4556617fb194F"), "unindexed", true, types.OWNER_MODULE)
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+			},
+			keeper.TokenPairIndexesInvariant,
+			true,
+		},
+		{
+			"ERC20 representation minted without escrow",
+			contractMinterBurner,
+			func() {
+				_, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, erc20, types.ModuleAddress, coinPair.GetERC20Contract(), true, "mint", suite.address, big.NewInt(1))
+				suite.Require().NoError(err)
+			},
+			keeper.NativeCoinEscrowInvariant,
+			true,
+		},
+		{
+			"coin representation minted without escrow",
+			contractMinterBurner,
+			func() {
+				denom := types.CreateDenom(contract.String())
+				coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 1))
+				suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+			},
+			keeper.NativeERC20EscrowInvariant,
+			true,
+		},
+		{
+			"tokens transferred to the module address of a disabled pair",
+			contractMinterBurner,
+			func() {
+				_, err := suite.app.Erc20Keeper.ToggleConversion(suite.ctx, contract.String())
+				suite.Require().NoError(err)
+				suite.TransferERC20TokenToModule(contract, suite.address, big.NewInt(10))
+			},
+			keeper.NativeERC20EscrowInvariant,
+			true,
+		},
+		{
+			"escrow drained through a delayed malicious contract",
+			contractMaliciousDelayed,
+			func() {
+				suite.TransferERC20TokenToModule(contract, suite.address, big.NewInt(10))
+				suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, thief.Bytes()))
+				_, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, erc20, thief, contract, true, "transferFrom", types.ModuleAddress, thief, big.NewInt(10))
+				suite.Require().NoError(err)
+			},
+			keeper.NativeERC20EscrowInvariant,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			suite.ensureHooksSet()
+
+			_, coinPair = suite.setupRegisterCoin()
+			sender := sdk.AccAddress(suite.address.Bytes())
+			coins := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, 100))
+			suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+			suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins))
+			_, err := suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), types.NewMsgConvertCoin(sdk.NewInt64Coin(cosmosTokenBase, 60), suite.address, sender))
+			suite.Require().NoError(err)
+
+			contract = suite.setupRegisterERC20Pair(tc.contractType)
+			if tc.contractType == contractMinterBurner {
+				suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(100))
+				_, err = suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), types.NewMsgConvertERC20(sdk.NewInt(40), sender, contract, suite.address))
+				suite.Require().NoError(err)
+			}
+
+			tc.malleate()
+
+			_, broken := tc.invariant(suite.app.Erc20Keeper)(suite.ctx)
+			suite.Require().Equal(tc.expBroken, broken)
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestNativeERC20EscrowCircuitBreaker() {
+	var contract common.Address
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	testCases := []struct {
+		name         string
+		contractType int
+		malleate     func()
+		convert      func()
+	}{
+		{
+			"coin representation minted without escrow",
+			contractMinterBurner,
+			func() {
+				denom := types.CreateDenom(contract.String())
+				coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 1))
+				suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+			},
+			func() {
+				sender := sdk.AccAddress(suite.address.Bytes())
+				res, err := suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), types.NewMsgConvertERC20(sdk.NewInt(10), sender, contract, suite.address))
+				suite.Require().NoError(err)
+				suite.Require().Nil(res)
+			},
+		},
+		{
+			"escrow drained through a delayed malicious contract",
+			contractMaliciousDelayed,
+			func() {
+				// the transfer to the module address approves the thief, who
+				// can then move the escrowed tokens
+				suite.TransferERC20TokenToModule(contract, suite.address, big.NewInt(10))
+				suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, thief.Bytes()))
+				_, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, erc20, thief, contract, true, "transferFrom", types.ModuleAddress, thief, big.NewInt(10))
+				suite.Require().NoError(err)
+			},
+			func() {
+				suite.TransferERC20TokenToModule(contract, suite.address, big.NewInt(10))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			suite.ensureHooksSet()
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			contract = suite.setupRegisterERC20Pair(tc.contractType)
+			if tc.contractType == contractMinterBurner {
+				suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(100))
+				_, err := suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), types.NewMsgConvertERC20(sdk.NewInt(40), sender, contract, suite.address))
+				suite.Require().NoError(err)
+			}
+
+			tc.malleate()
+			denom := types.CreateDenom(contract.String())
+			supply := suite.app.BankKeeper.GetSupply(suite.ctx, denom)
+
+			// the conversion is discarded and the token pair disabled
+			tc.convert()
+			suite.Require().Equal(supply, suite.app.BankKeeper.GetSupply(suite.ctx, denom))
+
+			id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, contract.String())
+			pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+			suite.Require().True(found)
+			suite.Require().False(pair.Enabled)
+		})
+	}
+	suite.mintFeeCollector = false
+}
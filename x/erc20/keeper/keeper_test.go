@@ -464,6 +464,15 @@ func (m *MockEVMKeeper) ApplyMessage(ctx sdk.Context, msg core.Message, tracer v
 	return args.Get(0).(*evmtypes.MsgEthereumTxResponse), args.Error(1)
 }
 
+func (m *MockEVMKeeper) GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash {
+	args := m.Called(mock.Anything, mock.Anything, mock.Anything)
+	return args.Get(0).(common.Hash)
+}
+
+func (m *MockEVMKeeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte) {
+	m.Called(mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 var _ types.BankKeeper = &MockBankKeeper{}
 
 type MockBankKeeper struct {
@@ -517,3 +526,8 @@ func (b *MockBankKeeper) GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom
 	args := b.Called(mock.Anything, mock.Anything)
 	return args.Get(0).(sdk.Coin)
 }
+
+func (b *MockBankKeeper) GetSupply(ctx sdk.Context, denom string) sdk.Coin {
+	args := b.Called(mock.Anything, mock.Anything)
+	return args.Get(0).(sdk.Coin)
+}
@@ -1,5 +1,11 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v3 "github.com/ArableProtocol/acrechain/x/erc20/migrations/v3"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper Keeper
@@ -11,3 +17,12 @@ func NewMigrator(keeper Keeper) Migrator {
 		keeper: keeper,
 	}
 }
+
+// Migrate2to3 migrates the store from consensus version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	if err := v3.MigrateParams(ctx, m.keeper.paramstore); err != nil {
+		return err
+	}
+
+	return v3.MigrateTokenPairs(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ArableProtocol/acrechain/x/erc20"
+	"github.com/ArableProtocol/acrechain/x/erc20/keeper"
+	"github.com/ArableProtocol/acrechain/x/erc20/types"
+)
+
+// v2Genesis is an erc20 genesis exported from a v2 chain. The ERC20 address of
+// the second token pair was registered in its lowercase form.
+const v2Genesis = `{
+  "params": {
+    "enable_erc20": true,
+    "enable_evm_hook": true
+  },
+  "token_pairs": [
+    {
+      "erc20_address": "0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd",
+      "denom": "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+      "enabled": true,
+      "contract_owner": "OWNER_MODULE"
+    },
+    {
+      "erc20_address": "0xd4949664cd82660aae99bedc034a0dea8a0bd517",
+      "denom": "erc20/0xd4949664cd82660aae99bedc034a0dea8a0bd517",
+      "enabled": false,
+      "contract_owner": "OWNER_EXTERNAL"
+    }
+  ]
+}`
+
+// setupV2State writes the given genesis to the store in its v2 layout.
+func (suite *KeeperTestSuite) setupV2State(genesis types.GenesisState) {
+	paramStore := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	paramStore.Set(types.ParamStoreKeyEnableErc20, []byte("true"))
+	paramStore.Set(types.ParamStoreKeyEnableEVMHook, []byte("true"))
+	paramStore.Delete(types.ParamStoreKeyIBCAutoConvertChannels)
+	paramStore.Delete(types.ParamStoreKeyRegistrationDeposit)
+	paramStore.Delete(types.ParamStoreKeyBurnRegistrationDeposit)
+	paramStore.Delete(types.ParamStoreKeyAllowedCodeHashes)
+	paramStore.Delete(types.ParamStoreKeyRegistrationCooldown)
+	paramStore.Delete(types.ParamStoreKeyReviewPeriod)
+
+	for _, pair := range genesis.TokenPairs {
+		id := pair.GetID()
+		suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+		suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, id)
+		suite.app.Erc20Keeper.SetERC20Map(suite.ctx, pair.GetERC20Contract(), id)
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	suite.SetupTest()
+
+	var genesis types.GenesisState
+	suite.Require().NoError(suite.app.AppCodec().UnmarshalJSON([]byte(v2Genesis), &genesis))
+	suite.setupV2State(genesis)
+
+	// index entries left behind by a token pair that is no longer stored
+	staleContract := common.HexToAddress("0x5dCA2483280D9727c80b5518faC4556617fb194F")
+	suite.app.Erc20Keeper.SetERC20Map(suite.ctx, staleContract, []byte("stale"))
+	suite.app.Erc20Keeper.SetDenomMap(suite.ctx, "stale", []byte("stale"))
+
+	err := keeper.NewMigrator(suite.app.Erc20Keeper).Migrate2to3(suite.ctx)
+	suite.Require().NoError(err)
+
+	exported := erc20.ExportGenesis(suite.ctx, suite.app.Erc20Keeper)
+	suite.Require().NoError(exported.Validate())
+	suite.Require().Equal(types.DefaultParams(), exported.Params)
+	suite.Require().Len(exported.TokenPairs, len(genesis.TokenPairs))
+
+	for _, pair := range genesis.TokenPairs {
+		contract := pair.GetERC20Contract()
+		pair.Erc20Address = contract.Hex()
+
+		id := suite.app.Erc20Keeper.GetERC20Map(suite.ctx, contract)
+		suite.Require().Equal(pair.GetID(), id)
+		suite.Require().Equal(id, suite.app.Erc20Keeper.GetDenomMap(suite.ctx, pair.Denom))
+
+		migrated, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+		suite.Require().True(found)
+		suite.Require().Equal(pair, migrated)
+	}
+
+	suite.Require().False(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, staleContract))
+	suite.Require().False(suite.app.Erc20Keeper.IsDenomRegistered(suite.ctx, "stale"))
+
+	// a migrated chain can be exported and imported again
+	suite.SetupTest()
+	erc20.InitGenesis(suite.ctx, suite.app.Erc20Keeper, suite.app.AccountKeeper, *exported)
+	suite.Require().Equal(exported, erc20.ExportGenesis(suite.ctx, suite.app.Erc20Keeper))
+}
+
+func (suite *KeeperTestSuite) TestMigrate2to3DuplicatedTokenPair() {
+	suite.SetupTest()
+
+	var genesis types.GenesisState
+	suite.Require().NoError(suite.app.AppCodec().UnmarshalJSON([]byte(v2Genesis), &genesis))
+
+	// the same ERC20 registered under its lowercase and checksummed addresses
+	duplicate := genesis.TokenPairs[1]
+	duplicate.Erc20Address = common.HexToAddress(duplicate.Erc20Address).Hex()
+	duplicate.Denom = "erc20/duplicate"
+	genesis.TokenPairs = append(genesis.TokenPairs, duplicate)
+	suite.setupV2State(genesis)
+
+	err := keeper.NewMigrator(suite.app.Erc20Keeper).Migrate2to3(suite.ctx)
+	suite.Require().Error(err)
+}
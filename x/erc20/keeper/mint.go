@@ -4,13 +4,16 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/ArableProtocol/acrechain/x/erc20/types"
 )
 
 // MintingEnabled checks that:
 //   - the global parameter for erc20 conversion is enabled
-//   - minting is enabled for the given (erc20,coin) token pair
+//   - minting is enabled for the given (erc20,coin) token pair. A token pair
+//     being deregistered only allows the conversions back to its native
+//     representation
 //   - recipient address is not on the blocked list
 //   - bank module transfers are enabled for the Cosmos coin
 func (k Keeper) MintingEnabled(
@@ -39,7 +42,16 @@ func (k Keeper) MintingEnabled(
 		)
 	}
 
-	if !pair.Enabled {
+	// the token is an ERC20 contract address when converting from ERC20 to coin
+	fromERC20 := common.IsHexAddress(token)
+	switch {
+	case pair.ConversionEnabled(fromERC20):
+	case pair.IsDeregistering() && pair.Enabled:
+		return types.TokenPair{}, sdkerrors.Wrapf(
+			types.ErrTokenPairDeregistering,
+			"token '%s' can only be converted back to its native representation until %d", token, pair.WithdrawalEndTime,
+		)
+	default:
 		return types.TokenPair{}, sdkerrors.Wrapf(
 			types.ErrERC20TokenPairDisabled, "minting token '%s' is not enabled by governance", token,
 		)
@@ -47,15 +47,39 @@ func (k Keeper) ConvertCoin(
 		return nil, nil
 	}
 
+	// Execute the conversion on a cache context, so that a conversion that
+	// trips the circuit breaker can be discarded while the disabled token pair
+	// is persisted. The conversion rate limits are consumed on the cache context
+	// as well, so that a discarded conversion doesn't count towards them
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	if err := k.consumeConversionLimits(cacheCtx, pair, sender, msg.Coin.Amount); err != nil {
+		return nil, err
+	}
+
 	// Check ownership and execute conversion
+	var res *types.MsgConvertCoinResponse
 	switch {
 	case pair.IsNativeCoin():
-		return k.convertCoinNativeCoin(ctx, pair, msg, receiver, sender) // case 1.1
+		res, err = k.convertCoinNativeCoin(cacheCtx, pair, msg, receiver, sender) // case 1.1
 	case pair.IsNativeERC20():
-		return k.convertCoinNativeERC20(ctx, pair, msg, receiver, sender) // case 2.2
+		res, err = k.convertCoinNativeERC20(cacheCtx, pair, msg, receiver, sender) // case 2.2
 	default:
 		return nil, types.ErrUndefinedOwner
 	}
+
+	if err != nil {
+		if k.tripCircuitBreaker(ctx, pair, err) {
+			// NOTE: return nil error to persist the disabled token pair
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return res, nil
 }
 
 // ConvertERC20 converts ERC20 tokens into native Cosmos coins for both
@@ -89,15 +113,158 @@ func (k Keeper) ConvertERC20(
 		return nil, nil
 	}
 
+	// Execute the conversion on a cache context, so that a conversion that
+	// trips the circuit breaker can be discarded while the disabled token pair
+	// is persisted. The conversion rate limits are consumed on the cache context
+	// as well, so that a discarded conversion doesn't count towards them
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	if err := k.consumeConversionLimits(cacheCtx, pair, sender.Bytes(), msg.Amount); err != nil {
+		return nil, err
+	}
+
 	// Check ownership and execute conversion
+	var res *types.MsgConvertERC20Response
 	switch {
 	case pair.IsNativeCoin():
-		return k.convertERC20NativeCoin(ctx, pair, msg, receiver, sender) // case 1.2
+		res, err = k.convertERC20NativeCoin(cacheCtx, pair, msg, receiver, sender) // case 1.2
 	case pair.IsNativeERC20():
-		return k.convertERC20NativeToken(ctx, pair, msg, receiver, sender) // case 2.1
+		res, err = k.convertERC20NativeToken(cacheCtx, pair, msg, receiver, sender) // case 2.1
 	default:
 		return nil, types.ErrUndefinedOwner
 	}
+
+	if err != nil {
+		if k.tripCircuitBreaker(ctx, pair, err) {
+			// NOTE: return nil error to persist the disabled token pair
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return res, nil
+}
+
+// RegisterERC20WithDeposit registers a token pair for an ERC20 token without
+// a governance proposal, in exchange for the registration deposit
+func (k Keeper) RegisterERC20WithDeposit(
+	goCtx context.Context,
+	msg *types.MsgRegisterERC20WithDeposit,
+) (*types.MsgRegisterERC20WithDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	contract := common.HexToAddress(msg.Erc20Address)
+
+	if _, err := k.registerERC20WithDeposit(ctx, sender, contract, msg.Behavior); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterERC20WithDepositResponse{}, nil
+}
+
+// ConvertCoinBatch converts several native Cosmos coins into ERC20 tokens.
+// Either all the conversions succeed or none is applied.
+func (k Keeper) ConvertCoinBatch(
+	goCtx context.Context,
+	msg *types.MsgConvertCoinBatch,
+) (*types.MsgConvertCoinBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	receiver := common.HexToAddress(msg.Receiver)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	// convert in a cached context, so that a failed conversion discards the
+	// previous conversions of the batch
+	cacheCtx, writeCache := ctx.CacheContext()
+	var events sdk.Events
+	for _, coin := range msg.Coins {
+		convertCtx := cacheCtx.WithEventManager(sdk.NewEventManager())
+		res, err := k.ConvertCoin(sdk.WrapSDKContext(convertCtx), types.NewMsgConvertCoin(coin, receiver, sender))
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to convert %s", coin)
+		}
+
+		// the token pair was deleted as its contract self-destructed, or
+		// disabled by the circuit breaker
+		if res == nil {
+			k.persistBatchTokenPair(ctx, convertCtx, coin.Denom)
+			// NOTE: return nil error to persist the token pair, while the
+			// conversions of the batch are discarded
+			return nil, nil
+		}
+		events = append(events, convertCtx.EventManager().Events()...)
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(events)
+	return &types.MsgConvertCoinBatchResponse{}, nil
+}
+
+// ConvertERC20Batch converts several ERC20 tokens into native Cosmos coins.
+// Either all the conversions succeed or none is applied.
+func (k Keeper) ConvertERC20Batch(
+	goCtx context.Context,
+	msg *types.MsgConvertERC20Batch,
+) (*types.MsgConvertERC20BatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	receiver := sdk.MustAccAddressFromBech32(msg.Receiver)
+	sender := common.HexToAddress(msg.Sender)
+
+	// convert in a cached context, so that a failed conversion discards the
+	// previous conversions of the batch
+	cacheCtx, writeCache := ctx.CacheContext()
+	var events sdk.Events
+	for _, token := range msg.Tokens {
+		contract := common.HexToAddress(token.ContractAddress)
+		convertCtx := cacheCtx.WithEventManager(sdk.NewEventManager())
+		res, err := k.ConvertERC20(sdk.WrapSDKContext(convertCtx), types.NewMsgConvertERC20(token.Amount, receiver, contract, sender))
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to convert %s of '%s'", token.Amount, token.ContractAddress)
+		}
+
+		// the token pair was deleted as its contract self-destructed, or
+		// disabled by the circuit breaker
+		if res == nil {
+			k.persistBatchTokenPair(ctx, convertCtx, token.ContractAddress)
+			// NOTE: return nil error to persist the token pair, while the
+			// conversions of the batch are discarded
+			return nil, nil
+		}
+		events = append(events, convertCtx.EventManager().Events()...)
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(events)
+	return &types.MsgConvertERC20BatchResponse{}, nil
+}
+
+// persistBatchTokenPair copies the token pair of a token from the cached
+// context of a batch conversion, in which it was deleted after a self-destruct
+// or disabled by the circuit breaker, to the context of the message, along
+// with the events of the conversion. The other changes of the batch are
+// discarded.
+func (k Keeper) persistBatchTokenPair(ctx, convertCtx sdk.Context, token string) {
+	id := k.GetTokenPairID(ctx, token)
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return
+	}
+
+	if updated, found := k.GetTokenPair(convertCtx, id); found {
+		k.SetTokenPair(ctx, updated)
+	} else {
+		k.DeleteTokenPair(ctx, pair)
+	}
+
+	ctx.EventManager().EmitEvents(convertCtx.EventManager().Events())
 }
 
 // convertCoinNativeCoin handles the coin conversion for a native Cosmos coin
@@ -289,11 +456,13 @@ func (k Keeper) convertERC20NativeCoin(
 // convertERC20NativeToken handles the erc20 conversion for a native erc20 token
 // pair:
 //   - escrow tokens on module account
-//   - mint coins on bank module
+//   - mint coins on bank module for the escrowed amount, which is less than the
+//     amount for fee-on-transfer tokens
 //   - send minted coins to the receiver
 //   - check if coin balance increased by amount
 //   - check if token balance decreased by amount
 //   - check for unexpected `Approval` event in logs
+//   - check if the escrowed tokens back the coin supply
 func (k Keeper) convertERC20NativeToken(
 	ctx sdk.Context,
 	pair types.TokenPair,
@@ -301,8 +470,6 @@ func (k Keeper) convertERC20NativeToken(
 	receiver sdk.AccAddress,
 	sender common.Address,
 ) (*types.MsgConvertERC20Response, error) {
-	// NOTE: coin fields already validated
-	coins := sdk.Coins{sdk.Coin{Denom: pair.Denom, Amount: msg.Amount}}
 	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
 	contract := pair.GetERC20Contract()
 	balanceCoin := k.bankKeeper.GetBalance(ctx, receiver, pair.Denom)
@@ -333,22 +500,19 @@ func (k Keeper) convertERC20NativeToken(
 	}
 
 	// Check expected escrow balance after transfer execution
-	tokens := coins[0].Amount.BigInt()
 	balanceTokenAfter := k.BalanceOf(ctx, erc20, contract, types.ModuleAddress)
 	if balanceTokenAfter == nil {
 		return nil, sdkerrors.Wrap(types.ErrEVMCall, "failed to retrieve balance")
 	}
 
-	expToken := big.NewInt(0).Add(balanceToken, tokens)
-
-	if r := balanceTokenAfter.Cmp(expToken); r != 0 {
-		return nil, sdkerrors.Wrapf(
-			types.ErrBalanceInvariance,
-			"invalid token balance - expected: %v, actual: %v",
-			expToken, balanceTokenAfter,
-		)
+	escrowed, err := receivedTokens(pair, balanceToken, balanceTokenAfter, msg.Amount.BigInt())
+	if err != nil {
+		return nil, err
 	}
 
+	// NOTE: fee-on-transfer tokens only mint the amount actually escrowed
+	coins := sdk.Coins{sdk.Coin{Denom: pair.Denom, Amount: sdk.NewIntFromBigInt(escrowed)}}
+
 	// Mint coins
 	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
 		return nil, err
@@ -376,6 +540,11 @@ func (k Keeper) convertERC20NativeToken(
 		return nil, err
 	}
 
+	// Check that the escrowed tokens still back the coin supply
+	if err := k.checkNativeERC20Escrow(ctx, pair, sdk.ZeroInt()); err != nil {
+		return nil, err
+	}
+
 	defer func() {
 		telemetry.IncrCounterWithLabels(
 			[]string{"tx", "msg", "convert", "erc20", "total"},
@@ -404,7 +573,7 @@ func (k Keeper) convertERC20NativeToken(
 				types.EventTypeConvertERC20,
 				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
 				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
-				sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, coins[0].Amount.String()),
 				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
 				sdk.NewAttribute(types.AttributeKeyERC20Token, msg.ContractAddress),
 			),
@@ -421,6 +590,7 @@ func (k Keeper) convertERC20NativeToken(
 //   - burn escrowed Coins
 //   - check if token balance increased by amount
 //   - check for unexpected `Approval` event in logs
+//   - check if the escrowed tokens back the coin supply
 func (k Keeper) convertCoinNativeERC20(
 	ctx sdk.Context,
 	pair types.TokenPair,
@@ -460,19 +630,13 @@ func (k Keeper) convertCoinNativeERC20(
 	}
 
 	// Check expected Receiver balance after transfer execution
-	tokens := msg.Coin.Amount.BigInt()
 	balanceTokenAfter := k.BalanceOf(ctx, erc20, contract, receiver)
 	if balanceTokenAfter == nil {
 		return nil, sdkerrors.Wrap(types.ErrEVMCall, "failed to retrieve balance")
 	}
 
-	exp := big.NewInt(0).Add(balanceToken, tokens)
-
-	if r := balanceTokenAfter.Cmp(exp); r != 0 {
-		return nil, sdkerrors.Wrapf(
-			types.ErrBalanceInvariance,
-			"invalid token balance - expected: %v, actual: %v", exp, balanceTokenAfter,
-		)
+	if _, err := receivedTokens(pair, balanceToken, balanceTokenAfter, msg.Coin.Amount.BigInt()); err != nil {
+		return nil, err
 	}
 
 	// Burn escrowed Coins
@@ -486,6 +650,11 @@ func (k Keeper) convertCoinNativeERC20(
 		return nil, err
 	}
 
+	// Check that the escrowed tokens still back the coin supply
+	if err := k.checkNativeERC20Escrow(ctx, pair, sdk.ZeroInt()); err != nil {
+		return nil, err
+	}
+
 	defer func() {
 		telemetry.IncrCounterWithLabels(
 			[]string{"tx", "msg", "convert", "coin", "total"},
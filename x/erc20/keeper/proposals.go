@@ -1,12 +1,16 @@
 package keeper
 
 import (
+	"fmt"
+	"math/big"
+	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/ArableProtocol/acrechain/x/erc20/types"
 )
@@ -68,10 +72,13 @@ func (k Keeper) RegisterCoin(
 }
 
 // RegisterERC20 creates a Cosmos coin and registers the token pair between the
-// coin and the ERC20
+// coin and the ERC20. The declared token behavior is verified by a probe
+// transfer of the balance of the holder, if any.
 func (k Keeper) RegisterERC20(
 	ctx sdk.Context,
 	contract common.Address,
+	behavior types.TokenBehavior,
+	holder common.Address,
 ) (*types.TokenPair, error) {
 	// Check if the conversion is globally enabled
 	params := k.GetParams(ctx)
@@ -88,6 +95,10 @@ func (k Keeper) RegisterERC20(
 		)
 	}
 
+	if err := k.verifyTokenBehavior(ctx, contract, behavior, holder); err != nil {
+		return nil, err
+	}
+
 	metadata, err := k.CreateCoinMetadata(ctx, contract)
 	if err != nil {
 		return nil, sdkerrors.Wrap(
@@ -96,6 +107,7 @@ func (k Keeper) RegisterERC20(
 	}
 
 	pair := types.NewTokenPair(contract, metadata.Name, true, types.OWNER_EXTERNAL)
+	pair.Behavior = behavior
 	k.SetTokenPair(ctx, pair)
 	k.SetDenomMap(ctx, pair.Denom, pair.GetID())
 	k.SetERC20Map(ctx, common.HexToAddress(pair.Erc20Address), pair.GetID())
@@ -129,6 +141,21 @@ func (k Keeper) CreateCoinMetadata(
 		)
 	}
 
+	metadata, err := erc20CoinMetadata(contract, erc20Data)
+	if err != nil {
+		return nil, err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	return &metadata, nil
+}
+
+// erc20CoinMetadata returns the bank denom metadata representing the ERC20
+// token with the given details.
+func erc20CoinMetadata(contract common.Address, erc20Data types.ERC20Data) (banktypes.Metadata, error) {
+	strContract := contract.String()
+
 	// base denomination
 	base := types.CreateDenom(strContract)
 
@@ -164,14 +191,12 @@ func (k Keeper) CreateCoinMetadata(
 	}
 
 	if err := metadata.Validate(); err != nil {
-		return nil, sdkerrors.Wrapf(
+		return banktypes.Metadata{}, sdkerrors.Wrapf(
 			err, "ERC20 token data is invalid for contract %s", strContract,
 		)
 	}
 
-	k.bankKeeper.SetDenomMetaData(ctx, metadata)
-
-	return &metadata, nil
+	return metadata, nil
 }
 
 // ToggleConversion toggles conversion for a given token pair
@@ -199,6 +224,198 @@ func (k Keeper) ToggleConversion(
 	return pair, nil
 }
 
+// UpdateTokenPairMetadata refreshes the coin metadata of a token pair after a
+// rebrand. The metadata of a token pair for an ERC20 token is read again from
+// its contract. The metadata of a token pair for a native Cosmos coin is
+// replaced by the given one, whose name and symbol are also set on the ERC20
+// contract owned by the module.
+func (k Keeper) UpdateTokenPairMetadata(
+	ctx sdk.Context,
+	token string,
+	coinMetadata banktypes.Metadata,
+) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, sdkerrors.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, sdkerrors.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	contract := pair.GetERC20Contract()
+	erc20Data, err := k.QueryERC20(ctx, contract)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	erc20Updated := false
+	switch {
+	case pair.IsNativeERC20():
+		if coinMetadata.Base != "" {
+			return types.TokenPair{}, sdkerrors.Wrap(
+				types.ErrInternalTokenPair, "the metadata of an ERC20 token pair is read from its contract",
+			)
+		}
+
+		coinMetadata, err = erc20CoinMetadata(contract, erc20Data)
+		if err != nil {
+			return types.TokenPair{}, err
+		}
+	case pair.IsNativeCoin():
+		if err := k.verifyUpdatedMetadata(ctx, pair, coinMetadata, erc20Data); err != nil {
+			return types.TokenPair{}, err
+		}
+
+		erc20Updated = k.setERC20Metadata(ctx, contract, coinMetadata)
+	default:
+		return types.TokenPair{}, types.ErrUndefinedOwner
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, coinMetadata)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateMetadata,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeySymbol, coinMetadata.Symbol),
+			sdk.NewAttribute(types.AttributeKeyUpdated, strconv.FormatBool(erc20Updated)),
+		),
+	)
+
+	return pair, nil
+}
+
+// verifyUpdatedMetadata checks that the updated metadata of a native Cosmos
+// coin token pair keeps the base denomination and the decimals of its ERC20
+// contract, which cannot be changed.
+func (k Keeper) verifyUpdatedMetadata(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	coinMetadata banktypes.Metadata,
+	erc20Data types.ERC20Data,
+) error {
+	if coinMetadata.Base != pair.Denom {
+		return sdkerrors.Wrapf(
+			types.ErrInternalTokenPair,
+			"metadata base denomination '%s' doesn't match the token pair denomination '%s'", coinMetadata.Base, pair.Denom,
+		)
+	}
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, pair.Denom); !found {
+		return sdkerrors.Wrapf(
+			types.ErrInternalTokenPair, "metadata of '%s' not found", pair.Denom,
+		)
+	}
+
+	decimals := uint32(0)
+	if len(coinMetadata.DenomUnits) > 0 {
+		decimals = coinMetadata.DenomUnits[len(coinMetadata.DenomUnits)-1].Exponent
+	}
+
+	if decimals != uint32(erc20Data.Decimals) {
+		return sdkerrors.Wrapf(
+			types.ErrInternalTokenPair,
+			"metadata decimals %d don't match the ERC20 contract decimals %d", decimals, erc20Data.Decimals,
+		)
+	}
+
+	return nil
+}
+
+// Storage slots of the name and symbol of the ERC20MinterBurnerDecimals
+// contract, which follow the slots of AccessControl (0), AccessControlEnumerable
+// (1) and of the balances (2), allowances (3) and total supply (4) of ERC20.
+var (
+	erc20NameSlot   = common.BigToHash(big.NewInt(5))
+	erc20SymbolSlot = common.BigToHash(big.NewInt(6))
+)
+
+// setERC20Metadata sets the name and symbol of the given metadata on an ERC20
+// contract owned by the module. The contract doesn't implement a setter, so
+// they are written to its storage. It returns false without changing the
+// state if the name and symbol read back from the contract don't match, as
+// with a contract of another storage layout.
+func (k Keeper) setERC20Metadata(
+	ctx sdk.Context,
+	contract common.Address,
+	coinMetadata banktypes.Metadata,
+) bool {
+	cacheCtx, writeCache := ctx.CacheContext()
+	k.setStorageString(cacheCtx, contract, erc20NameSlot, coinMetadata.Name)
+	k.setStorageString(cacheCtx, contract, erc20SymbolSlot, coinMetadata.Symbol)
+
+	erc20Data, err := k.QueryERC20(cacheCtx, contract)
+	if err == nil && (erc20Data.Name != coinMetadata.Name || erc20Data.Symbol != coinMetadata.Symbol) {
+		err = fmt.Errorf("contract returned name '%s' and symbol '%s'", erc20Data.Name, erc20Data.Symbol)
+	}
+	if err != nil {
+		k.Logger(ctx).Info(
+			"ERC20 contract metadata not updated",
+			"contract", contract.String(), "error", err.Error(),
+		)
+		return false
+	}
+
+	writeCache()
+	return true
+}
+
+// setStorageString writes a string to a storage slot of a contract with the
+// encoding of Solidity. Strings shorter than 32 bytes are stored in the slot
+// along with twice their length. Longer strings store twice their length plus
+// one in the slot, and their data from the slot at the keccak256 hash of the
+// slot. The data slots of a previous long string are cleared.
+func (k Keeper) setStorageString(ctx sdk.Context, contract common.Address, slot common.Hash, value string) {
+	dataSlot := new(big.Int).SetBytes(crypto.Keccak256(slot.Bytes()))
+	setSlot := func(i int, data []byte) {
+		key := common.BigToHash(new(big.Int).Add(dataSlot, big.NewInt(int64(i))))
+		if len(data) == 0 {
+			k.evmKeeper.SetState(ctx, contract, key, nil)
+			return
+		}
+		k.evmKeeper.SetState(ctx, contract, key, common.RightPadBytes(data, common.HashLength))
+	}
+
+	// clear the data of the previous string
+	prev := k.evmKeeper.GetState(ctx, contract, slot).Big()
+	if prev.Bit(0) == 1 {
+		prevLen := new(big.Int).Rsh(prev, 1).Int64()
+		for i := 0; int64(i)*common.HashLength < prevLen; i++ {
+			setSlot(i, nil)
+		}
+	}
+
+	data := []byte(value)
+	if len(data) == 0 {
+		k.evmKeeper.SetState(ctx, contract, slot, nil)
+		return
+	}
+
+	if len(data) < common.HashLength {
+		word := common.RightPadBytes(data, common.HashLength)
+		word[common.HashLength-1] = byte(len(data) * 2)
+		k.evmKeeper.SetState(ctx, contract, slot, word)
+		return
+	}
+
+	length := common.BigToHash(big.NewInt(int64(len(data)*2 + 1)))
+	k.evmKeeper.SetState(ctx, contract, slot, length.Bytes())
+	for i := 0; i*common.HashLength < len(data); i++ {
+		end := (i + 1) * common.HashLength
+		if end > len(data) {
+			end = len(data)
+		}
+		setSlot(i, data[i*common.HashLength:end])
+	}
+}
+
 // verifyMetadata verifies if the metadata matches the existing one, if not it
 // sets it to the store
 func (k Keeper) verifyMetadata(
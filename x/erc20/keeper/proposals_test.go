@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/ArableProtocol/acrechain/x/erc20/keeper"
 	"github.com/ArableProtocol/acrechain/x/erc20/types"
@@ -9,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/ethermint/tests"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	"github.com/stretchr/testify/mock"
@@ -45,7 +47,7 @@ func (suite *KeeperTestSuite) setupRegisterERC20Pair(contractType int) common.Ad
 	}
 	suite.Commit()
 
-	_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contract)
+	_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contract, types.TOKEN_BEHAVIOR_STANDARD, common.Address{})
 	suite.Require().NoError(err)
 	return contract
 }
@@ -241,7 +243,7 @@ func (suite KeeperTestSuite) TestRegisterCoin() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper)
+				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.DistrKeeper)
 				mockEVMKeeper.On("EstimateGas", mock.Anything, mock.Anything).Return(&evmtypes.EstimateGasResponse{Gas: uint64(200)}, nil)
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("forced ApplyMessage error"))
 			},
@@ -337,7 +339,7 @@ func (suite KeeperTestSuite) TestRegisterERC20() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper)
+				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.DistrKeeper)
 				mockEVMKeeper.On("EstimateGas", mock.Anything, mock.Anything).Return(&evmtypes.EstimateGasResponse{Gas: uint64(200)}, nil)
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("forced ApplyMessage error"))
 			},
@@ -357,7 +359,7 @@ func (suite KeeperTestSuite) TestRegisterERC20() {
 
 			tc.malleate()
 
-			_, err = suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+			_, err = suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOR_STANDARD, common.Address{})
 			metadata, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, coinName)
 			if tc.expPass {
 				suite.Require().NoError(err, tc.name)
@@ -466,3 +468,137 @@ func (suite KeeperTestSuite) TestToggleConverision() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateTokenPairMetadata() {
+	var (
+		token    string
+		metadata banktypes.Metadata
+		expected banktypes.Metadata
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"token pair not registered",
+			func() {
+				token = tests.GenerateAddress().String()
+			},
+			false,
+		},
+		{
+			"ERC20 token pair - metadata read from the contract",
+			func() {
+				contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+				token = contractAddr.String()
+				metadata = banktypes.Metadata{}
+
+				// stale metadata is replaced by the contract details
+				expected, _ = suite.app.BankKeeper.GetDenomMetaData(suite.ctx, types.CreateDenom(token))
+				stale := expected
+				stale.Symbol = "OLD"
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, stale)
+			},
+			true,
+		},
+		{
+			"ERC20 token pair - metadata set",
+			func() {
+				contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+				token = contractAddr.String()
+				metadata, _ = suite.app.BankKeeper.GetDenomMetaData(suite.ctx, types.CreateDenom(token))
+			},
+			false,
+		},
+		{
+			"native coin token pair - base denomination mismatch",
+			func() {
+				metadata, _ = suite.setupRegisterCoin()
+				token = metadata.Base
+				metadata.Base = "anewcoin"
+				metadata.DenomUnits[0].Denom = "anewcoin"
+			},
+			false,
+		},
+		{
+			"native coin token pair - decimals mismatch",
+			func() {
+				metadata, _ = suite.setupRegisterCoin()
+				token = metadata.Base
+				metadata.DenomUnits[1].Exponent = 6
+			},
+			false,
+		},
+		{
+			"native coin token pair - rebranded",
+			func() {
+				metadata, _ = suite.setupRegisterCoin()
+				token = metadata.Base
+				metadata.Name = "new coin"
+				metadata.Symbol = "NEWCOIN"
+				expected = metadata
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			pair, err := suite.app.Erc20Keeper.UpdateTokenPairMetadata(suite.ctx, token, metadata)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+
+				stored, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, pair.Denom)
+				suite.Require().True(found)
+				suite.Require().Equal(expected, stored)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateTokenPairMetadataERC20Contract() {
+	suite.SetupTest()
+	metadata, pair := suite.setupRegisterCoin()
+	contract := pair.GetERC20Contract()
+
+	for _, rebrand := range []struct{ name, symbol string }{
+		{"a new coin with a name longer than two storage slots of the contract", "NEWCOIN"},
+		{"a new coin with a 31 bytes name", "THIRTY_ONE_BYTES_LONG_SYMBOL___"},
+		{"a new coin with a 32 bytes name!", "NEWCOIN"},
+		{"new coin", "NCOIN"},
+	} {
+		suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+		metadata.Name = rebrand.name
+		metadata.Symbol = rebrand.symbol
+
+		_, err := suite.app.Erc20Keeper.UpdateTokenPairMetadata(suite.ctx, pair.Denom, metadata)
+		suite.Require().NoError(err)
+
+		erc20Data, err := suite.app.Erc20Keeper.QueryERC20(suite.ctx, contract)
+		suite.Require().NoError(err)
+		suite.Require().Equal(rebrand.name, erc20Data.Name)
+		suite.Require().Equal(rebrand.symbol, erc20Data.Symbol)
+		suite.Require().Equal(erc20Decimals, erc20Data.Decimals)
+
+		events := suite.ctx.EventManager().Events()
+		event := events[len(events)-1]
+		suite.Require().Equal(types.EventTypeUpdateMetadata, event.Type)
+		updated := event.Attributes[len(event.Attributes)-1]
+		suite.Require().Equal(types.AttributeKeyUpdated, string(updated.Key))
+		suite.Require().Equal("true", string(updated.Value))
+	}
+
+	// the data of the previous long names is cleared
+	dataSlot := new(big.Int).SetBytes(crypto.Keccak256(common.BigToHash(big.NewInt(5)).Bytes()))
+	for i := int64(0); i < 3; i++ {
+		key := common.BigToHash(new(big.Int).Add(dataSlot, big.NewInt(i)))
+		suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, contract, key))
+	}
+}
@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ArableProtocol/acrechain/x/erc20/types"
+)
+
+// registerERC20WithDeposit registers the token pair of an ERC20 contract whose
+// code hash is allowed by governance, in exchange for the registration deposit
+// paid by the sender. The deposit is either burned or sent to the community
+// pool. The registered token pair is pending review for the review period,
+// during which governance can revoke it.
+func (k Keeper) registerERC20WithDeposit(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	contract common.Address,
+	behavior types.TokenBehavior,
+) (*types.TokenPair, error) {
+	params := k.GetParams(ctx)
+	if len(params.AllowedCodeHashes) == 0 {
+		return nil, sdkerrors.Wrap(
+			types.ErrRegistrationDisabled, "no code hash is allowed by governance",
+		)
+	}
+
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if acc == nil || !acc.IsContract() {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidAddress, "%s is not a contract", contract.String(),
+		)
+	}
+
+	codeHash := common.BytesToHash(acc.CodeHash)
+	if !params.IsCodeHashAllowed(codeHash) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrCodeHashNotAllowed, "code hash %s of contract %s", codeHash.Hex(), contract.String(),
+		)
+	}
+
+	blockTime := ctx.BlockTime()
+	if last, found := k.GetLastRegistrationTime(ctx, sender); found {
+		if nextTime := last.Add(params.RegistrationCooldown); blockTime.Before(nextTime) {
+			return nil, sdkerrors.Wrapf(
+				types.ErrRegistrationCooldown, "next registration allowed at %s", nextTime,
+			)
+		}
+	}
+
+	// NOTE: the sender is the holder probing the token behavior
+	pair, err := k.RegisterERC20(ctx, contract, behavior, common.BytesToAddress(sender))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.collectRegistrationDeposit(ctx, sender, params); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to collect registration deposit")
+	}
+
+	pair.ReviewEndTime = blockTime.Add(params.ReviewPeriod).Unix()
+	k.SetTokenPair(ctx, *pair)
+	k.SetLastRegistrationTime(ctx, sender, blockTime)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterERC20,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyDeposit, params.RegistrationDeposit.String()),
+			sdk.NewAttribute(types.AttributeKeyReviewEnd, fmt.Sprintf("%d", pair.ReviewEndTime)),
+		),
+	)
+
+	return pair, nil
+}
+
+// collectRegistrationDeposit burns the registration deposit or funds the
+// community pool with it.
+func (k Keeper) collectRegistrationDeposit(ctx sdk.Context, sender sdk.AccAddress, params types.Params) error {
+	deposit := params.RegistrationDeposit
+	if deposit.IsZero() {
+		return nil
+	}
+
+	if !params.BurnRegistrationDeposit {
+		return k.distrKeeper.FundCommunityPool(ctx, deposit, sender)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, deposit); err != nil {
+		return err
+	}
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, deposit)
+}
+
+// RevokeERC20Registration disables the conversions of a token pair registered
+// through a MsgRegisterERC20WithDeposit while it is pending review, and ends its review.
+// The token pair is kept so that governance can enable it again with a
+// ToggleTokenConversionProposal.
+func (k Keeper) RevokeERC20Registration(
+	ctx sdk.Context,
+	contract common.Address,
+) (types.TokenPair, error) {
+	id := k.GetERC20Map(ctx, contract)
+	if len(id) == 0 {
+		return types.TokenPair{}, sdkerrors.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", contract.String(),
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, sdkerrors.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", contract.String(),
+		)
+	}
+
+	if !pair.IsPendingReview(ctx.BlockTime().Unix()) {
+		return types.TokenPair{}, sdkerrors.Wrapf(
+			types.ErrNotPendingReview, "token '%s'", contract.String(),
+		)
+	}
+
+	pair.Enabled = false
+	pair.ReviewEndTime = 0
+	k.SetTokenPair(ctx, pair)
+	return pair, nil
+}
+
+// GetLastRegistrationTime returns the time of the last registration of the
+// sender through a MsgRegisterERC20WithDeposit.
+func (k Keeper) GetLastRegistrationTime(ctx sdk.Context, sender sdk.AccAddress) (time.Time, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLastRegistrationTime)
+	bz := store.Get(sender)
+	if bz == nil {
+		return time.Time{}, false
+	}
+
+	lastTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return lastTime, true
+}
+
+// SetLastRegistrationTime stores the time of the last registration of the
+// sender through a MsgRegisterERC20WithDeposit.
+func (k Keeper) SetLastRegistrationTime(ctx sdk.Context, sender sdk.AccAddress, lastTime time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLastRegistrationTime)
+	store.Set(sender, sdk.FormatTimeBytes(lastTime))
+}
+
+// GetAllLastRegistrationTimes returns the times of the last registrations
+// through a MsgRegisterERC20WithDeposit of all the senders.
+func (k Keeper) GetAllLastRegistrationTimes(ctx sdk.Context) []types.LastRegistrationTime {
+	times := []types.LastRegistrationTime{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLastRegistrationTime)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		lastTime, err := sdk.ParseTimeBytes(iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+
+		times = append(times, types.LastRegistrationTime{
+			Address: sdk.AccAddress(iterator.Key()).String(),
+			Time:    lastTime,
+		})
+	}
+
+	return times
+}
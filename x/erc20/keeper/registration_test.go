@@ -0,0 +1,274 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/ArableProtocol/acrechain/x/erc20/types"
+	minttypes "github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+const registrationDenom = "aacre"
+
+func (suite *KeeperTestSuite) setupRegistrationParams(contract common.Address, burn bool) {
+	acc := suite.app.EvmKeeper.GetAccountWithoutBalance(suite.ctx, contract)
+	suite.Require().NotNil(acc)
+
+	params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+	params.RegistrationDeposit = sdk.NewCoins(sdk.NewInt64Coin(registrationDenom, 100))
+	params.BurnRegistrationDeposit = burn
+	params.AllowedCodeHashes = []string{common.BytesToHash(acc.CodeHash).Hex()}
+	suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(registrationDenom, 1000))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, sender, coins))
+}
+
+func (suite *KeeperTestSuite) TestRegisterERC20WithDeposit() {
+	var contract common.Address
+
+	testCases := []struct {
+		name     string
+		burn     bool
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"ok - deposit sent to the community pool",
+			false,
+			func() {},
+			true,
+		},
+		{
+			"ok - deposit burned",
+			true,
+			func() {},
+			true,
+		},
+		{
+			"fail - no allowed code hash",
+			false,
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.AllowedCodeHashes = nil
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			},
+			false,
+		},
+		{
+			"fail - code hash not allowed",
+			false,
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.AllowedCodeHashes = []string{common.Hash{1}.Hex()}
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			},
+			false,
+		},
+		{
+			"fail - not a contract",
+			false,
+			func() {
+				contract = suite.address
+			},
+			false,
+		},
+		{
+			"ok - registration cooldown of another sender",
+			false,
+			func() {
+				other := sdk.AccAddress(tests.GenerateAddress().Bytes())
+				suite.app.Erc20Keeper.SetLastRegistrationTime(suite.ctx, other, suite.ctx.BlockTime())
+			},
+			true,
+		},
+		{
+			"fail - registration cooldown",
+			false,
+			func() {
+				sender := sdk.AccAddress(suite.address.Bytes())
+				suite.app.Erc20Keeper.SetLastRegistrationTime(suite.ctx, sender, suite.ctx.BlockTime())
+			},
+			false,
+		},
+		{
+			"fail - insufficient deposit",
+			false,
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.RegistrationDeposit = sdk.NewCoins(sdk.NewInt64Coin(registrationDenom, 10000))
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			},
+			false,
+		},
+		{
+			"fail - pair already registered",
+			false,
+			func() {
+				_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contract, types.TOKEN_BEHAVIOR_STANDARD, common.Address{})
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			sender := sdk.AccAddress(suite.address.Bytes())
+
+			var err error
+			contract, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+			suite.Require().NoError(err)
+			suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(100))
+			suite.Commit()
+
+			suite.setupRegistrationParams(contract, tc.burn)
+			tc.malleate()
+
+			supplyBefore := suite.app.BankKeeper.GetSupply(suite.ctx, registrationDenom)
+			poolBefore := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(registrationDenom)
+
+			msg := types.NewMsgRegisterERC20WithDeposit(sender, contract, types.TOKEN_BEHAVIOR_STANDARD)
+			_, err = suite.app.Erc20Keeper.RegisterERC20WithDeposit(sdk.WrapSDKContext(suite.ctx), msg)
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, registrationDenom)
+			supply := suite.app.BankKeeper.GetSupply(suite.ctx, registrationDenom)
+			pool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(registrationDenom)
+
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Equal(int64(1000), balance.Amount.Int64())
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(int64(900), balance.Amount.Int64())
+			if tc.burn {
+				suite.Require().Equal(supplyBefore.Amount.SubRaw(100), supply.Amount)
+				suite.Require().Equal(poolBefore, pool)
+			} else {
+				suite.Require().Equal(supplyBefore.Amount, supply.Amount)
+				suite.Require().Equal(poolBefore.Add(sdk.NewDec(100)), pool)
+			}
+
+			id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, contract.String())
+			pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+			suite.Require().True(found)
+			suite.Require().True(pair.Enabled)
+			suite.Require().Equal(types.OWNER_EXTERNAL, pair.ContractOwner)
+			reviewEnd := suite.ctx.BlockTime().Add(types.DefaultReviewPeriod).Unix()
+			suite.Require().Equal(reviewEnd, pair.ReviewEndTime)
+			suite.Require().True(pair.IsPendingReview(suite.ctx.BlockTime().Unix()))
+
+			lastTime, found := suite.app.Erc20Keeper.GetLastRegistrationTime(suite.ctx, sender)
+			suite.Require().True(found)
+			suite.Require().Equal(suite.ctx.BlockTime().Unix(), lastTime.Unix())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterERC20WithDepositCooldown() {
+	suite.SetupTest()
+	sender := sdk.AccAddress(suite.address.Bytes())
+
+	contract, err := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+	suite.Require().NoError(err)
+	contract2, err := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+	suite.Require().NoError(err)
+	suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(100))
+	suite.MintERC20Token(contract2, suite.address, suite.address, big.NewInt(100))
+	suite.Commit()
+	suite.setupRegistrationParams(contract, false)
+
+	_, err = suite.app.Erc20Keeper.RegisterERC20WithDeposit(sdk.WrapSDKContext(suite.ctx), types.NewMsgRegisterERC20WithDeposit(sender, contract, types.TOKEN_BEHAVIOR_STANDARD))
+	suite.Require().NoError(err)
+
+	_, err = suite.app.Erc20Keeper.RegisterERC20WithDeposit(sdk.WrapSDKContext(suite.ctx), types.NewMsgRegisterERC20WithDeposit(sender, contract2, types.TOKEN_BEHAVIOR_STANDARD))
+	suite.Require().ErrorIs(err, types.ErrRegistrationCooldown)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(types.DefaultRegistrationCooldown))
+	_, err = suite.app.Erc20Keeper.RegisterERC20WithDeposit(sdk.WrapSDKContext(suite.ctx), types.NewMsgRegisterERC20WithDeposit(sender, contract2, types.TOKEN_BEHAVIOR_STANDARD))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestRevokeERC20Registration() {
+	var contract common.Address
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"ok - pending review",
+			func() {},
+			true,
+		},
+		{
+			"fail - review period over",
+			func() {
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(types.DefaultReviewPeriod + time.Second))
+			},
+			false,
+		},
+		{
+			"fail - already revoked",
+			func() {
+				_, err := suite.app.Erc20Keeper.RevokeERC20Registration(suite.ctx, contract)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"fail - pair not registered",
+			func() {
+				contract = suite.address
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			sender := sdk.AccAddress(suite.address.Bytes())
+
+			var err error
+			contract, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+			suite.Require().NoError(err)
+			suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(100))
+			suite.Commit()
+			suite.setupRegistrationParams(contract, false)
+
+			_, err = suite.app.Erc20Keeper.RegisterERC20WithDeposit(sdk.WrapSDKContext(suite.ctx), types.NewMsgRegisterERC20WithDeposit(sender, contract, types.TOKEN_BEHAVIOR_STANDARD))
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			pair, err := suite.app.Erc20Keeper.RevokeERC20Registration(suite.ctx, contract)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().False(pair.Enabled)
+				suite.Require().Zero(pair.ReviewEndTime)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterERC20GovernanceNotPendingReview() {
+	suite.SetupTest()
+	contract := suite.setupRegisterERC20Pair(contractMinterBurner)
+
+	_, err := suite.app.Erc20Keeper.RevokeERC20Registration(suite.ctx, contract)
+	suite.Require().ErrorIs(err, types.ErrNotPendingReview)
+}
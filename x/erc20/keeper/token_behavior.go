@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ArableProtocol/acrechain/contracts"
+	"github.com/ArableProtocol/acrechain/x/erc20/types"
+)
+
+// verifyTokenBehavior checks the declared behavior of an ERC20 token against
+// the behavior detected by a probe transfer of the balance of the holder to
+// the module account. The probe runs in a cached context that is discarded.
+// Rebasing tokens are rejected, as well as fee-on-transfer tokens declared as
+// standard. The registration is rejected if the holder has no balance to
+// probe with. The probe is skipped for an empty holder, which is only passed
+// by callers that do not go through a proposal or a message.
+func (k Keeper) verifyTokenBehavior(
+	ctx sdk.Context,
+	contract common.Address,
+	behavior types.TokenBehavior,
+	holder common.Address,
+) error {
+	if err := types.ValidateTokenBehavior(behavior); err != nil {
+		return sdkerrors.Wrap(types.ErrTokenBehavior, err.Error())
+	}
+
+	if holder == (common.Address{}) {
+		return nil
+	}
+
+	if holder == types.ModuleAddress {
+		return sdkerrors.Wrapf(types.ErrTokenBehavior, "invalid probe holder %s", holder)
+	}
+
+	// NOTE: the cached context is never written and its events are dropped
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	amount := k.BalanceOf(cacheCtx, erc20, contract, holder)
+	if amount == nil || amount.Sign() == 0 {
+		return sdkerrors.Wrapf(
+			types.ErrTokenBehavior, "probe holder %s has no balance of token %s", holder, contract,
+		)
+	}
+
+	balanceEscrow := k.BalanceOf(cacheCtx, erc20, contract, types.ModuleAddress)
+	if balanceEscrow == nil {
+		return sdkerrors.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+
+	transferData, err := erc20.Pack("transfer", types.ModuleAddress, amount)
+	if err != nil {
+		return err
+	}
+
+	if _, err := k.CallEVMWithData(cacheCtx, holder, &contract, transferData, true); err != nil {
+		return sdkerrors.Wrapf(types.ErrTokenBehavior, "probe transfer failed: %s", err)
+	}
+
+	balanceHolderAfter := k.BalanceOf(cacheCtx, erc20, contract, holder)
+	balanceEscrowAfter := k.BalanceOf(cacheCtx, erc20, contract, types.ModuleAddress)
+	if balanceHolderAfter == nil || balanceEscrowAfter == nil {
+		return sdkerrors.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+
+	sent := big.NewInt(0).Sub(amount, balanceHolderAfter)
+	received := big.NewInt(0).Sub(balanceEscrowAfter, balanceEscrow)
+
+	switch types.DetectTokenBehavior(amount, sent, received) {
+	case types.TOKEN_BEHAVIOR_REBASING_FORBIDDEN:
+		return sdkerrors.Wrapf(
+			types.ErrTokenBehavior,
+			"rebasing token %s - transferred: %v, sent: %v, received: %v", contract, amount, sent, received,
+		)
+	case types.TOKEN_BEHAVIOR_FEE_ON_TRANSFER:
+		if behavior != types.TOKEN_BEHAVIOR_FEE_ON_TRANSFER {
+			return sdkerrors.Wrapf(
+				types.ErrTokenBehavior,
+				"token %s charges a fee on transfer - transferred: %v, received: %v", contract, amount, received,
+			)
+		}
+	}
+
+	return nil
+}
+
+// receivedTokens returns the amount of tokens received by the recipient of a
+// transfer of the given amount, from its balances before and after the
+// transfer. Fee-on-transfer tokens may deliver less than the amount, but not
+// nothing, while other tokens must deliver exactly the amount.
+func receivedTokens(pair types.TokenPair, before, after, amount *big.Int) (*big.Int, error) {
+	received := big.NewInt(0).Sub(after, before)
+
+	if pair.IsFeeOnTransfer() {
+		if received.Sign() <= 0 || received.Cmp(amount) > 0 {
+			return nil, sdkerrors.Wrapf(
+				types.ErrBalanceInvariance,
+				"invalid token balance - expected an increase of at most %v, actual: %v", amount, received,
+			)
+		}
+		return received, nil
+	}
+
+	if received.Cmp(amount) != 0 {
+		return nil, sdkerrors.Wrapf(
+			types.ErrBalanceInvariance,
+			"invalid token balance - expected: %v, actual: %v", big.NewInt(0).Add(before, amount), after,
+		)
+	}
+	return received, nil
+}
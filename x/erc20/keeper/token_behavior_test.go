@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/ArableProtocol/acrechain/contracts"
+	"github.com/ArableProtocol/acrechain/x/erc20/keeper"
+	"github.com/ArableProtocol/acrechain/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterERC20TokenBehavior() {
+	testCases := []struct {
+		name     string
+		behavior types.TokenBehavior
+		probe    bool
+		expPass  bool
+	}{
+		{"rebasing token", types.TOKEN_BEHAVIOR_REBASING_FORBIDDEN, false, false},
+		{"fee on transfer token registered as standard", types.TOKEN_BEHAVIOR_STANDARD, true, false},
+		{"fee on transfer token registered as standard without probe", types.TOKEN_BEHAVIOR_STANDARD, false, true},
+		{"fee on transfer token", types.TOKEN_BEHAVIOR_FEE_ON_TRANSFER, true, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+
+			contractAddr := suite.DeployContractDirectBalanceManipulation(erc20Name, erc20Symbol)
+			suite.Commit()
+
+			holder := common.Address{}
+			if tc.probe {
+				holder = suite.address
+			}
+
+			balance := suite.BalanceOf(contractAddr, suite.address)
+
+			pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, tc.behavior, holder)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.behavior, pair.Behavior)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrTokenBehavior)
+				suite.Require().False(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, contractAddr))
+			}
+
+			// the probe transfer is discarded
+			suite.Require().Equal(balance, suite.BalanceOf(contractAddr, suite.address))
+			suite.mintFeeCollector = false
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterERC20ProbeHolderWithoutBalance() {
+	suite.SetupTest()
+
+	contractAddr, err := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	_, err = suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOR_STANDARD, suite.address)
+	suite.Require().ErrorIs(err, types.ErrTokenBehavior)
+	suite.Require().False(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, contractAddr))
+
+	_, err = suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOR_STANDARD, types.ModuleAddress)
+	suite.Require().ErrorIs(err, types.ErrTokenBehavior)
+}
+
+func (suite *KeeperTestSuite) TestConvertFeeOnTransferToken() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+
+	contractAddr := suite.DeployContractDirectBalanceManipulation(erc20Name, erc20Symbol)
+	suite.Commit()
+
+	pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOR_FEE_ON_TRANSFER, suite.address)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	// the token sends half of the amount transferred to a third party
+	msgERC20 := types.NewMsgConvertERC20(sdk.NewInt(100), sender, contractAddr, suite.address)
+	_, err = suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msgERC20)
+	suite.Require().NoError(err)
+
+	cosmosBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, pair.Denom)
+	suite.Require().Equal(sdk.NewInt(50), cosmosBalance.Amount)
+
+	escrowBalance := suite.app.Erc20Keeper.BalanceOf(suite.ctx, erc20, contractAddr, types.ModuleAddress)
+	suite.Require().Equal(big.NewInt(50), escrowBalance)
+
+	receiver := common.BytesToAddress([]byte("receiver"))
+	msgCoin := types.NewMsgConvertCoin(sdk.NewInt64Coin(pair.Denom, 50), receiver, sender)
+	_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msgCoin)
+	suite.Require().NoError(err)
+
+	cosmosBalance = suite.app.BankKeeper.GetBalance(suite.ctx, sender, pair.Denom)
+	suite.Require().True(cosmosBalance.IsZero())
+
+	receiverBalance := suite.app.Erc20Keeper.BalanceOf(suite.ctx, erc20, contractAddr, receiver)
+	suite.Require().Equal(big.NewInt(25), receiverBalance)
+
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, pair.Denom)
+	suite.Require().True(supply.IsZero())
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestEVMHookFeeOnTransferToken() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+	suite.ensureHooksSet()
+
+	contractAddr := suite.DeployContractDirectBalanceManipulation(erc20Name, erc20Symbol)
+	suite.Commit()
+
+	pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOR_FEE_ON_TRANSFER, suite.address)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	// the token sends half of the amount transferred to a third party, so
+	// only 50 tokens are escrowed
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	_, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, erc20, suite.address, contractAddr, true, "transfer", types.ModuleAddress, big.NewInt(100))
+	suite.Require().NoError(err)
+
+	// transfer events reporting the amounts before the fee
+	transferEvent := erc20.Events[types.ERC20EventTransfer]
+	first, second := tests.GenerateAddress(), tests.GenerateAddress()
+	receipt := &ethtypes.Receipt{Logs: []*ethtypes.Log{
+		{
+			Topics:  []common.Hash{transferEvent.ID, first.Hash(), types.ModuleAddress.Hash()},
+			Data:    common.LeftPadBytes(big.NewInt(100).Bytes(), 32),
+			Address: contractAddr,
+		},
+		{
+			Topics:  []common.Hash{transferEvent.ID, second.Hash(), types.ModuleAddress.Hash()},
+			Data:    common.LeftPadBytes(big.NewInt(300).Bytes(), 32),
+			Address: contractAddr,
+		},
+	}}
+
+	err = suite.app.Erc20Keeper.PostTxProcessing(suite.ctx, ethtypes.Message{}, receipt)
+	suite.Require().NoError(err)
+
+	// the escrowed tokens are shared in proportion to the transferred amounts
+	firstBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(first.Bytes()), pair.Denom)
+	suite.Require().Equal(sdk.NewInt(12), firstBalance.Amount)
+	secondBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(second.Bytes()), pair.Denom)
+	suite.Require().Equal(sdk.NewInt(38), secondBalance.Amount)
+
+	stored, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, pair.GetID())
+	suite.Require().True(found)
+	suite.Require().True(stored.Enabled)
+
+	_, broken := keeper.NativeERC20EscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+	suite.Require().False(broken)
+	suite.mintFeeCollector = false
+}
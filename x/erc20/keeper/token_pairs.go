@@ -66,6 +66,11 @@ func (k Keeper) DeleteTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
 	k.deleteTokenPair(ctx, id)
 	k.deleteERC20Map(ctx, tokenPair.GetERC20Contract())
 	k.deleteDenomMap(ctx, tokenPair.Denom)
+	k.deleteConversionLimits(ctx, id)
+
+	if tokenPair.IsDeregistering() {
+		k.deleteWithdrawalQueueEntry(ctx, tokenPair)
+	}
 }
 
 // deleteTokenPair deletes the token pair for the given id
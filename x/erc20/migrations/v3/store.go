@@ -0,0 +1,92 @@
+package v3
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ArableProtocol/acrechain/x/erc20/types"
+)
+
+// MigrateParams sets the x/erc20 parameters introduced in v3. The IBC
+// auto-conversion and the permissionless registration are left disabled, and
+// the registration cooldown and review period are set to their defaults.
+func MigrateParams(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	paramstore.Set(ctx, types.ParamStoreKeyIBCAutoConvertChannels, []string{})
+	paramstore.Set(ctx, types.ParamStoreKeyRegistrationDeposit, sdk.Coins{})
+	paramstore.Set(ctx, types.ParamStoreKeyBurnRegistrationDeposit, false)
+	paramstore.Set(ctx, types.ParamStoreKeyAllowedCodeHashes, []string{})
+	paramstore.Set(ctx, types.ParamStoreKeyRegistrationCooldown, types.DefaultRegistrationCooldown)
+	paramstore.Set(ctx, types.ParamStoreKeyReviewPeriod, types.DefaultReviewPeriod)
+	return nil
+}
+
+// MigrateTokenPairs re-indexes the token pairs. The ERC20 addresses are stored
+// in their checksummed form, each token pair is stored under the id derived
+// from its normalized fields, and the ERC20 and denom indexes are rebuilt from
+// the token pairs, dropping the entries that don't point to any of them.
+func MigrateTokenPairs(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var pairs []types.TokenPair
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixTokenPair)
+	for ; iterator.Valid(); iterator.Next() {
+		var pair types.TokenPair
+		cdc.MustUnmarshal(iterator.Value(), &pair)
+		pairs = append(pairs, pair)
+	}
+	iterator.Close()
+
+	for _, keyPrefix := range [][]byte{
+		types.KeyPrefixTokenPair,
+		types.KeyPrefixTokenPairByERC20,
+		types.KeyPrefixTokenPairByDenom,
+	} {
+		deletePrefix(store, keyPrefix)
+	}
+
+	pairStore := prefix.NewStore(store, types.KeyPrefixTokenPair)
+	erc20Store := prefix.NewStore(store, types.KeyPrefixTokenPairByERC20)
+	denomStore := prefix.NewStore(store, types.KeyPrefixTokenPairByDenom)
+
+	for _, pair := range pairs {
+		if !common.IsHexAddress(pair.Erc20Address) {
+			return fmt.Errorf("invalid ERC20 address %s of token pair %s", pair.Erc20Address, pair.Denom)
+		}
+		pair.Erc20Address = common.HexToAddress(pair.Erc20Address).Hex()
+
+		contract := pair.GetERC20Contract()
+		if erc20Store.Has(contract.Bytes()) {
+			return fmt.Errorf("duplicated token pair for ERC20 %s", pair.Erc20Address)
+		}
+		if denomStore.Has([]byte(pair.Denom)) {
+			return fmt.Errorf("duplicated token pair for denom %s", pair.Denom)
+		}
+
+		id := pair.GetID()
+		pairStore.Set(id, cdc.MustMarshal(&pair))
+		erc20Store.Set(contract.Bytes(), id)
+		denomStore.Set([]byte(pair.Denom), id)
+	}
+
+	return nil
+}
+
+// deletePrefix deletes all the entries of the store under the given prefix.
+func deletePrefix(store sdk.KVStore, keyPrefix []byte) {
+	prefixStore := prefix.NewStore(store, keyPrefix)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
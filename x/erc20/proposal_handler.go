@@ -1,6 +1,8 @@
 package erc20
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -20,6 +22,14 @@ func NewErc20ProposalHandler(k *keeper.Keeper) govtypes.Handler {
 			return handleRegisterERC20Proposal(ctx, k, c)
 		case *types.ToggleTokenConversionProposal:
 			return handleToggleConversionProposal(ctx, k, c)
+		case *types.RevokeERC20RegistrationProposal:
+			return handleRevokeERC20RegistrationProposal(ctx, k, c)
+		case *types.UpdateConversionLimitsProposal:
+			return handleUpdateConversionLimitsProposal(ctx, k, c)
+		case *types.UpdateTokenPairMetadataProposal:
+			return handleUpdateTokenPairMetadataProposal(ctx, k, c)
+		case *types.DeregisterTokenPairProposal:
+			return handleDeregisterTokenPairProposal(ctx, k, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
@@ -44,7 +54,9 @@ func handleRegisterCoinProposal(ctx sdk.Context, k *keeper.Keeper, p *types.Regi
 }
 
 func handleRegisterERC20Proposal(ctx sdk.Context, k *keeper.Keeper, p *types.RegisterERC20Proposal) error {
-	pair, err := k.RegisterERC20(ctx, common.HexToAddress(p.Erc20Address))
+	pair, err := k.RegisterERC20(
+		ctx, common.HexToAddress(p.Erc20Address), p.Behavior, common.HexToAddress(p.ProbeHolder),
+	)
 	if err != nil {
 		return err
 	}
@@ -75,3 +87,62 @@ func handleToggleConversionProposal(ctx sdk.Context, k *keeper.Keeper, p *types.
 
 	return nil
 }
+
+func handleRevokeERC20RegistrationProposal(ctx sdk.Context, k *keeper.Keeper, p *types.RevokeERC20RegistrationProposal) error {
+	pair, err := k.RevokeERC20Registration(ctx, common.HexToAddress(p.Erc20Address))
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeERC20,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return nil
+}
+
+func handleUpdateConversionLimitsProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdateConversionLimitsProposal) error {
+	pair, err := k.UpdateConversionLimits(ctx, p.Limits)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateConversionLimits,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return nil
+}
+
+func handleUpdateTokenPairMetadataProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdateTokenPairMetadataProposal) error {
+	// NOTE: the event is emitted by the keeper, which knows whether the ERC20
+	// contract was updated
+	_, err := k.UpdateTokenPairMetadata(ctx, p.Token, p.Metadata)
+	return err
+}
+
+func handleDeregisterTokenPairProposal(ctx sdk.Context, k *keeper.Keeper, p *types.DeregisterTokenPairProposal) error {
+	pair, err := k.DeregisterTokenPair(ctx, p.Token, p.WithdrawalPeriod)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeregisterTokenPair,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyWithdrawalEnd, fmt.Sprintf("%d", pair.WithdrawalEndTime)),
+		),
+	)
+
+	return nil
+}
@@ -24,8 +24,11 @@ var (
 
 const (
 	// Amino names
-	convertERC20Name = "evmos/MsgConvertERC20"
-	convertCoinName  = "evmos/MsgConvertCoin"
+	convertERC20Name             = "evmos/MsgConvertERC20"
+	convertCoinName              = "evmos/MsgConvertCoin"
+	registerERC20WithDepositName = "acrechain/MsgRegisterERC20WithDeposit"
+	convertCoinBatchName         = "acrechain/MsgConvertCoinBatch"
+	convertERC20BatchName        = "acrechain/MsgConvertERC20Batch"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -40,12 +43,19 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgConvertCoin{},
 		&MsgConvertERC20{},
+		&MsgRegisterERC20WithDeposit{},
+		&MsgConvertCoinBatch{},
+		&MsgConvertERC20Batch{},
 	)
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&RegisterCoinProposal{},
 		&RegisterERC20Proposal{},
 		&ToggleTokenConversionProposal{},
+		&RevokeERC20RegistrationProposal{},
+		&UpdateConversionLimitsProposal{},
+		&UpdateTokenPairMetadataProposal{},
+		&DeregisterTokenPairProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -57,4 +67,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgConvertERC20{}, convertERC20Name, nil)
 	cdc.RegisterConcrete(&MsgConvertCoin{}, convertCoinName, nil)
+	cdc.RegisterConcrete(&MsgRegisterERC20WithDeposit{}, registerERC20WithDepositName, nil)
+	cdc.RegisterConcrete(&MsgConvertCoinBatch{}, convertCoinBatchName, nil)
+	cdc.RegisterConcrete(&MsgConvertERC20Batch{}, convertERC20BatchName, nil)
 }
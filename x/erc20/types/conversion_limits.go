@@ -0,0 +1,154 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// NewConversionLimits returns a new instance of ConversionLimits
+func NewConversionLimits(
+	erc20Address common.Address,
+	maxPerConversion, maxPerWindow, maxPerAddress sdk.Int,
+	window time.Duration,
+) ConversionLimits {
+	return ConversionLimits{
+		Erc20Address:     erc20Address.String(),
+		MaxPerConversion: maxPerConversion,
+		MaxPerWindow:     maxPerWindow,
+		MaxPerAddress:    maxPerAddress,
+		Window:           window,
+	}
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (cl ConversionLimits) GetERC20Contract() common.Address {
+	return common.HexToAddress(cl.Erc20Address)
+}
+
+// IsZero returns true if none of the limits is enforced
+func (cl ConversionLimits) IsZero() bool {
+	return isZeroLimit(cl.MaxPerConversion) && isZeroLimit(cl.MaxPerWindow) && isZeroLimit(cl.MaxPerAddress)
+}
+
+// HasWindowLimits returns true if any of the rolling window limits is enforced
+func (cl ConversionLimits) HasWindowLimits() bool {
+	return !isZeroLimit(cl.MaxPerWindow) || !isZeroLimit(cl.MaxPerAddress)
+}
+
+// Validate performs a stateless validation of the conversion limits
+func (cl ConversionLimits) Validate() error {
+	if err := ethermint.ValidateAddress(cl.Erc20Address); err != nil {
+		return err
+	}
+
+	for _, limit := range []sdk.Int{cl.MaxPerConversion, cl.MaxPerWindow, cl.MaxPerAddress} {
+		if !limit.IsNil() && limit.IsNegative() {
+			return fmt.Errorf("conversion limit cannot be negative: %s", limit)
+		}
+	}
+
+	if cl.Window < 0 {
+		return fmt.Errorf("conversion limits window cannot be negative: %s", cl.Window)
+	}
+
+	if cl.HasWindowLimits() && cl.Window == 0 {
+		return fmt.Errorf("conversion limits window must be positive when a window limit is set")
+	}
+
+	return nil
+}
+
+// isZeroLimit returns true if the limit is not enforced
+func isZeroLimit(limit sdk.Int) bool {
+	return limit.IsNil() || limit.IsZero()
+}
+
+// NewConversionWindow returns a new ConversionWindow starting at the given time
+func NewConversionWindow(startTime int64) ConversionWindow {
+	return ConversionWindow{
+		StartTime: startTime,
+		Current:   sdk.ZeroInt(),
+		Previous:  sdk.ZeroInt(),
+	}
+}
+
+// Roll moves the conversion window forward to the fixed window containing the
+// block time. The amount of the current window becomes the amount of the
+// previous window when the windows are adjacent, and is dropped otherwise.
+func (cw ConversionWindow) Roll(blockTime int64, window time.Duration) ConversionWindow {
+	length := int64(window / time.Second)
+	if length <= 0 {
+		length = 1
+	}
+
+	start := blockTime - blockTime%length
+	switch {
+	case cw.StartTime == start:
+		return cw
+	case cw.StartTime == start-length:
+		rolled := NewConversionWindow(start)
+		rolled.Previous = cw.Current
+		return rolled
+	default:
+		return NewConversionWindow(start)
+	}
+}
+
+// Amount approximates the amount converted over the rolling window ending at
+// the block time, weighting the amount of the previous window by the share of
+// the rolling window that overlaps it, rounded up. The window must be rolled to
+// the block time. As the amount of the previous window is assumed to be spread
+// evenly over it, up to twice a limit can be converted around a boundary.
+func (cw ConversionWindow) Amount(blockTime int64, window time.Duration) sdk.Int {
+	length := int64(window / time.Second)
+	if length <= 0 {
+		length = 1
+	}
+
+	overlap := length - (blockTime - cw.StartTime)
+	if overlap <= 0 {
+		return cw.Current
+	}
+
+	weighted := cw.Previous.MulRaw(overlap).AddRaw(length - 1).QuoRaw(length)
+	return cw.Current.Add(weighted)
+}
+
+// Validate performs a stateless validation of the conversion window
+func (cw ConversionWindow) Validate() error {
+	if cw.StartTime < 0 {
+		return fmt.Errorf("conversion window start time cannot be negative: %d", cw.StartTime)
+	}
+
+	for _, amount := range []sdk.Int{cw.Current, cw.Previous} {
+		if amount.IsNil() || amount.IsNegative() {
+			return fmt.Errorf("conversion window amount cannot be nil or negative: %s", amount)
+		}
+	}
+
+	return nil
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (e ConversionWindowEntry) GetERC20Contract() common.Address {
+	return common.HexToAddress(e.Erc20Address)
+}
+
+// Validate performs a stateless validation of the conversion window entry
+func (e ConversionWindowEntry) Validate() error {
+	if err := ethermint.ValidateAddress(e.Erc20Address); err != nil {
+		return err
+	}
+
+	if e.Address != "" {
+		if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
+			return fmt.Errorf("invalid conversion window address '%s': %w", e.Address, err)
+		}
+	}
+
+	return e.Window.Validate()
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/tests"
+)
+
+type ConversionLimitsTestSuite struct {
+	suite.Suite
+}
+
+func TestConversionLimitsSuite(t *testing.T) {
+	suite.Run(t, new(ConversionLimitsTestSuite))
+}
+
+func (suite *ConversionLimitsTestSuite) TestConversionLimitsValidate() {
+	testCases := []struct {
+		msg        string
+		limits     ConversionLimits
+		expectPass bool
+	}{
+		{"pass - max per conversion", NewConversionLimits(tests.GenerateAddress(), sdk.NewInt(100), sdk.ZeroInt(), sdk.ZeroInt(), 0), true},
+		{"pass - window limits", NewConversionLimits(tests.GenerateAddress(), sdk.ZeroInt(), sdk.NewInt(1000), sdk.NewInt(100), time.Hour), true},
+		{"pass - no limits", NewConversionLimits(tests.GenerateAddress(), sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt(), 0), true},
+		{"fail - invalid address", ConversionLimits{Erc20Address: "0xinvalidaddress", MaxPerConversion: sdk.NewInt(100)}, false},
+		{"fail - negative max per conversion", NewConversionLimits(tests.GenerateAddress(), sdk.NewInt(-1), sdk.ZeroInt(), sdk.ZeroInt(), 0), false},
+		{"fail - negative max per window", NewConversionLimits(tests.GenerateAddress(), sdk.ZeroInt(), sdk.NewInt(-1), sdk.ZeroInt(), time.Hour), false},
+		{"fail - negative max per address", NewConversionLimits(tests.GenerateAddress(), sdk.ZeroInt(), sdk.ZeroInt(), sdk.NewInt(-1), time.Hour), false},
+		{"fail - negative window", NewConversionLimits(tests.GenerateAddress(), sdk.NewInt(100), sdk.ZeroInt(), sdk.ZeroInt(), -time.Hour), false},
+		{"fail - window limit without window", NewConversionLimits(tests.GenerateAddress(), sdk.ZeroInt(), sdk.NewInt(1000), sdk.ZeroInt(), 0), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.limits.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+		}
+	}
+}
+
+func (suite *ConversionLimitsTestSuite) TestConversionWindow() {
+	window := time.Hour
+
+	testCases := []struct {
+		msg       string
+		cw        ConversionWindow
+		blockTime int64
+		expStart  int64
+		expAmount sdk.Int
+	}{
+		{
+			"same window",
+			ConversionWindow{StartTime: 3600, Current: sdk.NewInt(100), Previous: sdk.ZeroInt()},
+			5400,
+			3600,
+			sdk.NewInt(100),
+		},
+		{
+			"same window - previous amount weighted by the overlap",
+			ConversionWindow{StartTime: 3600, Current: sdk.NewInt(100), Previous: sdk.NewInt(100)},
+			5400,
+			3600,
+			sdk.NewInt(150),
+		},
+		{
+			"adjacent window - current amount becomes previous",
+			ConversionWindow{StartTime: 3600, Current: sdk.NewInt(100), Previous: sdk.NewInt(100)},
+			8100,
+			7200,
+			sdk.NewInt(75),
+		},
+		{
+			"adjacent window - previous amount rounded up",
+			ConversionWindow{StartTime: 3600, Current: sdk.NewInt(1), Previous: sdk.ZeroInt()},
+			10799,
+			7200,
+			sdk.NewInt(1),
+		},
+		{
+			"expired window",
+			ConversionWindow{StartTime: 3600, Current: sdk.NewInt(100), Previous: sdk.NewInt(100)},
+			10800,
+			10800,
+			sdk.ZeroInt(),
+		},
+	}
+
+	for _, tc := range testCases {
+		cw := tc.cw.Roll(tc.blockTime, window)
+		suite.Require().Equal(tc.expStart, cw.StartTime, tc.msg)
+		suite.Require().Equal(tc.expAmount, cw.Amount(tc.blockTime, window), tc.msg)
+	}
+}
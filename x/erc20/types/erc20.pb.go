@@ -5,18 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -56,6 +61,40 @@ func (Owner) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_46530f3c1c0397c3, []int{0}
 }
 
+// TokenBehavior enumerates the transfer behaviors of an ERC20 token.
+type TokenBehavior int32
+
+const (
+	// TOKEN_BEHAVIOR_STANDARD transfers exactly the amount requested.
+	TOKEN_BEHAVIOR_STANDARD TokenBehavior = 0
+	// TOKEN_BEHAVIOR_FEE_ON_TRANSFER charges a fee on the amount transferred, so
+	// that the recipient receives less than the amount requested.
+	TOKEN_BEHAVIOR_FEE_ON_TRANSFER TokenBehavior = 1
+	// TOKEN_BEHAVIOR_REBASING_FORBIDDEN changes balances independently of the
+	// amount transferred. Rebasing tokens cannot be registered.
+	TOKEN_BEHAVIOR_REBASING_FORBIDDEN TokenBehavior = 2
+)
+
+var TokenBehavior_name = map[int32]string{
+	0: "TOKEN_BEHAVIOR_STANDARD",
+	1: "TOKEN_BEHAVIOR_FEE_ON_TRANSFER",
+	2: "TOKEN_BEHAVIOR_REBASING_FORBIDDEN",
+}
+
+var TokenBehavior_value = map[string]int32{
+	"TOKEN_BEHAVIOR_STANDARD":           0,
+	"TOKEN_BEHAVIOR_FEE_ON_TRANSFER":    1,
+	"TOKEN_BEHAVIOR_REBASING_FORBIDDEN": 2,
+}
+
+func (x TokenBehavior) String() string {
+	return proto.EnumName(TokenBehavior_name, int32(x))
+}
+
+func (TokenBehavior) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_46530f3c1c0397c3, []int{1}
+}
+
 // TokenPair defines an instance that records a pairing consisting of a native
 //  Cosmos Coin and an ERC20 token address.
 type TokenPair struct {
@@ -67,6 +106,15 @@ type TokenPair struct {
 	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	// ERC20 owner address ENUM (0 invalid, 1 ModuleAccount, 2 external address)
 	ContractOwner Owner `protobuf:"varint,4,opt,name=contract_owner,json=contractOwner,proto3,enum=acrechain.erc20.v1.Owner" json:"contract_owner,omitempty"`
+	// unix time until which governance can revoke the token pair, zero if the
+	// token pair is not pending review
+	ReviewEndTime int64 `protobuf:"varint,5,opt,name=review_end_time,json=reviewEndTime,proto3" json:"review_end_time,omitempty"`
+	// transfer behavior of the ERC20 token
+	Behavior TokenBehavior `protobuf:"varint,6,opt,name=behavior,proto3,enum=acrechain.erc20.v1.TokenBehavior" json:"behavior,omitempty"`
+	// unix time at which the token pair is removed, zero if the token pair is
+	// not being deregistered. Until then, only the conversions back to the
+	// native representation are allowed.
+	WithdrawalEndTime int64 `protobuf:"varint,7,opt,name=withdrawal_end_time,json=withdrawalEndTime,proto3" json:"withdrawal_end_time,omitempty"`
 }
 
 func (m *TokenPair) Reset()         { *m = TokenPair{} }
@@ -130,6 +178,27 @@ func (m *TokenPair) GetContractOwner() Owner {
 	return OWNER_UNSPECIFIED
 }
 
+func (m *TokenPair) GetReviewEndTime() int64 {
+	if m != nil {
+		return m.ReviewEndTime
+	}
+	return 0
+}
+
+func (m *TokenPair) GetBehavior() TokenBehavior {
+	if m != nil {
+		return m.Behavior
+	}
+	return TOKEN_BEHAVIOR_STANDARD
+}
+
+func (m *TokenPair) GetWithdrawalEndTime() int64 {
+	if m != nil {
+		return m.WithdrawalEndTime
+	}
+	return 0
+}
+
 // RegisterCoinProposal is a gov Content type to register a token pair for a
 // native Cosmos coin.
 type RegisterCoinProposal struct {
@@ -204,6 +273,11 @@ type RegisterERC20Proposal struct {
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	// contract address of ERC20 token
 	Erc20Address string `protobuf:"bytes,3,opt,name=erc20address,proto3" json:"erc20address,omitempty"`
+	// transfer behavior of the ERC20 token
+	Behavior TokenBehavior `protobuf:"varint,4,opt,name=behavior,proto3,enum=acrechain.erc20.v1.TokenBehavior" json:"behavior,omitempty"`
+	// hex address of a token holder whose balance is used to probe the transfer
+	// behavior of the token
+	ProbeHolder string `protobuf:"bytes,5,opt,name=probe_holder,json=probeHolder,proto3" json:"probe_holder,omitempty"`
 }
 
 func (m *RegisterERC20Proposal) Reset()         { *m = RegisterERC20Proposal{} }
@@ -260,6 +334,20 @@ func (m *RegisterERC20Proposal) GetErc20Address() string {
 	return ""
 }
 
+func (m *RegisterERC20Proposal) GetBehavior() TokenBehavior {
+	if m != nil {
+		return m.Behavior
+	}
+	return TOKEN_BEHAVIOR_STANDARD
+}
+
+func (m *RegisterERC20Proposal) GetProbeHolder() string {
+	if m != nil {
+		return m.ProbeHolder
+	}
+	return ""
+}
+
 // ToggleTokenConversionProposal is a gov Content type to toggle the conversion
 // of a token pair.
 type ToggleTokenConversionProposal struct {
@@ -326,403 +414,2419 @@ func (m *ToggleTokenConversionProposal) GetToken() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("acrechain.erc20.v1.Owner", Owner_name, Owner_value)
-	proto.RegisterType((*TokenPair)(nil), "acrechain.erc20.v1.TokenPair")
-	proto.RegisterType((*RegisterCoinProposal)(nil), "acrechain.erc20.v1.RegisterCoinProposal")
-	proto.RegisterType((*RegisterERC20Proposal)(nil), "acrechain.erc20.v1.RegisterERC20Proposal")
-	proto.RegisterType((*ToggleTokenConversionProposal)(nil), "acrechain.erc20.v1.ToggleTokenConversionProposal")
+// RevokeERC20RegistrationProposal is a gov Content type to revoke a token pair
+// registered through a MsgRegisterERC20WithDeposit while it is pending review.
+type RevokeERC20RegistrationProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// contract address of ERC20 token
+	Erc20Address string `protobuf:"bytes,3,opt,name=erc20address,proto3" json:"erc20address,omitempty"`
 }
 
-func init() { proto.RegisterFile("acrechain/erc20/erc20.proto", fileDescriptor_46530f3c1c0397c3) }
-
-var fileDescriptor_46530f3c1c0397c3 = []byte{
-	// 492 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xf6, 0xb5, 0x29, 0xb4, 0xd7, 0x1f, 0x0a, 0xa7, 0x54, 0x32, 0x41, 0x75, 0xa3, 0xb0, 0x44,
-	0x0c, 0x76, 0x13, 0x36, 0x16, 0x48, 0x53, 0x23, 0x15, 0xda, 0x24, 0x32, 0xa9, 0x40, 0x2c, 0xd1,
-	0xd9, 0x3e, 0xb9, 0xa7, 0x3a, 0xf7, 0xa2, 0xbb, 0x23, 0xd0, 0xff, 0x80, 0x91, 0x85, 0x1d, 0x89,
-	0x85, 0x3f, 0xa5, 0x63, 0x47, 0x26, 0x84, 0x92, 0x85, 0x3f, 0x03, 0xf9, 0xce, 0x29, 0x95, 0x18,
-	0xbb, 0x44, 0xf9, 0xbe, 0xf7, 0xde, 0xdd, 0xe7, 0xef, 0x7b, 0x87, 0x1f, 0xd1, 0x44, 0xb2, 0xe4,
-	0x9c, 0x72, 0x11, 0x30, 0x99, 0x74, 0x0e, 0xec, 0xaf, 0x3f, 0x95, 0xa0, 0x81, 0x90, 0x9b, 0xa2,
-	0x6f, 0xe9, 0x59, 0xbb, 0x5e, 0xcb, 0x20, 0x03, 0x53, 0x0e, 0x8a, 0x7f, 0xb6, 0xb3, 0xee, 0x25,
-	0xa0, 0x26, 0xa0, 0x82, 0x98, 0x8a, 0x8b, 0x60, 0xd6, 0x8e, 0x99, 0xa6, 0x6d, 0x03, 0x6c, 0xbd,
-	0xf9, 0x03, 0xe1, 0x8d, 0x11, 0x5c, 0x30, 0x31, 0xa4, 0x5c, 0x92, 0xc7, 0x78, 0xdb, 0x9c, 0x37,
-	0xa6, 0x69, 0x2a, 0x99, 0x52, 0x2e, 0x6a, 0xa0, 0xd6, 0x46, 0xb4, 0x65, 0xc8, 0xae, 0xe5, 0x48,
-	0x0d, 0xaf, 0xa5, 0x4c, 0xc0, 0xc4, 0x5d, 0x31, 0x45, 0x0b, 0x88, 0x8b, 0xef, 0x33, 0x41, 0xe3,
-	0x9c, 0xa5, 0xee, 0x6a, 0x03, 0xb5, 0xd6, 0xa3, 0x25, 0x24, 0x2f, 0xf0, 0x4e, 0x02, 0x42, 0x4b,
-	0x9a, 0xe8, 0x31, 0x7c, 0x14, 0x4c, 0xba, 0x95, 0x06, 0x6a, 0xed, 0x74, 0x1e, 0xfa, 0xff, 0x7f,
-	0x85, 0x3f, 0x28, 0x1a, 0xa2, 0xed, 0xe5, 0x80, 0x81, 0xcf, 0x2a, 0x7f, 0xbe, 0xed, 0xa3, 0xe6,
-	0x57, 0x84, 0x6b, 0x11, 0xcb, 0xb8, 0xd2, 0x4c, 0xf6, 0x80, 0x8b, 0xa1, 0x84, 0x29, 0x28, 0x9a,
-	0x17, 0x82, 0x34, 0xd7, 0x39, 0x2b, 0xd5, 0x5a, 0x40, 0x1a, 0x78, 0x33, 0x65, 0x2a, 0x91, 0x7c,
-	0xaa, 0x39, 0x88, 0x52, 0xec, 0x6d, 0x8a, 0x3c, 0xc7, 0xeb, 0x13, 0xa6, 0x69, 0x4a, 0x35, 0x35,
-	0x9a, 0x37, 0x3b, 0x7b, 0xbe, 0xb5, 0xcb, 0x37, 0x0e, 0x95, 0x76, 0xf9, 0xa7, 0x65, 0xd3, 0x61,
-	0xe5, 0xea, 0xd7, 0xbe, 0x13, 0xdd, 0x0c, 0x19, 0x5d, 0x4e, 0xf3, 0x12, 0xef, 0x2e, 0x65, 0x85,
-	0x51, 0xaf, 0x73, 0x70, 0x67, 0x5d, 0x4d, 0x6c, 0x0d, 0x5f, 0x86, 0xb0, 0x7a, 0x2b, 0x84, 0x92,
-	0x2b, 0xaf, 0x56, 0x78, 0x6f, 0x04, 0x59, 0x96, 0x33, 0x13, 0x61, 0x0f, 0xc4, 0x8c, 0x49, 0xc5,
-	0xe1, 0xee, 0xd6, 0x14, 0x73, 0xc5, 0x91, 0xe5, 0xdd, 0x16, 0xd8, 0x1c, 0x9e, 0xbc, 0xc2, 0x6b,
-	0x26, 0x16, 0xb2, 0x8b, 0x1f, 0x0c, 0xde, 0xf6, 0xc3, 0x68, 0x7c, 0xd6, 0x7f, 0x33, 0x0c, 0x7b,
-	0xc7, 0x2f, 0x8f, 0xc3, 0xa3, 0xaa, 0x43, 0xaa, 0x78, 0xcb, 0xd2, 0xa7, 0x83, 0xa3, 0xb3, 0x93,
-	0xb0, 0x8a, 0x08, 0xc1, 0x3b, 0x96, 0x09, 0xdf, 0x8d, 0xc2, 0xa8, 0xdf, 0x3d, 0xa9, 0xae, 0xd4,
-	0x2b, 0x9f, 0xbf, 0x7b, 0xce, 0xe1, 0xeb, 0xab, 0xb9, 0x87, 0xae, 0xe7, 0x1e, 0xfa, 0x3d, 0xf7,
-	0xd0, 0x97, 0x85, 0xe7, 0x5c, 0x2f, 0x3c, 0xe7, 0xe7, 0xc2, 0x73, 0xde, 0xb7, 0x33, 0xae, 0xcf,
-	0x3f, 0xc4, 0x7e, 0x02, 0x93, 0xa0, 0x2b, 0x8b, 0x4d, 0x1a, 0x16, 0x0b, 0x9b, 0x40, 0x1e, 0xfc,
-	0x7b, 0x19, 0x9f, 0xca, 0xb7, 0xa1, 0x2f, 0xa7, 0x4c, 0xc5, 0xf7, 0xcc, 0x4a, 0x3f, 0xfd, 0x1b,
-	0x00, 0x00, 0xff, 0xff, 0x39, 0x74, 0xa6, 0xa1, 0x3b, 0x03, 0x00, 0x00,
+func (m *RevokeERC20RegistrationProposal) Reset()         { *m = RevokeERC20RegistrationProposal{} }
+func (m *RevokeERC20RegistrationProposal) String() string { return proto.CompactTextString(m) }
+func (*RevokeERC20RegistrationProposal) ProtoMessage()    {}
+func (*RevokeERC20RegistrationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46530f3c1c0397c3, []int{4}
 }
-
-func (this *TokenPair) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*TokenPair)
-	if !ok {
-		that2, ok := that.(TokenPair)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *RevokeERC20RegistrationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokeERC20RegistrationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokeERC20RegistrationProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Erc20Address != that1.Erc20Address {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if this.Enabled != that1.Enabled {
-		return false
-	}
-	if this.ContractOwner != that1.ContractOwner {
-		return false
-	}
-	return true
 }
-func (this *ToggleTokenConversionProposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *RevokeERC20RegistrationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeERC20RegistrationProposal.Merge(m, src)
+}
+func (m *RevokeERC20RegistrationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokeERC20RegistrationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeERC20RegistrationProposal.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ToggleTokenConversionProposal)
-	if !ok {
-		that2, ok := that.(ToggleTokenConversionProposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Title != that1.Title {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
+var xxx_messageInfo_RevokeERC20RegistrationProposal proto.InternalMessageInfo
+
+func (m *RevokeERC20RegistrationProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if this.Token != that1.Token {
-		return false
+	return ""
+}
+
+func (m *RevokeERC20RegistrationProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return true
+	return ""
 }
-func (m *TokenPair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *RevokeERC20RegistrationProposal) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TokenPair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ConversionLimits defines the rate limits of the conversions of a token pair,
+// in both directions. A zero limit is not enforced.
+type ConversionLimits struct {
+	// hex address of the ERC20 contract of the token pair
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// maximum amount converted by a single conversion
+	MaxPerConversion github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=max_per_conversion,json=maxPerConversion,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_per_conversion"`
+	// maximum amount converted over the rolling window
+	MaxPerWindow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_per_window,json=maxPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_per_window"`
+	// maximum amount converted by a single address over the rolling window
+	MaxPerAddress github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_per_address,json=maxPerAddress,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_per_address"`
+	// duration of the rolling window
+	Window time.Duration `protobuf:"bytes,5,opt,name=window,proto3,stdduration" json:"window"`
 }
 
-func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractOwner != 0 {
-		i = encodeVarintErc20(dAtA, i, uint64(m.ContractOwner))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *ConversionLimits) Reset()         { *m = ConversionLimits{} }
+func (m *ConversionLimits) String() string { return proto.CompactTextString(m) }
+func (*ConversionLimits) ProtoMessage()    {}
+func (*ConversionLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46530f3c1c0397c3, []int{5}
+}
+func (m *ConversionLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Erc20Address) > 0 {
-		i -= len(m.Erc20Address)
-		copy(dAtA[i:], m.Erc20Address)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ConversionLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionLimits.Merge(m, src)
+}
+func (m *ConversionLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionLimits proto.InternalMessageInfo
+
+func (m *ConversionLimits) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConversionLimits) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RegisterCoinProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ConversionWindow tracks the amounts converted during the current and the
+// previous fixed windows, from which the amount converted over the rolling
+// window is approximated.
+type ConversionWindow struct {
+	// unix time at which the current window started
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// amount converted during the current window
+	Current github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=current,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"current"`
+	// amount converted during the previous window
+	Previous github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=previous,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"previous"`
 }
 
-func (m *RegisterCoinProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+func (m *ConversionWindow) Reset()         { *m = ConversionWindow{} }
+func (m *ConversionWindow) String() string { return proto.CompactTextString(m) }
+func (*ConversionWindow) ProtoMessage()    {}
+func (*ConversionWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46530f3c1c0397c3, []int{6}
+}
+func (m *ConversionWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintErc20(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConversionWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionWindow.Merge(m, src)
+}
+func (m *ConversionWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionWindow.DiscardUnknown(m)
 }
 
-func (m *RegisterERC20Proposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConversionWindow proto.InternalMessageInfo
+
+func (m *ConversionWindow) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RegisterERC20Proposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UpdateConversionLimitsProposal is a gov Content type to update the
+// conversion rate limits of a token pair.
+type UpdateConversionLimitsProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// conversion rate limits of the token pair
+	Limits ConversionLimits `protobuf:"bytes,3,opt,name=limits,proto3" json:"limits"`
 }
 
-func (m *RegisterERC20Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Erc20Address) > 0 {
-		i -= len(m.Erc20Address)
-		copy(dAtA[i:], m.Erc20Address)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
-		i--
-		dAtA[i] = 0x1a
+func (m *UpdateConversionLimitsProposal) Reset()         { *m = UpdateConversionLimitsProposal{} }
+func (m *UpdateConversionLimitsProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateConversionLimitsProposal) ProtoMessage()    {}
+func (*UpdateConversionLimitsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46530f3c1c0397c3, []int{7}
+}
+func (m *UpdateConversionLimitsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateConversionLimitsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateConversionLimitsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *UpdateConversionLimitsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateConversionLimitsProposal.Merge(m, src)
+}
+func (m *UpdateConversionLimitsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateConversionLimitsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateConversionLimitsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateConversionLimitsProposal proto.InternalMessageInfo
+
+func (m *UpdateConversionLimitsProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *UpdateConversionLimitsProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ToggleTokenConversionProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UpdateConversionLimitsProposal) GetLimits() ConversionLimits {
+	if m != nil {
+		return m.Limits
 	}
-	return dAtA[:n], nil
+	return ConversionLimits{}
 }
 
-func (m *ToggleTokenConversionProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UpdateTokenPairMetadataProposal is a gov Content type to refresh the coin
+// metadata of a token pair after its ERC20 token has been rebranded.
+type UpdateTokenPairMetadataProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// new metadata of the native Cosmos coin, only set for token pairs owned by
+	// the module. The metadata of the other token pairs is read from the ERC20
+	// contract.
+	Metadata types.Metadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata"`
 }
 
-func (m *ToggleTokenConversionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+func (m *UpdateTokenPairMetadataProposal) Reset()         { *m = UpdateTokenPairMetadataProposal{} }
+func (m *UpdateTokenPairMetadataProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateTokenPairMetadataProposal) ProtoMessage()    {}
+func (*UpdateTokenPairMetadataProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46530f3c1c0397c3, []int{8}
+}
+func (m *UpdateTokenPairMetadataProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateTokenPairMetadataProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateTokenPairMetadataProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UpdateTokenPairMetadataProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTokenPairMetadataProposal.Merge(m, src)
+}
+func (m *UpdateTokenPairMetadataProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateTokenPairMetadataProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTokenPairMetadataProposal.DiscardUnknown(m)
 }
 
-func encodeVarintErc20(dAtA []byte, offset int, v uint64) int {
-	offset -= sovErc20(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_UpdateTokenPairMetadataProposal proto.InternalMessageInfo
+
+func (m *UpdateTokenPairMetadataProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *TokenPair) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *UpdateTokenPairMetadataProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	var l int
-	_ = l
-	l = len(m.Erc20Address)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+func (m *UpdateTokenPairMetadataProposal) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+func (m *UpdateTokenPairMetadataProposal) GetMetadata() types.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	if m.Enabled {
-		n += 2
+	return types.Metadata{}
+}
+
+// DeregisterTokenPairProposal is a gov Content type to deregister a token pair.
+// The token pair is disabled, except for the conversions back to the native
+// representation during the withdrawal period, after which it is removed.
+type DeregisterTokenPairProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// duration of the withdrawal window
+	WithdrawalPeriod time.Duration `protobuf:"bytes,4,opt,name=withdrawal_period,json=withdrawalPeriod,proto3,stdduration" json:"withdrawal_period"`
+}
+
+func (m *DeregisterTokenPairProposal) Reset()         { *m = DeregisterTokenPairProposal{} }
+func (m *DeregisterTokenPairProposal) String() string { return proto.CompactTextString(m) }
+func (*DeregisterTokenPairProposal) ProtoMessage()    {}
+func (*DeregisterTokenPairProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46530f3c1c0397c3, []int{9}
+}
+func (m *DeregisterTokenPairProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeregisterTokenPairProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeregisterTokenPairProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ContractOwner != 0 {
-		n += 1 + sovErc20(uint64(m.ContractOwner))
+}
+func (m *DeregisterTokenPairProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeregisterTokenPairProposal.Merge(m, src)
+}
+func (m *DeregisterTokenPairProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeregisterTokenPairProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeregisterTokenPairProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeregisterTokenPairProposal proto.InternalMessageInfo
+
+func (m *DeregisterTokenPairProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return n
+	return ""
 }
 
-func (m *RegisterCoinProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeregisterTokenPairProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+func (m *DeregisterTokenPairProposal) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+func (m *DeregisterTokenPairProposal) GetWithdrawalPeriod() time.Duration {
+	if m != nil {
+		return m.WithdrawalPeriod
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovErc20(uint64(l))
-	return n
+	return 0
 }
 
-func (m *RegisterERC20Proposal) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("acrechain.erc20.v1.Owner", Owner_name, Owner_value)
+	proto.RegisterEnum("acrechain.erc20.v1.TokenBehavior", TokenBehavior_name, TokenBehavior_value)
+	proto.RegisterType((*TokenPair)(nil), "acrechain.erc20.v1.TokenPair")
+	proto.RegisterType((*RegisterCoinProposal)(nil), "acrechain.erc20.v1.RegisterCoinProposal")
+	proto.RegisterType((*RegisterERC20Proposal)(nil), "acrechain.erc20.v1.RegisterERC20Proposal")
+	proto.RegisterType((*ToggleTokenConversionProposal)(nil), "acrechain.erc20.v1.ToggleTokenConversionProposal")
+	proto.RegisterType((*RevokeERC20RegistrationProposal)(nil), "acrechain.erc20.v1.RevokeERC20RegistrationProposal")
+	proto.RegisterType((*ConversionLimits)(nil), "acrechain.erc20.v1.ConversionLimits")
+	proto.RegisterType((*ConversionWindow)(nil), "acrechain.erc20.v1.ConversionWindow")
+	proto.RegisterType((*UpdateConversionLimitsProposal)(nil), "acrechain.erc20.v1.UpdateConversionLimitsProposal")
+	proto.RegisterType((*UpdateTokenPairMetadataProposal)(nil), "acrechain.erc20.v1.UpdateTokenPairMetadataProposal")
+	proto.RegisterType((*DeregisterTokenPairProposal)(nil), "acrechain.erc20.v1.DeregisterTokenPairProposal")
+}
+
+func init() { proto.RegisterFile("acrechain/erc20/erc20.proto", fileDescriptor_46530f3c1c0397c3) }
+
+var fileDescriptor_46530f3c1c0397c3 = []byte{
+	// 980 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x34, 0xce, 0xd7, 0xcb, 0x47, 0xdd, 0x21, 0x15, 0x6e, 0xa2, 0xac, 0x13, 0x03, 0x55,
+	0x54, 0x89, 0x75, 0x13, 0x6e, 0x20, 0x04, 0x76, 0xbc, 0x21, 0x6e, 0x53, 0xdb, 0x9a, 0x38, 0x2d,
+	0x42, 0x48, 0xab, 0xf1, 0xee, 0x60, 0xaf, 0x62, 0xef, 0x58, 0xb3, 0x63, 0x3b, 0x1c, 0x90, 0x90,
+	0xb8, 0x70, 0xe4, 0x82, 0xc4, 0x11, 0xc1, 0xff, 0xc0, 0x95, 0x03, 0x07, 0x7a, 0xec, 0x11, 0x81,
+	0x54, 0x50, 0x72, 0xe1, 0xcf, 0x40, 0x3b, 0x33, 0x6b, 0x3b, 0xa1, 0x87, 0xe2, 0xa8, 0x5c, 0x12,
+	0xcf, 0x7b, 0xf3, 0xbe, 0x7e, 0xef, 0xbd, 0xdf, 0x0e, 0x6c, 0x50, 0x4f, 0x30, 0xaf, 0x4d, 0x83,
+	0xb0, 0xc0, 0x84, 0xb7, 0x77, 0x5f, 0xff, 0xb5, 0x7b, 0x82, 0x4b, 0x8e, 0xf1, 0x48, 0x69, 0x6b,
+	0xf1, 0x60, 0x77, 0x7d, 0xad, 0xc5, 0x5b, 0x5c, 0xa9, 0x0b, 0xf1, 0x2f, 0x7d, 0x73, 0xdd, 0x6a,
+	0x71, 0xde, 0xea, 0xb0, 0x82, 0x3a, 0x35, 0xfb, 0x9f, 0x15, 0xfc, 0xbe, 0xa0, 0x32, 0xe0, 0x61,
+	0xa2, 0xf7, 0x78, 0xd4, 0xe5, 0x51, 0xa1, 0x49, 0xc3, 0xd3, 0xc2, 0x60, 0xb7, 0xc9, 0x24, 0xdd,
+	0x55, 0x07, 0xad, 0xcf, 0xff, 0x7c, 0x03, 0x16, 0x1b, 0xfc, 0x94, 0x85, 0x75, 0x1a, 0x08, 0xfc,
+	0x06, 0xac, 0xa8, 0x78, 0x2e, 0xf5, 0x7d, 0xc1, 0xa2, 0x28, 0x8b, 0xb6, 0xd0, 0xce, 0x22, 0x59,
+	0x56, 0xc2, 0xa2, 0x96, 0xe1, 0x35, 0x98, 0xf5, 0x59, 0xc8, 0xbb, 0xd9, 0x1b, 0x4a, 0xa9, 0x0f,
+	0x38, 0x0b, 0xf3, 0x2c, 0xa4, 0xcd, 0x0e, 0xf3, 0xb3, 0x33, 0x5b, 0x68, 0x67, 0x81, 0x24, 0x47,
+	0xfc, 0x21, 0xac, 0x7a, 0x3c, 0x94, 0x82, 0x7a, 0xd2, 0xe5, 0xc3, 0x90, 0x89, 0x6c, 0x7a, 0x0b,
+	0xed, 0xac, 0xee, 0xdd, 0xb1, 0xff, 0x5d, 0xa5, 0x5d, 0x8b, 0x2f, 0x90, 0x95, 0xc4, 0x40, 0x1d,
+	0xf1, 0x5d, 0xb8, 0x29, 0xd8, 0x20, 0x60, 0x43, 0x97, 0x85, 0xbe, 0x2b, 0x83, 0x2e, 0xcb, 0xce,
+	0x6e, 0xa1, 0x9d, 0x19, 0xb2, 0xa2, 0xc5, 0x4e, 0xe8, 0x37, 0x82, 0x2e, 0xc3, 0xef, 0xc3, 0x42,
+	0x93, 0xb5, 0xe9, 0x20, 0xe0, 0x22, 0x3b, 0xa7, 0x62, 0x6c, 0xbf, 0x28, 0x86, 0xaa, 0xb7, 0x64,
+	0x2e, 0x92, 0x91, 0x09, 0xb6, 0xe1, 0xb5, 0x61, 0x20, 0xdb, 0xbe, 0xa0, 0x43, 0xda, 0x19, 0x87,
+	0x9a, 0x57, 0xa1, 0x6e, 0x8d, 0x55, 0x26, 0xdc, 0xbb, 0xe9, 0xbf, 0xbf, 0xcf, 0xa1, 0xfc, 0xb7,
+	0x08, 0xd6, 0x08, 0x6b, 0x05, 0x91, 0x64, 0x62, 0x9f, 0x07, 0x61, 0x5d, 0xf0, 0x1e, 0x8f, 0x68,
+	0x27, 0xc6, 0x49, 0x06, 0xb2, 0xc3, 0x0c, 0x88, 0xfa, 0x80, 0xb7, 0x60, 0xc9, 0x67, 0x91, 0x27,
+	0x82, 0x5e, 0xdc, 0x25, 0x83, 0xe1, 0xa4, 0x08, 0x7f, 0x00, 0x0b, 0x5d, 0x26, 0xa9, 0x4f, 0x25,
+	0x55, 0x50, 0x2e, 0xed, 0x6d, 0xda, 0xba, 0x8b, 0xb6, 0x6a, 0x9c, 0xe9, 0xa2, 0xfd, 0xc8, 0x5c,
+	0x2a, 0xa5, 0x9f, 0x3e, 0xcf, 0xa5, 0xc8, 0xc8, 0x48, 0xe5, 0x95, 0xca, 0xff, 0x81, 0xe0, 0x76,
+	0x92, 0x97, 0x43, 0xf6, 0xf7, 0xee, 0x5f, 0x3b, 0xb1, 0x3c, 0xe8, 0x41, 0x48, 0x86, 0x63, 0x66,
+	0x62, 0x38, 0x8c, 0xec, 0x52, 0x0b, 0xd2, 0xff, 0xbd, 0x05, 0xdb, 0xb0, 0xdc, 0x13, 0xbc, 0xc9,
+	0xdc, 0x36, 0xef, 0xf8, 0x4c, 0xa8, 0x36, 0x2f, 0x92, 0x25, 0x25, 0x3b, 0x54, 0x22, 0x53, 0x5d,
+	0x04, 0x9b, 0x0d, 0xde, 0x6a, 0x75, 0x98, 0xf2, 0xb4, 0xcf, 0xc3, 0x01, 0x13, 0x51, 0xc0, 0xaf,
+	0x8f, 0x7e, 0x6c, 0x17, 0xbb, 0x34, 0xd5, 0xe9, 0x83, 0x69, 0xf5, 0x57, 0x08, 0x72, 0x84, 0x0d,
+	0xf8, 0x29, 0x53, 0x80, 0x6a, 0x74, 0xf5, 0xba, 0xfd, 0x1f, 0xe0, 0x9a, 0x2c, 0xbe, 0x9c, 0x81,
+	0xcc, 0xb8, 0xe0, 0xa3, 0xa0, 0x1b, 0xc8, 0xe8, 0xe5, 0x36, 0xf7, 0x53, 0xc0, 0x5d, 0x7a, 0xe6,
+	0xf6, 0x98, 0x70, 0xbd, 0x91, 0x03, 0x9d, 0x4c, 0xc9, 0x8e, 0x87, 0xe8, 0xf7, 0xe7, 0xb9, 0xbb,
+	0xad, 0x40, 0xb6, 0xfb, 0x4d, 0xdb, 0xe3, 0xdd, 0x82, 0xe1, 0x0e, 0xfd, 0xef, 0xed, 0xc8, 0x3f,
+	0x2d, 0xc8, 0xcf, 0x7b, 0x2c, 0xb2, 0x2b, 0xa1, 0x24, 0x99, 0x2e, 0x3d, 0xab, 0xc7, 0x13, 0x9f,
+	0xf8, 0xc1, 0x0d, 0x58, 0x4d, 0xbc, 0x0f, 0x83, 0xd0, 0xe7, 0xc3, 0xec, 0xcc, 0x54, 0x9e, 0x97,
+	0xb5, 0xe7, 0x27, 0xca, 0x07, 0x7e, 0x0c, 0x37, 0x13, 0xaf, 0x49, 0x69, 0xe9, 0xa9, 0xdc, 0xae,
+	0x68, 0xb7, 0x09, 0x16, 0xef, 0xc1, 0x9c, 0xc9, 0x72, 0x56, 0xed, 0xd8, 0x1d, 0x5b, 0x33, 0xa9,
+	0x9d, 0x30, 0xa9, 0x5d, 0x36, 0x4c, 0x5a, 0x5a, 0x88, 0x23, 0x7d, 0xf7, 0x67, 0x0e, 0x11, 0x63,
+	0x92, 0xff, 0x15, 0x4d, 0xb6, 0xc0, 0x64, 0xba, 0x09, 0x10, 0x49, 0x2a, 0xa4, 0x66, 0x0d, 0xa4,
+	0x58, 0x63, 0x51, 0x49, 0x14, 0x39, 0x1d, 0xc2, 0xbc, 0xd7, 0x17, 0x82, 0x85, 0x72, 0x4a, 0xc4,
+	0x13, 0x73, 0xfc, 0x00, 0x16, 0x7a, 0x31, 0xf1, 0xf1, 0x7e, 0x34, 0x25, 0xc4, 0x23, 0xfb, 0xfc,
+	0x0f, 0x08, 0xac, 0x93, 0x9e, 0x4f, 0x25, 0xbb, 0x3a, 0x52, 0xd7, 0x9e, 0xe8, 0x12, 0xcc, 0x75,
+	0x94, 0x27, 0xc3, 0x62, 0x6f, 0xbe, 0x88, 0x08, 0xae, 0x46, 0x35, 0x64, 0x66, 0x2c, 0xcd, 0xb2,
+	0xff, 0x84, 0x20, 0xa7, 0x93, 0x1c, 0x7d, 0xaa, 0x12, 0xf2, 0x7b, 0x35, 0xfb, 0x7e, 0x89, 0x83,
+	0xd3, 0xd3, 0x73, 0xf0, 0x2f, 0x08, 0x36, 0xca, 0x4c, 0x18, 0x16, 0x1e, 0x25, 0xff, 0x8a, 0x92,
+	0xae, 0xc3, 0xc4, 0x47, 0x2a, 0xde, 0x98, 0x80, 0xfb, 0xd9, 0xf4, 0xcb, 0x4f, 0x77, 0x66, 0x6c,
+	0x5d, 0x57, 0xc6, 0xba, 0x8a, 0x7b, 0x0f, 0x60, 0x56, 0x7f, 0x87, 0x6f, 0xc3, 0xad, 0xda, 0x93,
+	0xaa, 0x43, 0xdc, 0x93, 0xea, 0x71, 0xdd, 0xd9, 0xaf, 0x1c, 0x54, 0x9c, 0x72, 0x26, 0x85, 0x33,
+	0xb0, 0xac, 0xc5, 0x8f, 0x6a, 0xe5, 0x93, 0x23, 0x27, 0x83, 0x30, 0x86, 0x55, 0x2d, 0x71, 0x3e,
+	0x6e, 0x38, 0xa4, 0x5a, 0x3c, 0xca, 0xdc, 0x58, 0x4f, 0x7f, 0xfd, 0xa3, 0x95, 0xba, 0xf7, 0x05,
+	0xac, 0x5c, 0xe2, 0x7e, 0xbc, 0x01, 0xaf, 0x37, 0x6a, 0x0f, 0x9d, 0xaa, 0x5b, 0x72, 0x0e, 0x8b,
+	0x8f, 0x2b, 0x35, 0xe2, 0x1e, 0x37, 0x8a, 0xd5, 0x72, 0x91, 0xc4, 0x9e, 0xf3, 0x60, 0x5d, 0x51,
+	0x1e, 0x38, 0x8e, 0x5b, 0xab, 0xba, 0x0d, 0x52, 0xac, 0x1e, 0x1f, 0x38, 0x24, 0x83, 0xf0, 0x5b,
+	0xb0, 0x7d, 0xe5, 0x0e, 0x71, 0x4a, 0xc5, 0xe3, 0x4a, 0xf5, 0x23, 0xf7, 0xa0, 0x46, 0x4a, 0x95,
+	0x72, 0xd9, 0xa9, 0x26, 0xe1, 0x4b, 0x0f, 0x9f, 0x9e, 0x5b, 0xe8, 0xd9, 0xb9, 0x85, 0xfe, 0x3a,
+	0xb7, 0xd0, 0x37, 0x17, 0x56, 0xea, 0xd9, 0x85, 0x95, 0xfa, 0xed, 0xc2, 0x4a, 0x7d, 0xb2, 0x3b,
+	0xb1, 0x3a, 0x45, 0x11, 0xbf, 0x5c, 0xea, 0x31, 0x54, 0x1e, 0xef, 0x14, 0xc6, 0x2f, 0xb5, 0x33,
+	0xf3, 0x56, 0x53, 0x9b, 0xd4, 0x9c, 0x53, 0x60, 0xbe, 0xf3, 0xcf, 0x00, 0xd6, 0x2c, 0x0b, 0xad,
+	0xcb, 0x09, 0x00, 0x00,
+}
+
+func (this *TokenPair) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+
+	that1, ok := that.(*TokenPair)
+	if !ok {
+		that2, ok := that.(TokenPair)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Erc20Address)
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.ContractOwner != that1.ContractOwner {
+		return false
+	}
+	if this.ReviewEndTime != that1.ReviewEndTime {
+		return false
+	}
+	if this.Behavior != that1.Behavior {
+		return false
+	}
+	if this.WithdrawalEndTime != that1.WithdrawalEndTime {
+		return false
+	}
+	return true
+}
+func (this *ToggleTokenConversionProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ToggleTokenConversionProposal)
+	if !ok {
+		that2, ok := that.(ToggleTokenConversionProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	return true
+}
+func (this *RevokeERC20RegistrationProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RevokeERC20RegistrationProposal)
+	if !ok {
+		that2, ok := that.(RevokeERC20RegistrationProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	return true
+}
+func (m *TokenPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithdrawalEndTime != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.WithdrawalEndTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Behavior != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Behavior))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ReviewEndTime != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.ReviewEndTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ContractOwner != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.ContractOwner))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterCoinProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterCoinProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterERC20Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterERC20Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterERC20Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProbeHolder) > 0 {
+		i -= len(m.ProbeHolder)
+		copy(dAtA[i:], m.ProbeHolder)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.ProbeHolder)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Behavior != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Behavior))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ToggleTokenConversionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ToggleTokenConversionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ToggleTokenConversionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevokeERC20RegistrationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeERC20RegistrationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokeERC20RegistrationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintErc20(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxPerAddress.Size()
+		i -= size
+		if _, err := m.MaxPerAddress.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxPerWindow.Size()
+		i -= size
+		if _, err := m.MaxPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxPerConversion.Size()
+		i -= size
+		if _, err := m.MaxPerConversion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Previous.Size()
+		i -= size
+		if _, err := m.Previous.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Current.Size()
+		i -= size
+		if _, err := m.Current.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.StartTime != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateConversionLimitsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateConversionLimitsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateConversionLimitsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateTokenPairMetadataProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTokenPairMetadataProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTokenPairMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeregisterTokenPairProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeregisterTokenPairProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeregisterTokenPairProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.WithdrawalPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.WithdrawalPeriod):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintErc20(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintErc20(dAtA []byte, offset int, v uint64) int {
+	offset -= sovErc20(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.ContractOwner != 0 {
+		n += 1 + sovErc20(uint64(m.ContractOwner))
+	}
+	if m.ReviewEndTime != 0 {
+		n += 1 + sovErc20(uint64(m.ReviewEndTime))
+	}
+	if m.Behavior != 0 {
+		n += 1 + sovErc20(uint64(m.Behavior))
+	}
+	if m.WithdrawalEndTime != 0 {
+		n += 1 + sovErc20(uint64(m.WithdrawalEndTime))
+	}
+	return n
+}
+
+func (m *RegisterCoinProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *RegisterERC20Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Behavior != 0 {
+		n += 1 + sovErc20(uint64(m.Behavior))
+	}
+	l = len(m.ProbeHolder)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *ToggleTokenConversionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *RevokeERC20RegistrationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *ConversionLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.MaxPerConversion.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.MaxPerWindow.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.MaxPerAddress.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *ConversionWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		n += 1 + sovErc20(uint64(m.StartTime))
+	}
+	l = m.Current.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.Previous.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *UpdateConversionLimitsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Limits.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *UpdateTokenPairMetadataProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *DeregisterTokenPairProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
 	if l > 0 {
 		n += 1 + l + sovErc20(uint64(l))
 	}
-	return n
-}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.WithdrawalPeriod)
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func sovErc20(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozErc20(x uint64) (n int) {
+	return sovErc20(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TokenPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
+			}
+			m.ContractOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractOwner |= Owner(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewEndTime", wireType)
+			}
+			m.ReviewEndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReviewEndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Behavior", wireType)
+			}
+			m.Behavior = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Behavior |= TokenBehavior(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalEndTime", wireType)
+			}
+			m.WithdrawalEndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalEndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterCoinProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterCoinProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterERC20Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterERC20Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Behavior", wireType)
+			}
+			m.Behavior = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Behavior |= TokenBehavior(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbeHolder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProbeHolder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ToggleTokenConversionProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ToggleTokenConversionProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ToggleTokenConversionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevokeERC20RegistrationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevokeERC20RegistrationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevokeERC20RegistrationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerConversion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPerConversion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPerAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *ToggleTokenConversionProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
-	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovErc20(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozErc20(x uint64) (n int) {
-	return sovErc20(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *TokenPair) Unmarshal(dAtA []byte) error {
+func (m *ConversionWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -745,17 +2849,17 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConversionWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConversionWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -765,27 +2869,14 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthErc20
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthErc20
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Erc20Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -813,13 +2904,15 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -829,31 +2922,26 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
 			}
-			m.ContractOwner = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowErc20
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ContractOwner |= Owner(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Previous.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -875,7 +2963,7 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
+func (m *UpdateConversionLimitsProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -898,10 +2986,10 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterCoinProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateConversionLimitsProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterCoinProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateConversionLimitsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -970,7 +3058,7 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -997,7 +3085,7 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1022,7 +3110,7 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
+func (m *UpdateTokenPairMetadataProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1045,10 +3133,10 @@ func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterERC20Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateTokenPairMetadataProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterERC20Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateTokenPairMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1117,7 +3205,7 @@ func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1145,7 +3233,40 @@ func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1168,7 +3289,7 @@ func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ToggleTokenConversionProposal) Unmarshal(dAtA []byte) error {
+func (m *DeregisterTokenPairProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1191,10 +3312,10 @@ func (m *ToggleTokenConversionProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ToggleTokenConversionProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeregisterTokenPairProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ToggleTokenConversionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeregisterTokenPairProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1293,6 +3414,39 @@ func (m *ToggleTokenConversionProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.WithdrawalPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
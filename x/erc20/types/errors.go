@@ -18,4 +18,11 @@ var (
 	ErrEVMDenom               = sdkerrors.Register(ModuleName, 11, "EVM denomination registration")
 	ErrEVMCall                = sdkerrors.Register(ModuleName, 12, "EVM call unexpected error")
 	ErrERC20TokenPairDisabled = sdkerrors.Register(ModuleName, 13, "erc20 token pair is disabled")
+	ErrRegistrationDisabled   = sdkerrors.Register(ModuleName, 14, "permissionless registration is disabled")
+	ErrCodeHashNotAllowed     = sdkerrors.Register(ModuleName, 15, "contract code hash is not allowed")
+	ErrRegistrationCooldown   = sdkerrors.Register(ModuleName, 16, "registration cooldown has not elapsed")
+	ErrNotPendingReview       = sdkerrors.Register(ModuleName, 17, "token pair is not pending review")
+	ErrConversionLimit        = sdkerrors.Register(ModuleName, 18, "conversion limit exceeded")
+	ErrTokenBehavior          = sdkerrors.Register(ModuleName, 19, "unsupported token behavior")
+	ErrTokenPairDeregistering = sdkerrors.Register(ModuleName, 20, "token pair is being deregistered")
 )
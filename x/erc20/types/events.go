@@ -8,19 +8,36 @@ import (
 
 // erc20 events
 const (
-	EventTypeTokenLock             = "token_lock"
-	EventTypeTokenUnlock           = "token_unlock"
-	EventTypeMint                  = "mint"
-	EventTypeConvertCoin           = "convert_coin"
-	EventTypeConvertERC20          = "convert_erc20"
-	EventTypeBurn                  = "burn"
-	EventTypeRegisterCoin          = "register_coin"
-	EventTypeRegisterERC20         = "register_erc20"
-	EventTypeToggleTokenConversion = "toggle_token_conversion" // #nosec
+	EventTypeTokenLock              = "token_lock"
+	EventTypeTokenUnlock            = "token_unlock"
+	EventTypeMint                   = "mint"
+	EventTypeConvertCoin            = "convert_coin"
+	EventTypeConvertERC20           = "convert_erc20"
+	EventTypeBurn                   = "burn"
+	EventTypeRegisterCoin           = "register_coin"
+	EventTypeRegisterERC20          = "register_erc20"
+	EventTypeToggleTokenConversion  = "toggle_token_conversion" // #nosec
+	EventTypeIBCAutoConvert         = "ibc_auto_convert"
+	EventTypeRevokeERC20            = "revoke_erc20_registration"
+	EventTypeUpdateConversionLimits = "update_conversion_limits"
+	EventTypeCircuitBreaker         = "circuit_breaker"
+	EventTypeUpdateMetadata         = "update_token_pair_metadata"
+	EventTypeDeregisterTokenPair    = "deregister_token_pair"
+	EventTypeRemoveTokenPair        = "remove_token_pair"
 
-	AttributeKeyCosmosCoin = "cosmos_coin"
-	AttributeKeyERC20Token = "erc20_token" // #nosec
-	AttributeKeyReceiver   = "receiver"
+	AttributeKeyCosmosCoin    = "cosmos_coin"
+	AttributeKeyERC20Token    = "erc20_token" // #nosec
+	AttributeKeyReceiver      = "receiver"
+	AttributeKeyChannel       = "channel"
+	AttributeKeySender        = "sender"
+	AttributeKeyDeposit       = "deposit"
+	AttributeKeyReviewEnd     = "review_end_time"
+	AttributeKeyReason        = "reason"
+	AttributeKeySymbol        = "symbol"
+	AttributeKeyUpdated       = "erc20_updated"
+	AttributeKeyWithdrawalEnd = "withdrawal_end_time"
+	AttributeKeyEscrow        = "escrow"
+	AttributeKeyOutstanding   = "outstanding"
 
 	ERC20EventTransfer = "Transfer"
 )
@@ -1,12 +1,17 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, pairs []TokenPair) GenesisState {
+func NewGenesisState(params Params, pairs []TokenPair, limits []ConversionLimits) GenesisState {
 	return GenesisState{
-		Params:     params,
-		TokenPairs: pairs,
+		Params:           params,
+		TokenPairs:       pairs,
+		ConversionLimits: limits,
 	}
 }
 
@@ -40,5 +45,54 @@ func (gs GenesisState) Validate() error {
 		seenDenom[b.Denom] = true
 	}
 
+	seenLimits := make(map[string]bool)
+
+	for _, l := range gs.ConversionLimits {
+		if !seenErc20[l.Erc20Address] {
+			return fmt.Errorf("conversion limits of unregistered token pair on genesis '%s'", l.Erc20Address)
+		}
+		if seenLimits[l.Erc20Address] {
+			return fmt.Errorf("conversion limits duplicated on genesis '%s'", l.Erc20Address)
+		}
+
+		if err := l.Validate(); err != nil {
+			return err
+		}
+
+		seenLimits[l.Erc20Address] = true
+	}
+
+	seenSenders := make(map[string]bool)
+
+	for _, t := range gs.LastRegistrationTimes {
+		if _, err := sdk.AccAddressFromBech32(t.Address); err != nil {
+			return fmt.Errorf("invalid last registration time sender on genesis '%s': %w", t.Address, err)
+		}
+		if seenSenders[t.Address] {
+			return fmt.Errorf("last registration time duplicated on genesis '%s'", t.Address)
+		}
+
+		seenSenders[t.Address] = true
+	}
+
+	seenWindows := make(map[string]bool)
+
+	for _, w := range gs.ConversionWindows {
+		if !seenLimits[w.Erc20Address] {
+			return fmt.Errorf("conversion window of token pair without conversion limits on genesis '%s'", w.Erc20Address)
+		}
+
+		key := w.Erc20Address + "/" + w.Address
+		if seenWindows[key] {
+			return fmt.Errorf("conversion window duplicated on genesis '%s'", key)
+		}
+
+		if err := w.Validate(); err != nil {
+			return err
+		}
+
+		seenWindows[key] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -5,17 +5,24 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,6 +36,13 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// registered token pairs
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// conversion rate limits of the token pairs
+	ConversionLimits []ConversionLimits `protobuf:"bytes,3,rep,name=conversion_limits,json=conversionLimits,proto3" json:"conversion_limits"`
+	// times of the last registrations through a MsgRegisterERC20WithDeposit by
+	// sender
+	LastRegistrationTimes []LastRegistrationTime `protobuf:"bytes,4,rep,name=last_registration_times,json=lastRegistrationTimes,proto3" json:"last_registration_times"`
+	// amounts converted over the rolling windows of the conversion rate limits
+	ConversionWindows []ConversionWindowEntry `protobuf:"bytes,5,rep,name=conversion_windows,json=conversionWindows,proto3" json:"conversion_windows"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +92,149 @@ func (m *GenesisState) GetTokenPairs() []TokenPair {
 	return nil
 }
 
+func (m *GenesisState) GetConversionLimits() []ConversionLimits {
+	if m != nil {
+		return m.ConversionLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastRegistrationTimes() []LastRegistrationTime {
+	if m != nil {
+		return m.LastRegistrationTimes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConversionWindows() []ConversionWindowEntry {
+	if m != nil {
+		return m.ConversionWindows
+	}
+	return nil
+}
+
+// ConversionWindowEntry defines the amounts converted over the rolling window
+// of a token pair, or of an address for a token pair.
+type ConversionWindowEntry struct {
+	// ERC20 token contract address of the token pair
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// cosmos bech32 address of the converting account, empty for the window of
+	// the token pair
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amounts converted over the rolling window
+	Window ConversionWindow `protobuf:"bytes,3,opt,name=window,proto3" json:"window"`
+}
+
+func (m *ConversionWindowEntry) Reset()         { *m = ConversionWindowEntry{} }
+func (m *ConversionWindowEntry) String() string { return proto.CompactTextString(m) }
+func (*ConversionWindowEntry) ProtoMessage()    {}
+func (*ConversionWindowEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fac55b7e6e432d38, []int{1}
+}
+func (m *ConversionWindowEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionWindowEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionWindowEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionWindowEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionWindowEntry.Merge(m, src)
+}
+func (m *ConversionWindowEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionWindowEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionWindowEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionWindowEntry proto.InternalMessageInfo
+
+func (m *ConversionWindowEntry) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *ConversionWindowEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ConversionWindowEntry) GetWindow() ConversionWindow {
+	if m != nil {
+		return m.Window
+	}
+	return ConversionWindow{}
+}
+
+// LastRegistrationTime defines the time of the last registration of a sender
+// through a MsgRegisterERC20WithDeposit.
+type LastRegistrationTime struct {
+	// cosmos bech32 address of the sender
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// time of the last registration
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *LastRegistrationTime) Reset()         { *m = LastRegistrationTime{} }
+func (m *LastRegistrationTime) String() string { return proto.CompactTextString(m) }
+func (*LastRegistrationTime) ProtoMessage()    {}
+func (*LastRegistrationTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fac55b7e6e432d38, []int{2}
+}
+func (m *LastRegistrationTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastRegistrationTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastRegistrationTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LastRegistrationTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastRegistrationTime.Merge(m, src)
+}
+func (m *LastRegistrationTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastRegistrationTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastRegistrationTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastRegistrationTime proto.InternalMessageInfo
+
+func (m *LastRegistrationTime) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LastRegistrationTime) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// parameter to enable the conversion of Cosmos coins <--> ERC20 tokens.
@@ -86,13 +243,33 @@ type Params struct {
 	// Coin by transferring the Tokens through a MsgEthereumTx to the
 	// ModuleAddress Ethereum address.
 	EnableEVMHook bool `protobuf:"varint,2,opt,name=enable_evm_hook,json=enableEvmHook,proto3" json:"enable_evm_hook,omitempty"`
+	// channels on which the received IBC vouchers of a registered and enabled
+	// token pair are automatically converted to their ERC20 representation for
+	// the recipient's hex address.
+	IBCAutoConvertChannels []string `protobuf:"bytes,3,rep,name=ibc_auto_convert_channels,json=ibcAutoConvertChannels,proto3" json:"ibc_auto_convert_channels,omitempty"`
+	// deposit paid to register an ERC20 token pair through a
+	// MsgRegisterERC20WithDeposit.
+	RegistrationDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=registration_deposit,json=registrationDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_deposit"`
+	// burn the registration deposit instead of funding the community pool with
+	// it.
+	BurnRegistrationDeposit bool `protobuf:"varint,5,opt,name=burn_registration_deposit,json=burnRegistrationDeposit,proto3" json:"burn_registration_deposit,omitempty"`
+	// hex code hashes of the ERC20 contracts that can be registered through a
+	// MsgRegisterERC20WithDeposit. The permissionless registration is disabled
+	// when empty.
+	AllowedCodeHashes []string `protobuf:"bytes,6,rep,name=allowed_code_hashes,json=allowedCodeHashes,proto3" json:"allowed_code_hashes,omitempty"`
+	// minimum time between two registrations of a sender through a
+	// MsgRegisterERC20WithDeposit.
+	RegistrationCooldown time.Duration `protobuf:"bytes,7,opt,name=registration_cooldown,json=registrationCooldown,proto3,stdduration" json:"registration_cooldown"`
+	// time during which governance can revoke a token pair registered through
+	// a MsgRegisterERC20WithDeposit.
+	ReviewPeriod time.Duration `protobuf:"bytes,8,opt,name=review_period,json=reviewPeriod,proto3,stdduration" json:"review_period"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fac55b7e6e432d38, []int{1}
+	return fileDescriptor_fac55b7e6e432d38, []int{3}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -135,35 +312,108 @@ func (m *Params) GetEnableEVMHook() bool {
 	return false
 }
 
+func (m *Params) GetIBCAutoConvertChannels() []string {
+	if m != nil {
+		return m.IBCAutoConvertChannels
+	}
+	return nil
+}
+
+func (m *Params) GetRegistrationDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RegistrationDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetBurnRegistrationDeposit() bool {
+	if m != nil {
+		return m.BurnRegistrationDeposit
+	}
+	return false
+}
+
+func (m *Params) GetAllowedCodeHashes() []string {
+	if m != nil {
+		return m.AllowedCodeHashes
+	}
+	return nil
+}
+
+func (m *Params) GetRegistrationCooldown() time.Duration {
+	if m != nil {
+		return m.RegistrationCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetReviewPeriod() time.Duration {
+	if m != nil {
+		return m.ReviewPeriod
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "acrechain.erc20.v1.GenesisState")
+	proto.RegisterType((*ConversionWindowEntry)(nil), "acrechain.erc20.v1.ConversionWindowEntry")
+	proto.RegisterType((*LastRegistrationTime)(nil), "acrechain.erc20.v1.LastRegistrationTime")
 	proto.RegisterType((*Params)(nil), "acrechain.erc20.v1.Params")
 }
 
 func init() { proto.RegisterFile("acrechain/erc20/genesis.proto", fileDescriptor_fac55b7e6e432d38) }
 
 var fileDescriptor_fac55b7e6e432d38 = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x4c, 0x2e, 0x4a,
-	0x4d, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x4f, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x4b, 0xeb, 0x81, 0xa5,
-	0xf5, 0xca, 0x0c, 0xa5, 0xa4, 0xd1, 0xb5, 0x40, 0x64, 0xc0, 0x1a, 0xa4, 0x44, 0xd2, 0xf3, 0xd3,
-	0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0xd4, 0xc7, 0xc8, 0xc5, 0xe3, 0x0e, 0x31, 0x38,
-	0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x82, 0x8b, 0xad, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82,
-	0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x4a, 0x0f, 0xd3, 0x22, 0xbd, 0x00, 0xb0, 0x0a, 0x27, 0x96,
-	0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0xea, 0x85, 0x5c, 0xb8, 0xb8, 0x4b, 0xf2, 0xb3, 0x53, 0xf3,
-	0xe2, 0x0b, 0x12, 0x33, 0x8b, 0x8a, 0x25, 0x98, 0x14, 0x98, 0x35, 0xb8, 0x8d, 0x64, 0xb1, 0x69,
-	0x0f, 0x01, 0x29, 0x0b, 0x48, 0xcc, 0x2c, 0x82, 0x9a, 0xc0, 0x55, 0x02, 0x13, 0x28, 0x56, 0x4a,
-	0xe3, 0x62, 0x83, 0x98, 0x2e, 0xa4, 0xc8, 0xc5, 0x93, 0x9a, 0x97, 0x98, 0x94, 0x93, 0x1a, 0x0f,
-	0xd6, 0x08, 0x76, 0x0f, 0x47, 0x10, 0x37, 0x44, 0xcc, 0x15, 0x24, 0x24, 0x64, 0xc9, 0xc5, 0x0f,
-	0x53, 0x52, 0x96, 0x1b, 0x9f, 0x91, 0x9f, 0x9f, 0x2d, 0xc1, 0x04, 0x52, 0xe5, 0x24, 0xf8, 0xe8,
-	0x9e, 0x3c, 0xaf, 0x2b, 0x44, 0x65, 0x98, 0xaf, 0x47, 0x7e, 0x7e, 0x76, 0x10, 0x2f, 0x54, 0x63,
-	0x59, 0x2e, 0x88, 0xeb, 0xe4, 0x7d, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e,
-	0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51,
-	0x86, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x8e, 0x45, 0x20, 0x3d,
-	0x01, 0xa0, 0xa0, 0x4a, 0xce, 0xcf, 0xd1, 0x47, 0x84, 0x6f, 0x05, 0x34, 0x84, 0x4b, 0x2a, 0x0b,
-	0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x81, 0x69, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xd8, 0xbf, 0x47,
-	0xf0, 0xb4, 0x01, 0x00, 0x00,
+	// 772 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x72, 0xea, 0x36,
+	0x14, 0xc6, 0x81, 0x10, 0x22, 0x60, 0x5a, 0x94, 0x3f, 0x43, 0x27, 0x90, 0xd2, 0x2e, 0xe8, 0xa2,
+	0x76, 0x48, 0x37, 0x69, 0x77, 0x31, 0xc9, 0x34, 0x9d, 0xa6, 0x33, 0x8c, 0x9b, 0x36, 0x9d, 0x2e,
+	0xea, 0x91, 0x6d, 0x05, 0x54, 0x6c, 0x8b, 0xb1, 0x04, 0x34, 0x9b, 0x3e, 0x43, 0x96, 0x9d, 0x3e,
+	0x42, 0x37, 0x7d, 0x8d, 0x6c, 0xee, 0x4c, 0x96, 0x77, 0x95, 0xdc, 0x21, 0x2f, 0x72, 0xc7, 0x92,
+	0x9c, 0x0b, 0xc4, 0x73, 0xef, 0x5d, 0xd9, 0x3a, 0xe7, 0x7c, 0xdf, 0x27, 0x7d, 0xe7, 0x48, 0x60,
+	0x1f, 0x79, 0x31, 0xf6, 0x86, 0x88, 0x44, 0x26, 0x8e, 0xbd, 0xa3, 0x43, 0x73, 0x80, 0x23, 0xcc,
+	0x08, 0x33, 0xc6, 0x31, 0xe5, 0x14, 0xc2, 0xe7, 0xb4, 0x21, 0xd2, 0xc6, 0xb4, 0xdb, 0xf8, 0x6c,
+	0x15, 0x22, 0x33, 0x02, 0xd0, 0xd8, 0x1e, 0xd0, 0x01, 0x15, 0xbf, 0x66, 0xf2, 0xa7, 0xa2, 0xcd,
+	0x01, 0xa5, 0x83, 0x00, 0x9b, 0x62, 0xe5, 0x4e, 0xae, 0x4d, 0x7f, 0x12, 0x23, 0x4e, 0x68, 0xa4,
+	0xf2, 0xad, 0xd5, 0x3c, 0x27, 0x21, 0x66, 0x1c, 0x85, 0xe3, 0x94, 0xc0, 0xa3, 0x2c, 0xa4, 0xcc,
+	0x74, 0x11, 0xc3, 0xe6, 0xb4, 0xeb, 0x62, 0x8e, 0xba, 0xa6, 0x47, 0x89, 0x22, 0x68, 0xff, 0x9f,
+	0x07, 0x95, 0xef, 0xe5, 0xce, 0x7f, 0xe6, 0x88, 0x63, 0x78, 0x0c, 0x8a, 0x63, 0x14, 0xa3, 0x90,
+	0xe9, 0xda, 0x81, 0xd6, 0x29, 0x1f, 0x35, 0x8c, 0x97, 0x27, 0x31, 0xfa, 0xa2, 0xc2, 0x2a, 0xdc,
+	0x3d, 0xb4, 0x72, 0xb6, 0xaa, 0x87, 0xa7, 0xa0, 0xcc, 0xe9, 0x08, 0x47, 0xce, 0x18, 0x91, 0x98,
+	0xe9, 0x6b, 0x07, 0xf9, 0x4e, 0xf9, 0x68, 0x3f, 0x0b, 0x7e, 0x99, 0x94, 0xf5, 0x11, 0x89, 0x15,
+	0x03, 0xe0, 0x69, 0x80, 0xc1, 0x2b, 0x50, 0xf3, 0x68, 0x34, 0xc5, 0x31, 0x23, 0x34, 0x72, 0x02,
+	0x12, 0x12, 0xce, 0xf4, 0xbc, 0xe0, 0xfa, 0x32, 0x8b, 0xab, 0xf7, 0x5c, 0x7c, 0x21, 0x6a, 0x15,
+	0xe5, 0xa7, 0xde, 0x4a, 0x1c, 0x5e, 0x83, 0xbd, 0x00, 0x31, 0xee, 0xc4, 0x78, 0x40, 0x18, 0x97,
+	0x2e, 0x3a, 0xc2, 0x2e, 0xbd, 0x20, 0xe8, 0x3b, 0x59, 0xf4, 0x17, 0x88, 0x71, 0x7b, 0x01, 0x71,
+	0x49, 0x42, 0xac, 0x24, 0x76, 0x82, 0x8c, 0x1c, 0x83, 0x7f, 0x00, 0xb8, 0x70, 0x80, 0x19, 0x89,
+	0x7c, 0x3a, 0x63, 0xfa, 0xba, 0x90, 0xf8, 0xea, 0xfd, 0x27, 0xb8, 0x12, 0xc5, 0x67, 0x11, 0x8f,
+	0x6f, 0x94, 0x46, 0xcd, 0x5b, 0x49, 0xb2, 0xf6, 0xbf, 0x1a, 0xd8, 0xc9, 0x84, 0xc0, 0x2f, 0x40,
+	0x55, 0x90, 0x3a, 0xc8, 0xf7, 0x63, 0xcc, 0x64, 0x07, 0x37, 0xed, 0x8a, 0x08, 0x9e, 0xc8, 0x18,
+	0xd4, 0xc1, 0x46, 0x9a, 0x5e, 0x13, 0xe9, 0x74, 0x09, 0x2d, 0x50, 0x94, 0xbb, 0xd5, 0xf3, 0x07,
+	0xda, 0x87, 0xed, 0x96, 0xca, 0xe9, 0x0c, 0x48, 0x64, 0xfb, 0x4f, 0xb0, 0x9d, 0xe5, 0xd8, 0xa2,
+	0xaa, 0xb6, 0xac, 0x7a, 0x0c, 0x0a, 0x49, 0x13, 0xf4, 0x35, 0x35, 0x6d, 0x72, 0xa0, 0x8d, 0x74,
+	0xa0, 0x8d, 0xcb, 0x74, 0xa0, 0xad, 0x52, 0xa2, 0x74, 0xfb, 0xd8, 0xd2, 0x6c, 0x81, 0x68, 0xbf,
+	0x2a, 0x80, 0xa2, 0x1c, 0x44, 0xf8, 0x39, 0xa8, 0xe0, 0x08, 0xb9, 0x01, 0x76, 0xc4, 0x46, 0x85,
+	0x46, 0xc9, 0x2e, 0xcb, 0xd8, 0x59, 0x12, 0x82, 0xdf, 0x82, 0x4f, 0xd2, 0x92, 0x69, 0xe8, 0x0c,
+	0x29, 0x1d, 0x09, 0xc9, 0x92, 0x55, 0x9b, 0x3f, 0xb4, 0xaa, 0x67, 0xb2, 0xf2, 0xd7, 0x9f, 0xce,
+	0x29, 0x1d, 0xd9, 0x55, 0x05, 0x9c, 0x86, 0xc9, 0x12, 0xfe, 0x02, 0xea, 0xc4, 0xf5, 0x1c, 0x34,
+	0xe1, 0xd4, 0x91, 0xfd, 0xe0, 0x8e, 0x37, 0x44, 0x51, 0x84, 0x03, 0x39, 0x9a, 0x9b, 0x56, 0x63,
+	0xfe, 0xd0, 0xda, 0xfd, 0xc1, 0xea, 0x9d, 0x4c, 0x38, 0x95, 0x16, 0xf1, 0x9e, 0xaa, 0xb0, 0x77,
+	0x89, 0xeb, 0x65, 0xc4, 0xe1, 0xdf, 0x60, 0x7b, 0x69, 0x16, 0x7d, 0x3c, 0xa6, 0x8c, 0x70, 0x35,
+	0x8d, 0x75, 0x43, 0xde, 0x5c, 0x23, 0xb9, 0xb9, 0x86, 0xba, 0xb9, 0x46, 0x8f, 0x92, 0xc8, 0x3a,
+	0x4c, 0x8c, 0xf8, 0xef, 0xb1, 0xd5, 0x19, 0x10, 0x3e, 0x9c, 0xb8, 0x86, 0x47, 0x43, 0x53, 0x5d,
+	0x73, 0xf9, 0xf9, 0x9a, 0xf9, 0x23, 0x93, 0xdf, 0x8c, 0x31, 0x13, 0x00, 0x66, 0x6f, 0x2d, 0x0a,
+	0x9d, 0x4a, 0x1d, 0xf8, 0x1d, 0xa8, 0xbb, 0x93, 0x38, 0x72, 0x32, 0x37, 0xb1, 0x2e, 0x1c, 0xdc,
+	0x4b, 0x0a, 0xec, 0x0c, 0xac, 0x01, 0xb6, 0x50, 0x10, 0xd0, 0x19, 0xf6, 0x1d, 0x8f, 0xfa, 0xd8,
+	0x19, 0x22, 0x36, 0xc4, 0x4c, 0x2f, 0x26, 0x66, 0xd8, 0x35, 0x95, 0xea, 0x51, 0x1f, 0x9f, 0x8b,
+	0x04, 0xfc, 0x0d, 0xec, 0x2c, 0xc9, 0x78, 0x94, 0x06, 0x3e, 0x9d, 0x45, 0xfa, 0x86, 0x68, 0x7b,
+	0xfd, 0x45, 0xdb, 0x4f, 0xd5, 0x3b, 0x27, 0xbb, 0xfe, 0x4f, 0xd2, 0xf5, 0x25, 0xb7, 0x7a, 0x8a,
+	0x00, 0x9e, 0x83, 0x6a, 0x8c, 0xa7, 0x04, 0xcf, 0x9c, 0x31, 0x8e, 0x09, 0xf5, 0xf5, 0xd2, 0xc7,
+	0x33, 0x56, 0x24, 0xb2, 0x2f, 0x80, 0xd6, 0x8f, 0x77, 0xf3, 0xa6, 0x76, 0x3f, 0x6f, 0x6a, 0x6f,
+	0xe6, 0x4d, 0xed, 0xf6, 0xa9, 0x99, 0xbb, 0x7f, 0x6a, 0xe6, 0x5e, 0x3f, 0x35, 0x73, 0xbf, 0x77,
+	0x17, 0x8c, 0x3e, 0x89, 0x93, 0xd1, 0xe8, 0x27, 0xac, 0x1e, 0x0d, 0xcc, 0x77, 0x4f, 0xfa, 0x5f,
+	0xea, 0x51, 0x17, 0xbe, 0xbb, 0x45, 0xa1, 0xfb, 0xcd, 0xdb, 0x01, 0x00, 0x7d, 0xeb, 0x33, 0xb8,
+	0x27, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -186,6 +436,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConversionWindows) > 0 {
+		for iNdEx := len(m.ConversionWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConversionWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LastRegistrationTimes) > 0 {
+		for iNdEx := len(m.LastRegistrationTimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastRegistrationTimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ConversionLimits) > 0 {
+		for iNdEx := len(m.ConversionLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConversionLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TokenPairs) > 0 {
 		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -213,6 +505,91 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConversionWindowEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionWindowEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionWindowEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastRegistrationTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastRegistrationTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastRegistrationTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -233,6 +610,64 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ReviewPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ReviewPeriod):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x42
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RegistrationCooldown, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RegistrationCooldown):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.AllowedCodeHashes) > 0 {
+		for iNdEx := len(m.AllowedCodeHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCodeHashes[iNdEx])
+			copy(dAtA[i:], m.AllowedCodeHashes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedCodeHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.BurnRegistrationDeposit {
+		i--
+		if m.BurnRegistrationDeposit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RegistrationDeposit) > 0 {
+		for iNdEx := len(m.RegistrationDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.IBCAutoConvertChannels) > 0 {
+		for iNdEx := len(m.IBCAutoConvertChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IBCAutoConvertChannels[iNdEx])
+			copy(dAtA[i:], m.IBCAutoConvertChannels[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.IBCAutoConvertChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.EnableEVMHook {
 		i--
 		if m.EnableEVMHook {
@@ -281,6 +716,58 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ConversionLimits) > 0 {
+		for _, e := range m.ConversionLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LastRegistrationTimes) > 0 {
+		for _, e := range m.LastRegistrationTimes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConversionWindows) > 0 {
+		for _, e := range m.ConversionWindows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConversionWindowEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Window.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *LastRegistrationTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -296,9 +783,34 @@ func (m *Params) Size() (n int) {
 	if m.EnableEVMHook {
 		n += 2
 	}
-	return n
-}
-
+	if len(m.IBCAutoConvertChannels) > 0 {
+		for _, s := range m.IBCAutoConvertChannels {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RegistrationDeposit) > 0 {
+		for _, e := range m.RegistrationDeposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BurnRegistrationDeposit {
+		n += 2
+	}
+	if len(m.AllowedCodeHashes) > 0 {
+		for _, s := range m.AllowedCodeHashes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RegistrationCooldown)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ReviewPeriod)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -401,6 +913,370 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionLimits = append(m.ConversionLimits, ConversionLimits{})
+			if err := m.ConversionLimits[len(m.ConversionLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRegistrationTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastRegistrationTimes = append(m.LastRegistrationTimes, LastRegistrationTime{})
+			if err := m.LastRegistrationTimes[len(m.LastRegistrationTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionWindows = append(m.ConversionWindows, ConversionWindowEntry{})
+			if err := m.ConversionWindows[len(m.ConversionWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionWindowEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionWindowEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionWindowEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastRegistrationTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastRegistrationTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastRegistrationTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -491,6 +1367,190 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableEVMHook = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCAutoConvertChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCAutoConvertChannels = append(m.IBCAutoConvertChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationDeposit = append(m.RegistrationDeposit, types.Coin{})
+			if err := m.RegistrationDeposit[len(m.RegistrationDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRegistrationDeposit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnRegistrationDeposit = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCodeHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCodeHashes = append(m.AllowedCodeHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationCooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RegistrationCooldown, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ReviewPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
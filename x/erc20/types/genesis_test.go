@@ -2,7 +2,9 @@ package types
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -18,7 +20,7 @@ func TestGenesisTestSuite(t *testing.T) {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
-	newGen := NewGenesisState(DefaultParams(), []TokenPair{})
+	newGen := NewGenesisState(DefaultParams(), []TokenPair{}, []ConversionLimits{})
 
 	testCases := []struct {
 		name     string
@@ -128,6 +130,294 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with conversion limits",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				ConversionLimits: []ConversionLimits{
+					{
+						Erc20Address:     "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						MaxPerConversion: sdk.NewInt(100),
+						MaxPerWindow:     sdk.NewInt(1000),
+						MaxPerAddress:    sdk.ZeroInt(),
+						Window:           time.Hour,
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - conversion limits of unregistered token pair",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				ConversionLimits: []ConversionLimits{
+					{
+						Erc20Address:     "0xB8c77482e45F1F44dE1745F52C74426C631bDD52",
+						MaxPerConversion: sdk.NewInt(100),
+						MaxPerWindow:     sdk.ZeroInt(),
+						MaxPerAddress:    sdk.ZeroInt(),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated conversion limits",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				ConversionLimits: []ConversionLimits{
+					{
+						Erc20Address:     "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						MaxPerConversion: sdk.NewInt(100),
+						MaxPerWindow:     sdk.ZeroInt(),
+						MaxPerAddress:    sdk.ZeroInt(),
+					},
+					{
+						Erc20Address:     "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						MaxPerConversion: sdk.NewInt(200),
+						MaxPerWindow:     sdk.ZeroInt(),
+						MaxPerAddress:    sdk.ZeroInt(),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid conversion limits",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				ConversionLimits: []ConversionLimits{
+					{
+						Erc20Address:     "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						MaxPerConversion: sdk.ZeroInt(),
+						MaxPerWindow:     sdk.NewInt(1000),
+						MaxPerAddress:    sdk.ZeroInt(),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with last registration times",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				LastRegistrationTimes: []LastRegistrationTime{
+					{
+						Address: sdk.AccAddress([]byte("registration_sender_")).String(),
+						Time:    time.Unix(1000, 0).UTC(),
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - invalid last registration time sender",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				LastRegistrationTimes: []LastRegistrationTime{
+					{
+						Address: "invalid",
+						Time:    time.Unix(1000, 0).UTC(),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated last registration time",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				LastRegistrationTimes: []LastRegistrationTime{
+					{
+						Address: sdk.AccAddress([]byte("registration_sender_")).String(),
+						Time:    time.Unix(1000, 0).UTC(),
+					},
+					{
+						Address: sdk.AccAddress([]byte("registration_sender_")).String(),
+						Time:    time.Unix(2000, 0).UTC(),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with conversion windows",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				ConversionLimits: []ConversionLimits{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						MaxPerWindow:  sdk.NewInt(1000),
+						MaxPerAddress: sdk.ZeroInt(),
+						Window:        time.Hour,
+					},
+				},
+				ConversionWindows: []ConversionWindowEntry{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Window:       NewConversionWindow(3600),
+					},
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Address:      sdk.AccAddress([]byte("conversion_sender___")).String(),
+						Window:       NewConversionWindow(3600),
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated conversion window",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				ConversionLimits: []ConversionLimits{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						MaxPerWindow:  sdk.NewInt(1000),
+						MaxPerAddress: sdk.ZeroInt(),
+						Window:        time.Hour,
+					},
+				},
+				ConversionWindows: []ConversionWindowEntry{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Window:       NewConversionWindow(3600),
+					},
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Window:       NewConversionWindow(7200),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - conversion window without conversion limits",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				ConversionLimits: []ConversionLimits{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						MaxPerWindow:  sdk.NewInt(1000),
+						MaxPerAddress: sdk.ZeroInt(),
+						Window:        time.Hour,
+					},
+				},
+				ConversionWindows: []ConversionWindowEntry{
+					{
+						Erc20Address: "0x5dCA2483280D9727c80b5518faC4556617fb194F",
+						Window:       NewConversionWindow(3600),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - negative conversion window amount",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				ConversionLimits: []ConversionLimits{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						MaxPerWindow:  sdk.NewInt(1000),
+						MaxPerAddress: sdk.ZeroInt(),
+						Window:        time.Hour,
+					},
+				},
+				ConversionWindows: []ConversionWindowEntry{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Window:       ConversionWindow{StartTime: 3600, Current: sdk.NewInt(-1), Previous: sdk.ZeroInt()},
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid conversion window address",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				ConversionLimits: []ConversionLimits{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						MaxPerWindow:  sdk.NewInt(1000),
+						MaxPerAddress: sdk.ZeroInt(),
+						Window:        time.Hour,
+					},
+				},
+				ConversionWindows: []ConversionWindowEntry{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Address:      "invalid",
+						Window:       NewConversionWindow(3600),
+					},
+				},
+			},
+			expPass: false,
+		},
 		{
 			// Voting period cant be zero
 			name:     "empty genesis",
@@ -29,11 +29,23 @@ const (
 	prefixTokenPair = iota + 1
 	prefixTokenPairByERC20
 	prefixTokenPairByDenom
+	prefixLastRegistrationTime
+	prefixConversionLimits
+	prefixConversionWindow
+	prefixAddressConversionWindow
+	prefixWithdrawalQueue
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixTokenPair        = []byte{prefixTokenPair}
-	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
-	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
+	KeyPrefixTokenPair            = []byte{prefixTokenPair}
+	KeyPrefixTokenPairByERC20     = []byte{prefixTokenPairByERC20}
+	KeyPrefixTokenPairByDenom     = []byte{prefixTokenPairByDenom}
+	KeyPrefixLastRegistrationTime = []byte{prefixLastRegistrationTime}
+
+	KeyPrefixConversionLimits        = []byte{prefixConversionLimits}
+	KeyPrefixConversionWindow        = []byte{prefixConversionWindow}
+	KeyPrefixAddressConversionWindow = []byte{prefixAddressConversionWindow}
+
+	KeyPrefixWithdrawalQueue = []byte{prefixWithdrawalQueue}
 )
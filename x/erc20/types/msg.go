@@ -11,11 +11,17 @@ import (
 var (
 	_ sdk.Msg = &MsgConvertCoin{}
 	_ sdk.Msg = &MsgConvertERC20{}
+	_ sdk.Msg = &MsgRegisterERC20WithDeposit{}
+	_ sdk.Msg = &MsgConvertCoinBatch{}
+	_ sdk.Msg = &MsgConvertERC20Batch{}
 )
 
 const (
-	TypeMsgConvertCoin  = "convert_coin"
-	TypeMsgConvertERC20 = "convert_ERC20"
+	TypeMsgConvertCoin              = "convert_coin"
+	TypeMsgConvertERC20             = "convert_ERC20"
+	TypeMsgRegisterERC20WithDeposit = "register_ERC20_with_deposit"
+	TypeMsgConvertCoinBatch         = "convert_coin_batch"
+	TypeMsgConvertERC20Batch        = "convert_ERC20_batch"
 )
 
 // NewMsgConvertCoin creates a new instance of MsgConvertCoin
@@ -35,14 +41,8 @@ func (msg MsgConvertCoin) Type() string { return TypeMsgConvertCoin }
 
 // ValidateBasic runs stateless checks on the message
 func (msg MsgConvertCoin) ValidateBasic() error {
-	if err := ValidateErc20Denom(msg.Coin.Denom); err != nil {
-		if err := ibctransfertypes.ValidateIBCDenom(msg.Coin.Denom); err != nil {
-			return err
-		}
-	}
-
-	if !msg.Coin.Amount.IsPositive() {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "cannot mint a non-positive amount")
+	if err := validateConversionCoin(msg.Coin); err != nil {
+		return err
 	}
 	_, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
@@ -54,6 +54,21 @@ func (msg MsgConvertCoin) ValidateBasic() error {
 	return nil
 }
 
+// validateConversionCoin checks that a coin to convert has a valid
+// denomination and a positive amount
+func validateConversionCoin(coin sdk.Coin) error {
+	if err := ValidateErc20Denom(coin.Denom); err != nil {
+		if err := ibctransfertypes.ValidateIBCDenom(coin.Denom); err != nil {
+			return err
+		}
+	}
+
+	if !coin.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "cannot mint a non-positive amount")
+	}
+	return nil
+}
+
 // GetSignBytes encodes the message for signing
 func (msg MsgConvertCoin) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
@@ -109,3 +124,151 @@ func (msg MsgConvertERC20) GetSigners() []sdk.AccAddress {
 	addr := common.HexToAddress(msg.Sender)
 	return []sdk.AccAddress{addr.Bytes()}
 }
+
+// NewMsgRegisterERC20WithDeposit creates a new instance of MsgRegisterERC20WithDeposit
+func NewMsgRegisterERC20WithDeposit(sender sdk.AccAddress, contract common.Address, behavior TokenBehavior) *MsgRegisterERC20WithDeposit { // nolint: interfacer
+	return &MsgRegisterERC20WithDeposit{
+		Sender:       sender.String(),
+		Erc20Address: contract.String(),
+		Behavior:     behavior,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRegisterERC20WithDeposit) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRegisterERC20WithDeposit) Type() string { return TypeMsgRegisterERC20WithDeposit }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRegisterERC20WithDeposit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	if !common.IsHexAddress(msg.Erc20Address) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract hex address '%s'", msg.Erc20Address)
+	}
+	if err := ValidateTokenBehavior(msg.Behavior); err != nil {
+		return sdkerrors.Wrap(ErrTokenBehavior, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRegisterERC20WithDeposit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterERC20WithDeposit) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgConvertCoinBatch creates a new instance of MsgConvertCoinBatch
+func NewMsgConvertCoinBatch(coins sdk.Coins, receiver common.Address, sender sdk.AccAddress) *MsgConvertCoinBatch { // nolint: interfacer
+	return &MsgConvertCoinBatch{
+		Coins:    coins,
+		Receiver: receiver.Hex(),
+		Sender:   sender.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertCoinBatch) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertCoinBatch) Type() string { return TypeMsgConvertCoinBatch }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertCoinBatch) ValidateBasic() error {
+	if len(msg.Coins) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "no coins to convert")
+	}
+	if err := msg.Coins.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	for _, coin := range msg.Coins {
+		if err := validateConversionCoin(coin); err != nil {
+			return err
+		}
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	if !common.IsHexAddress(msg.Receiver) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver hex address %s", msg.Receiver)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgConvertCoinBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertCoinBatch) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgConvertERC20Batch creates a new instance of MsgConvertERC20Batch
+func NewMsgConvertERC20Batch(tokens []ERC20Amount, receiver sdk.AccAddress, sender common.Address) *MsgConvertERC20Batch { // nolint: interfacer
+	return &MsgConvertERC20Batch{
+		Tokens:   tokens,
+		Receiver: receiver.String(),
+		Sender:   sender.Hex(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertERC20Batch) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertERC20Batch) Type() string { return TypeMsgConvertERC20Batch }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertERC20Batch) ValidateBasic() error {
+	if len(msg.Tokens) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "no tokens to convert")
+	}
+
+	seenContracts := make(map[common.Address]bool)
+	for _, token := range msg.Tokens {
+		if !common.IsHexAddress(token.ContractAddress) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract hex address '%s'", token.ContractAddress)
+		}
+		contract := common.HexToAddress(token.ContractAddress)
+		if seenContracts[contract] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "duplicated contract address '%s'", token.ContractAddress)
+		}
+		if token.Amount.IsNil() || !token.Amount.IsPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "cannot mint a non-positive amount")
+		}
+		seenContracts[contract] = true
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid receiver address")
+	}
+	if !common.IsHexAddress(msg.Sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender hex address %s", msg.Sender)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgConvertERC20Batch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertERC20Batch) GetSigners() []sdk.AccAddress {
+	addr := common.HexToAddress(msg.Sender)
+	return []sdk.AccAddress{addr.Bytes()}
+}
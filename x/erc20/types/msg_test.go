@@ -245,3 +245,263 @@ func (suite *MsgsTestSuite) TestMsgConvertERC20() {
 		}
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgRegisterERC20Getters() {
+	msgInvalid := MsgRegisterERC20WithDeposit{}
+	msg := NewMsgRegisterERC20WithDeposit(
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+		tests.GenerateAddress(),
+		TOKEN_BEHAVIOR_STANDARD,
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgRegisterERC20WithDeposit, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterERC20WithDeposit() {
+	testCases := []struct {
+		msg        string
+		sender     string
+		contract   string
+		behavior   TokenBehavior
+		expectPass bool
+	}{
+		{
+			"invalid sender",
+			tests.GenerateAddress().String(),
+			tests.GenerateAddress().String(),
+			TOKEN_BEHAVIOR_STANDARD,
+			false,
+		},
+		{
+			"invalid contract hex address",
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress{}.String(),
+			TOKEN_BEHAVIOR_STANDARD,
+			false,
+		},
+		{
+			"rebasing token",
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			TOKEN_BEHAVIOR_REBASING_FORBIDDEN,
+			false,
+		},
+		{
+			"msg register erc20 - pass",
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			TOKEN_BEHAVIOR_STANDARD,
+			true,
+		},
+		{
+			"msg register erc20 - fee on transfer - pass",
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			TOKEN_BEHAVIOR_FEE_ON_TRANSFER,
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgRegisterERC20WithDeposit{tc.sender, tc.contract, tc.behavior}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertCoinBatchGetters() {
+	msgInvalid := MsgConvertCoinBatch{}
+	msg := NewMsgConvertCoinBatch(
+		sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100))),
+		tests.GenerateAddress(),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgConvertCoinBatch, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertCoinBatch() {
+	testCases := []struct {
+		msg        string
+		coins      sdk.Coins
+		receiver   string
+		sender     string
+		expectPass bool
+	}{
+		{
+			"no coins",
+			sdk.Coins{},
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"unsorted coins",
+			sdk.Coins{sdk.NewInt64Coin("test2", 100), sdk.NewInt64Coin("test", 100)},
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"duplicated coins",
+			sdk.Coins{sdk.NewInt64Coin("test", 100), sdk.NewInt64Coin("test", 100)},
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"zero amount",
+			sdk.Coins{sdk.NewInt64Coin("test", 0)},
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"ibc voucher",
+			sdk.Coins{sdk.NewInt64Coin("ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", 100), sdk.NewInt64Coin("test", 100)},
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			true,
+		},
+		{
+			"invalid sender address",
+			sdk.NewCoins(sdk.NewInt64Coin("test", 100)),
+			tests.GenerateAddress().String(),
+			"evmosinvalid",
+			false,
+		},
+		{
+			"invalid receiver address",
+			sdk.NewCoins(sdk.NewInt64Coin("test", 100)),
+			"0x0000",
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"msg convert coin batch - pass",
+			sdk.NewCoins(sdk.NewInt64Coin("test", 100), sdk.NewInt64Coin("test2", 50)),
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgConvertCoinBatch{tc.coins, tc.receiver, tc.sender}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertERC20BatchGetters() {
+	msgInvalid := MsgConvertERC20Batch{}
+	msg := NewMsgConvertERC20Batch(
+		[]ERC20Amount{{ContractAddress: tests.GenerateAddress().String(), Amount: sdk.NewInt(100)}},
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+		tests.GenerateAddress(),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgConvertERC20Batch, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertERC20Batch() {
+	contract := tests.GenerateAddress()
+
+	testCases := []struct {
+		msg        string
+		tokens     []ERC20Amount
+		receiver   string
+		sender     string
+		expectPass bool
+	}{
+		{
+			"no tokens",
+			[]ERC20Amount{},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"invalid contract hex address",
+			[]ERC20Amount{{ContractAddress: sdk.AccAddress{}.String(), Amount: sdk.NewInt(100)}},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"duplicated contract",
+			[]ERC20Amount{
+				{ContractAddress: contract.String(), Amount: sdk.NewInt(100)},
+				{ContractAddress: contract.Hex(), Amount: sdk.NewInt(100)},
+			},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"zero amount",
+			[]ERC20Amount{{ContractAddress: contract.String(), Amount: sdk.ZeroInt()}},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"nil amount",
+			[]ERC20Amount{{ContractAddress: contract.String()}},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"invalid receiver address",
+			[]ERC20Amount{{ContractAddress: contract.String(), Amount: sdk.NewInt(100)}},
+			sdk.AccAddress{}.String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"invalid sender address",
+			[]ERC20Amount{{ContractAddress: contract.String(), Amount: sdk.NewInt(100)}},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress{}.String(),
+			false,
+		},
+		{
+			"msg convert erc20 batch - pass",
+			[]ERC20Amount{
+				{ContractAddress: contract.String(), Amount: sdk.NewInt(100)},
+				{ContractAddress: tests.GenerateAddress().String(), Amount: sdk.NewInt(50)},
+			},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgConvertERC20Batch{tc.tokens, tc.receiver, tc.sender}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
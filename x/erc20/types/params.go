@@ -2,14 +2,31 @@ package types
 
 import (
 	fmt "fmt"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 // Parameter store key
 var (
-	ParamStoreKeyEnableErc20   = []byte("EnableErc20")
-	ParamStoreKeyEnableEVMHook = []byte("EnableEVMHook")
+	ParamStoreKeyEnableErc20             = []byte("EnableErc20")
+	ParamStoreKeyEnableEVMHook           = []byte("EnableEVMHook")
+	ParamStoreKeyIBCAutoConvertChannels  = []byte("IBCAutoConvertChannels")
+	ParamStoreKeyRegistrationDeposit     = []byte("RegistrationDeposit")
+	ParamStoreKeyBurnRegistrationDeposit = []byte("BurnRegistrationDeposit")
+	ParamStoreKeyAllowedCodeHashes       = []byte("AllowedCodeHashes")
+	ParamStoreKeyRegistrationCooldown    = []byte("RegistrationCooldown")
+	ParamStoreKeyReviewPeriod            = []byte("ReviewPeriod")
+)
+
+// Default values of the permissionless registration parameters
+const (
+	DefaultRegistrationCooldown = time.Hour
+	DefaultReviewPeriod         = 7 * 24 * time.Hour
 )
 
 var _ paramtypes.ParamSet = &Params{}
@@ -23,17 +40,31 @@ func ParamKeyTable() paramtypes.KeyTable {
 func NewParams(
 	enableErc20 bool,
 	enableEVMHook bool,
+	ibcAutoConvertChannels []string,
+	registrationDeposit sdk.Coins,
+	burnRegistrationDeposit bool,
+	allowedCodeHashes []string,
+	registrationCooldown time.Duration,
+	reviewPeriod time.Duration,
 ) Params {
 	return Params{
-		EnableErc20:   enableErc20,
-		EnableEVMHook: enableEVMHook,
+		EnableErc20:             enableErc20,
+		EnableEVMHook:           enableEVMHook,
+		IBCAutoConvertChannels:  ibcAutoConvertChannels,
+		RegistrationDeposit:     registrationDeposit,
+		BurnRegistrationDeposit: burnRegistrationDeposit,
+		AllowedCodeHashes:       allowedCodeHashes,
+		RegistrationCooldown:    registrationCooldown,
+		ReviewPeriod:            reviewPeriod,
 	}
 }
 
 func DefaultParams() Params {
 	return Params{
-		EnableErc20:   true,
-		EnableEVMHook: true,
+		EnableErc20:          true,
+		EnableEVMHook:        true,
+		RegistrationCooldown: DefaultRegistrationCooldown,
+		ReviewPeriod:         DefaultReviewPeriod,
 	}
 }
 
@@ -46,12 +77,117 @@ func validateBool(i interface{}) error {
 	return nil
 }
 
+func validateChannels(i interface{}) error {
+	channels, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, channel := range channels {
+		if err := host.ChannelIdentifierValidator(channel); err != nil {
+			return fmt.Errorf("invalid auto convert channel %q: %w", channel, err)
+		}
+		if seen[channel] {
+			return fmt.Errorf("duplicated auto convert channel %q", channel)
+		}
+		seen[channel] = true
+	}
+
+	return nil
+}
+
+func validateCoins(i interface{}) error {
+	coins, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return coins.Validate()
+}
+
+func validateCodeHashes(i interface{}) error {
+	hashes, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, hash := range hashes {
+		bz, err := hexutil.Decode(hash)
+		if err != nil || len(bz) != common.HashLength {
+			return fmt.Errorf("invalid code hash %q", hash)
+		}
+		if seen[hash] {
+			return fmt.Errorf("duplicated code hash %q", hash)
+		}
+		seen[hash] = true
+	}
+
+	return nil
+}
+
+func validateDuration(i interface{}) error {
+	duration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if duration < 0 {
+		return fmt.Errorf("duration cannot be negative: %s", duration)
+	}
+
+	return nil
+}
+
+// IsCodeHashAllowed returns true if the contracts with the code hash can be
+// registered through a MsgRegisterERC20WithDeposit.
+func (p Params) IsCodeHashAllowed(codeHash common.Hash) bool {
+	for _, hash := range p.AllowedCodeHashes {
+		if common.HexToHash(hash) == codeHash {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAutoConvertChannel returns true if the vouchers received on the channel are
+// automatically converted to ERC20 tokens.
+func (p Params) IsAutoConvertChannel(channel string) bool {
+	for _, c := range p.IBCAutoConvertChannels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
+
 // ParamSetPairs returns the parameter set pairs.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableErc20, &p.EnableErc20, validateBool),
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableEVMHook, &p.EnableEVMHook, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyIBCAutoConvertChannels, &p.IBCAutoConvertChannels, validateChannels),
+		paramtypes.NewParamSetPair(ParamStoreKeyRegistrationDeposit, &p.RegistrationDeposit, validateCoins),
+		paramtypes.NewParamSetPair(ParamStoreKeyBurnRegistrationDeposit, &p.BurnRegistrationDeposit, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyAllowedCodeHashes, &p.AllowedCodeHashes, validateCodeHashes),
+		paramtypes.NewParamSetPair(ParamStoreKeyRegistrationCooldown, &p.RegistrationCooldown, validateDuration),
+		paramtypes.NewParamSetPair(ParamStoreKeyReviewPeriod, &p.ReviewPeriod, validateDuration),
 	}
 }
 
-func (p Params) Validate() error { return nil }
+func (p Params) Validate() error {
+	if err := validateChannels(p.IBCAutoConvertChannels); err != nil {
+		return err
+	}
+	if err := validateCoins(p.RegistrationDeposit); err != nil {
+		return err
+	}
+	if err := validateCodeHashes(p.AllowedCodeHashes); err != nil {
+		return err
+	}
+	if err := validateDuration(p.RegistrationCooldown); err != nil {
+		return err
+	}
+	return validateDuration(p.ReviewPeriod)
+}
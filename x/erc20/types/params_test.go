@@ -2,11 +2,16 @@ package types
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 )
 
+const testCodeHash = "0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"
+
 type ParamsTestSuite struct {
 	suite.Suite
 }
@@ -28,9 +33,49 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{"default", DefaultParams(), false},
 		{
 			"valid",
-			NewParams(true, true),
+			NewParams(true, true, []string{"channel-0", "channel-14"}, nil, false, nil, time.Hour, time.Hour),
+			false,
+		},
+		{
+			"valid registration",
+			NewParams(true, true, nil, sdk.NewCoins(sdk.NewInt64Coin("aacre", 100)), true, []string{testCodeHash}, 0, 0),
 			false,
 		},
+		{
+			"invalid auto convert channel",
+			NewParams(true, true, []string{"channel"}, nil, false, nil, 0, 0),
+			true,
+		},
+		{
+			"duplicated auto convert channel",
+			NewParams(true, true, []string{"channel-0", "channel-0"}, nil, false, nil, 0, 0),
+			true,
+		},
+		{
+			"invalid registration deposit",
+			NewParams(true, true, nil, sdk.Coins{{Denom: "aacre", Amount: sdk.NewInt(-1)}}, false, nil, 0, 0),
+			true,
+		},
+		{
+			"invalid code hash",
+			NewParams(true, true, nil, nil, false, []string{"0x1234"}, 0, 0),
+			true,
+		},
+		{
+			"duplicated code hash",
+			NewParams(true, true, nil, nil, false, []string{testCodeHash, testCodeHash}, 0, 0),
+			true,
+		},
+		{
+			"negative registration cooldown",
+			NewParams(true, true, nil, nil, false, nil, -time.Hour, 0),
+			true,
+		},
+		{
+			"negative review period",
+			NewParams(true, true, nil, nil, false, nil, 0, -time.Hour),
+			true,
+		},
 		{
 			"empty",
 			Params{},
@@ -52,4 +97,27 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 func (suite *ParamsTestSuite) TestParamsValidatePriv() {
 	suite.Require().Error(validateBool(1))
 	suite.Require().NoError(validateBool(true))
+	suite.Require().Error(validateChannels("channel-0"))
+	suite.Require().NoError(validateChannels([]string{"channel-0"}))
+	suite.Require().Error(validateCoins(sdk.NewInt64Coin("aacre", 1)))
+	suite.Require().NoError(validateCoins(sdk.NewCoins(sdk.NewInt64Coin("aacre", 1))))
+	suite.Require().Error(validateCodeHashes(testCodeHash))
+	suite.Require().Error(validateCodeHashes([]string{"c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"}))
+	suite.Require().NoError(validateCodeHashes([]string{testCodeHash}))
+	suite.Require().Error(validateDuration(int64(1)))
+	suite.Require().Error(validateDuration(-time.Second))
+	suite.Require().NoError(validateDuration(time.Second))
+}
+
+func (suite *ParamsTestSuite) TestIsAutoConvertChannel() {
+	params := NewParams(true, true, []string{"channel-0"}, nil, false, nil, 0, 0)
+	suite.Require().True(params.IsAutoConvertChannel("channel-0"))
+	suite.Require().False(params.IsAutoConvertChannel("channel-1"))
+}
+
+func (suite *ParamsTestSuite) TestIsCodeHashAllowed() {
+	params := NewParams(true, true, nil, nil, false, []string{testCodeHash}, 0, 0)
+	suite.Require().True(params.IsCodeHashAllowed(common.HexToHash(testCodeHash)))
+	suite.Require().False(params.IsCodeHashAllowed(common.Hash{}))
+	suite.Require().False(DefaultParams().IsCodeHashAllowed(common.HexToHash(testCodeHash)))
 }
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -14,9 +15,13 @@ import (
 
 // constants
 const (
-	ProposalTypeRegisterCoin          string = "RegisterCoin"
-	ProposalTypeRegisterERC20         string = "RegisterERC20"
-	ProposalTypeToggleTokenConversion string = "ToggleTokenConversion" // #nosec
+	ProposalTypeRegisterCoin            string = "RegisterCoin"
+	ProposalTypeRegisterERC20           string = "RegisterERC20"
+	ProposalTypeToggleTokenConversion   string = "ToggleTokenConversion" // #nosec
+	ProposalTypeRevokeERC20Registration string = "RevokeERC20Registration"
+	ProposalTypeUpdateConversionLimits  string = "UpdateConversionLimits"
+	ProposalTypeUpdateTokenPairMetadata string = "UpdateTokenPairMetadata"
+	ProposalTypeDeregisterTokenPair     string = "DeregisterTokenPair"
 )
 
 // Implements Proposal Interface
@@ -24,15 +29,27 @@ var (
 	_ govtypes.Content = &RegisterCoinProposal{}
 	_ govtypes.Content = &RegisterERC20Proposal{}
 	_ govtypes.Content = &ToggleTokenConversionProposal{}
+	_ govtypes.Content = &RevokeERC20RegistrationProposal{}
+	_ govtypes.Content = &UpdateConversionLimitsProposal{}
+	_ govtypes.Content = &UpdateTokenPairMetadataProposal{}
+	_ govtypes.Content = &DeregisterTokenPairProposal{}
 )
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeRegisterCoin)
 	govtypes.RegisterProposalType(ProposalTypeRegisterERC20)
 	govtypes.RegisterProposalType(ProposalTypeToggleTokenConversion)
+	govtypes.RegisterProposalType(ProposalTypeRevokeERC20Registration)
+	govtypes.RegisterProposalType(ProposalTypeUpdateConversionLimits)
+	govtypes.RegisterProposalType(ProposalTypeUpdateTokenPairMetadata)
+	govtypes.RegisterProposalType(ProposalTypeDeregisterTokenPair)
 	govtypes.RegisterProposalTypeCodec(&RegisterCoinProposal{}, "erc20/RegisterCoinProposal")
 	govtypes.RegisterProposalTypeCodec(&RegisterERC20Proposal{}, "erc20/RegisterERC20Proposal")
 	govtypes.RegisterProposalTypeCodec(&ToggleTokenConversionProposal{}, "erc20/ToggleTokenConversionProposal")
+	govtypes.RegisterProposalTypeCodec(&RevokeERC20RegistrationProposal{}, "erc20/RevokeERC20RegistrationProposal")
+	govtypes.RegisterProposalTypeCodec(&UpdateConversionLimitsProposal{}, "erc20/UpdateConversionLimitsProposal")
+	govtypes.RegisterProposalTypeCodec(&UpdateTokenPairMetadataProposal{}, "erc20/UpdateTokenPairMetadataProposal")
+	govtypes.RegisterProposalTypeCodec(&DeregisterTokenPairProposal{}, "erc20/DeregisterTokenPairProposal")
 }
 
 // CreateDenomDescription generates a string with the coin description
@@ -111,11 +128,13 @@ func ValidateErc20Denom(denom string) error {
 }
 
 // NewRegisterERC20Proposal returns new instance of RegisterERC20Proposal
-func NewRegisterERC20Proposal(title, description, erc20Addr string) govtypes.Content {
+func NewRegisterERC20Proposal(title, description, erc20Addr string, behavior TokenBehavior, probeHolder string) govtypes.Content {
 	return &RegisterERC20Proposal{
 		Title:        title,
 		Description:  description,
 		Erc20Address: erc20Addr,
+		Behavior:     behavior,
+		ProbeHolder:  probeHolder,
 	}
 }
 
@@ -132,6 +151,12 @@ func (rtbp *RegisterERC20Proposal) ValidateBasic() error {
 	if err := ethermint.ValidateAddress(rtbp.Erc20Address); err != nil {
 		return sdkerrors.Wrap(err, "ERC20 address")
 	}
+	if err := ValidateTokenBehavior(rtbp.Behavior); err != nil {
+		return sdkerrors.Wrap(ErrTokenBehavior, err.Error())
+	}
+	if err := ethermint.ValidateNonZeroAddress(rtbp.ProbeHolder); err != nil {
+		return sdkerrors.Wrap(err, "probe holder address")
+	}
 	return govtypes.ValidateAbstract(rtbp)
 }
 
@@ -164,3 +189,135 @@ func (ttcp *ToggleTokenConversionProposal) ValidateBasic() error {
 
 	return govtypes.ValidateAbstract(ttcp)
 }
+
+// NewRevokeERC20RegistrationProposal returns new instance of RevokeERC20RegistrationProposal
+func NewRevokeERC20RegistrationProposal(title, description, erc20Addr string) govtypes.Content {
+	return &RevokeERC20RegistrationProposal{
+		Title:        title,
+		Description:  description,
+		Erc20Address: erc20Addr,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*RevokeERC20RegistrationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*RevokeERC20RegistrationProposal) ProposalType() string {
+	return ProposalTypeRevokeERC20Registration
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (rrp *RevokeERC20RegistrationProposal) ValidateBasic() error {
+	if err := ethermint.ValidateAddress(rrp.Erc20Address); err != nil {
+		return sdkerrors.Wrap(err, "ERC20 address")
+	}
+	return govtypes.ValidateAbstract(rrp)
+}
+
+// NewUpdateConversionLimitsProposal returns new instance of UpdateConversionLimitsProposal
+func NewUpdateConversionLimitsProposal(title, description string, limits ConversionLimits) govtypes.Content {
+	return &UpdateConversionLimitsProposal{
+		Title:       title,
+		Description: description,
+		Limits:      limits,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*UpdateConversionLimitsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*UpdateConversionLimitsProposal) ProposalType() string {
+	return ProposalTypeUpdateConversionLimits
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (uclp *UpdateConversionLimitsProposal) ValidateBasic() error {
+	if err := uclp.Limits.Validate(); err != nil {
+		return err
+	}
+	return govtypes.ValidateAbstract(uclp)
+}
+
+// NewUpdateTokenPairMetadataProposal returns new instance of UpdateTokenPairMetadataProposal
+func NewUpdateTokenPairMetadataProposal(title, description, token string, coinMetadata banktypes.Metadata) govtypes.Content {
+	return &UpdateTokenPairMetadataProposal{
+		Title:       title,
+		Description: description,
+		Token:       token,
+		Metadata:    coinMetadata,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*UpdateTokenPairMetadataProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*UpdateTokenPairMetadataProposal) ProposalType() string {
+	return ProposalTypeUpdateTokenPairMetadata
+}
+
+// ValidateBasic performs a stateless check of the proposal fields. The
+// metadata is only validated when it is set, as it is read from the ERC20
+// contract for the token pairs that are not owned by the module.
+func (utpmp *UpdateTokenPairMetadataProposal) ValidateBasic() error {
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(utpmp.Token); err != nil {
+		if err := sdk.ValidateDenom(utpmp.Token); err != nil {
+			return err
+		}
+	}
+
+	if utpmp.Metadata.Base != "" {
+		if err := utpmp.Metadata.Validate(); err != nil {
+			return err
+		}
+
+		if err := ibctransfertypes.ValidateIBCDenom(utpmp.Metadata.Base); err != nil {
+			return err
+		}
+
+		if err := validateIBCVoucherMetadata(utpmp.Metadata); err != nil {
+			return err
+		}
+	}
+
+	return govtypes.ValidateAbstract(utpmp)
+}
+
+// NewDeregisterTokenPairProposal returns new instance of DeregisterTokenPairProposal
+func NewDeregisterTokenPairProposal(title, description, token string, withdrawalPeriod time.Duration) govtypes.Content {
+	return &DeregisterTokenPairProposal{
+		Title:            title,
+		Description:      description,
+		Token:            token,
+		WithdrawalPeriod: withdrawalPeriod,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*DeregisterTokenPairProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*DeregisterTokenPairProposal) ProposalType() string {
+	return ProposalTypeDeregisterTokenPair
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (dtpp *DeregisterTokenPairProposal) ValidateBasic() error {
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(dtpp.Token); err != nil {
+		if err := sdk.ValidateDenom(dtpp.Token); err != nil {
+			return err
+		}
+	}
+
+	if dtpp.WithdrawalPeriod <= 0 {
+		return fmt.Errorf("withdrawal period must be positive: %s", dtpp.WithdrawalPeriod)
+	}
+
+	return govtypes.ValidateAbstract(dtpp)
+}
@@ -3,7 +3,9 @@ package types
 import (
 	"strings"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/evmos/ethermint/tests"
@@ -27,6 +29,14 @@ func (suite *ProposalTestSuite) TestKeysTypes() {
 	suite.Require().Equal("RegisterERC20", (&RegisterERC20Proposal{}).ProposalType())
 	suite.Require().Equal("erc20", (&ToggleTokenConversionProposal{}).ProposalRoute())
 	suite.Require().Equal("ToggleTokenConversion", (&ToggleTokenConversionProposal{}).ProposalType())
+	suite.Require().Equal("erc20", (&RevokeERC20RegistrationProposal{}).ProposalRoute())
+	suite.Require().Equal("RevokeERC20Registration", (&RevokeERC20RegistrationProposal{}).ProposalType())
+	suite.Require().Equal("erc20", (&UpdateConversionLimitsProposal{}).ProposalRoute())
+	suite.Require().Equal("UpdateConversionLimits", (&UpdateConversionLimitsProposal{}).ProposalType())
+	suite.Require().Equal("erc20", (&UpdateTokenPairMetadataProposal{}).ProposalRoute())
+	suite.Require().Equal("UpdateTokenPairMetadata", (&UpdateTokenPairMetadataProposal{}).ProposalType())
+	suite.Require().Equal("erc20", (&DeregisterTokenPairProposal{}).ProposalRoute())
+	suite.Require().Equal("DeregisterTokenPair", (&DeregisterTokenPairProposal{}).ProposalType())
 }
 
 func (suite *ProposalTestSuite) TestCreateDenomDescription() {
@@ -127,20 +137,20 @@ func (suite *ProposalTestSuite) TestRegisterERC20Proposal() {
 		expectPass  bool
 	}{
 		// Valid tests
-		{msg: "Register token pair - valid pair enabled", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE}, expectPass: true},
-		{msg: "Register token pair - valid pair dissabled", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE}, expectPass: true},
+		{msg: "Register token pair - valid pair enabled", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE, 0, TOKEN_BEHAVIOR_STANDARD, 0}, expectPass: true},
+		{msg: "Register token pair - valid pair dissabled", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE, 0, TOKEN_BEHAVIOR_STANDARD, 0}, expectPass: true},
 		// Missing params valid
-		{msg: "Register token pair - invalid missing title ", title: "", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid missing description ", title: "test", description: "", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE}, expectPass: false},
+		{msg: "Register token pair - invalid missing title ", title: "", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE, 0, TOKEN_BEHAVIOR_STANDARD, 0}, expectPass: false},
+		{msg: "Register token pair - invalid missing description ", title: "test", description: "", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE, 0, TOKEN_BEHAVIOR_STANDARD, 0}, expectPass: false},
 		// Invalid address
-		{msg: "Register token pair - invalid address (no hex)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid length 1)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid length 2)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb194FFF", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid prefix)", title: "test", description: "test desc", pair: TokenPair{"1x5dCA2483280D9727c80b5518faC4556617fb19F", "test", true, OWNER_MODULE}, expectPass: false},
+		{msg: "Register token pair - invalid address (no hex)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "test", true, OWNER_MODULE, 0, TOKEN_BEHAVIOR_STANDARD, 0}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid length 1)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19", "test", true, OWNER_MODULE, 0, TOKEN_BEHAVIOR_STANDARD, 0}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid length 2)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb194FFF", "test", true, OWNER_MODULE, 0, TOKEN_BEHAVIOR_STANDARD, 0}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid prefix)", title: "test", description: "test desc", pair: TokenPair{"1x5dCA2483280D9727c80b5518faC4556617fb19F", "test", true, OWNER_MODULE, 0, TOKEN_BEHAVIOR_STANDARD, 0}, expectPass: false},
 	}
 
 	for i, tc := range testCases {
-		tx := NewRegisterERC20Proposal(tc.title, tc.description, tc.pair.Erc20Address)
+		tx := NewRegisterERC20Proposal(tc.title, tc.description, tc.pair.Erc20Address, tc.pair.Behavior, tests.GenerateAddress().String())
 		err := tx.ValidateBasic()
 
 		if tc.expectPass {
@@ -151,6 +161,34 @@ func (suite *ProposalTestSuite) TestRegisterERC20Proposal() {
 	}
 }
 
+func (suite *ProposalTestSuite) TestRegisterERC20ProposalBehavior() {
+	testCases := []struct {
+		msg         string
+		behavior    TokenBehavior
+		probeHolder string
+		expectPass  bool
+	}{
+		{"standard token", TOKEN_BEHAVIOR_STANDARD, tests.GenerateAddress().String(), true},
+		{"fee on transfer token", TOKEN_BEHAVIOR_FEE_ON_TRANSFER, tests.GenerateAddress().String(), true},
+		{"rebasing token", TOKEN_BEHAVIOR_REBASING_FORBIDDEN, tests.GenerateAddress().String(), false},
+		{"invalid behavior", TokenBehavior(3), tests.GenerateAddress().String(), false},
+		{"missing probe holder", TOKEN_BEHAVIOR_STANDARD, "", false},
+		{"zero address probe holder", TOKEN_BEHAVIOR_STANDARD, "0x0000000000000000000000000000000000000000", false},
+		{"invalid probe holder", TOKEN_BEHAVIOR_STANDARD, "0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewRegisterERC20Proposal("test", "test desc", tests.GenerateAddress().String(), tc.behavior, tc.probeHolder)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
 func createFullMetadata(denom, symbol, name string) banktypes.Metadata {
 	return banktypes.Metadata{
 		Description: "desc",
@@ -277,3 +315,140 @@ func (suite *ProposalTestSuite) TestToggleTokenConversionProposal() {
 		}
 	}
 }
+
+func (suite *ProposalTestSuite) TestRevokeERC20RegistrationProposal() {
+	testCases := []struct {
+		msg         string
+		title       string
+		description string
+		address     string
+		expectPass  bool
+	}{
+		{msg: "Revoke ERC20 registration proposal - valid address", title: "test", description: "test desc", address: tests.GenerateAddress().String(), expectPass: true},
+		{msg: "Revoke ERC20 registration proposal - invalid address", title: "test", description: "test desc", address: "0x123", expectPass: false},
+		{msg: "Revoke ERC20 registration proposal - denom", title: "test", description: "test desc", address: "test", expectPass: false},
+
+		// Invalid missing params
+		{msg: "Revoke ERC20 registration proposal - missing title", title: "", description: "test desc", address: tests.GenerateAddress().String(), expectPass: false},
+		{msg: "Revoke ERC20 registration proposal - missing description", title: "test", description: "", address: tests.GenerateAddress().String(), expectPass: false},
+		{msg: "Revoke ERC20 registration proposal - missing address", title: "test", description: "test desc", address: "", expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewRevokeERC20RegistrationProposal(tc.title, tc.description, tc.address)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestUpdateConversionLimitsProposal() {
+	testCases := []struct {
+		msg         string
+		title       string
+		description string
+		limits      ConversionLimits
+		expectPass  bool
+	}{
+		{msg: "Update conversion limits proposal - valid limits", title: "test", description: "test desc", limits: NewConversionLimits(tests.GenerateAddress(), sdk.NewInt(100), sdk.NewInt(1000), sdk.NewInt(500), time.Hour), expectPass: true},
+		{msg: "Update conversion limits proposal - remove limits", title: "test", description: "test desc", limits: NewConversionLimits(tests.GenerateAddress(), sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt(), 0), expectPass: true},
+		{msg: "Update conversion limits proposal - invalid address", title: "test", description: "test desc", limits: ConversionLimits{Erc20Address: "0x123", MaxPerConversion: sdk.NewInt(100)}, expectPass: false},
+		{msg: "Update conversion limits proposal - negative limit", title: "test", description: "test desc", limits: NewConversionLimits(tests.GenerateAddress(), sdk.NewInt(-100), sdk.ZeroInt(), sdk.ZeroInt(), 0), expectPass: false},
+		{msg: "Update conversion limits proposal - missing window", title: "test", description: "test desc", limits: NewConversionLimits(tests.GenerateAddress(), sdk.ZeroInt(), sdk.NewInt(1000), sdk.ZeroInt(), 0), expectPass: false},
+
+		// Invalid missing params
+		{msg: "Update conversion limits proposal - missing title", title: "", description: "test desc", limits: NewConversionLimits(tests.GenerateAddress(), sdk.NewInt(100), sdk.ZeroInt(), sdk.ZeroInt(), 0), expectPass: false},
+		{msg: "Update conversion limits proposal - missing description", title: "test", description: "", limits: NewConversionLimits(tests.GenerateAddress(), sdk.NewInt(100), sdk.ZeroInt(), sdk.ZeroInt(), 0), expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewUpdateConversionLimitsProposal(tc.title, tc.description, tc.limits)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestUpdateTokenPairMetadataProposal() {
+	validMetadata := banktypes.Metadata{
+		Description: "desc",
+		Base:        "acoin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "acoin", Exponent: 0},
+			{Denom: "coin", Exponent: 18},
+		},
+		Name:    "Coin",
+		Symbol:  "COIN",
+		Display: "coin",
+	}
+
+	testCases := []struct {
+		msg         string
+		title       string
+		description string
+		token       string
+		metadata    banktypes.Metadata
+		expectPass  bool
+	}{
+		{msg: "Update token pair metadata - ERC20 token pair", title: "test", description: "test desc", token: tests.GenerateAddress().String(), metadata: banktypes.Metadata{}, expectPass: true},
+		{msg: "Update token pair metadata - native coin token pair", title: "test", description: "test desc", token: "acoin", metadata: validMetadata, expectPass: true},
+		{msg: "Update token pair metadata - invalid token", title: "test", description: "test desc", token: "", metadata: validMetadata, expectPass: false},
+		{msg: "Update token pair metadata - invalid metadata", title: "test", description: "test desc", token: "acoin", metadata: banktypes.Metadata{Base: "acoin"}, expectPass: false},
+		{msg: "Update token pair metadata - invalid IBC voucher metadata", title: "test", description: "test desc", token: "acoin", metadata: createFullMetadata("ibc/", "ATOM", "ATOM"), expectPass: false},
+
+		// Invalid missing params
+		{msg: "Update token pair metadata - missing title", title: "", description: "test desc", token: "acoin", metadata: validMetadata, expectPass: false},
+		{msg: "Update token pair metadata - missing description", title: "test", description: "", token: "acoin", metadata: validMetadata, expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewUpdateTokenPairMetadataProposal(tc.title, tc.description, tc.token, tc.metadata)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestDeregisterTokenPairProposal() {
+	testCases := []struct {
+		msg              string
+		title            string
+		description      string
+		token            string
+		withdrawalPeriod time.Duration
+		expectPass       bool
+	}{
+		{msg: "Deregister token pair - ERC20 contract", title: "test", description: "test desc", token: tests.GenerateAddress().String(), withdrawalPeriod: time.Hour, expectPass: true},
+		{msg: "Deregister token pair - denom", title: "test", description: "test desc", token: "acoin", withdrawalPeriod: time.Hour, expectPass: true},
+		{msg: "Deregister token pair - invalid token", title: "test", description: "test desc", token: "", withdrawalPeriod: time.Hour, expectPass: false},
+		{msg: "Deregister token pair - zero withdrawal period", title: "test", description: "test desc", token: "acoin", withdrawalPeriod: 0, expectPass: false},
+		{msg: "Deregister token pair - negative withdrawal period", title: "test", description: "test desc", token: "acoin", withdrawalPeriod: -time.Hour, expectPass: false},
+
+		// Invalid missing params
+		{msg: "Deregister token pair - missing title", title: "", description: "test desc", token: "acoin", withdrawalPeriod: time.Hour, expectPass: false},
+		{msg: "Deregister token pair - missing description", title: "test", description: "", token: "acoin", withdrawalPeriod: time.Hour, expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewDeregisterTokenPairProposal(tc.title, tc.description, tc.token, tc.withdrawalPeriod)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
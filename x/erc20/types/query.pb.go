@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -308,6 +310,174 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryBalancesRequest is the request type for the Query/Balances RPC method.
+type QueryBalancesRequest struct {
+	// address of the account, either as a bech32 or as a hex address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the token pairs.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBalancesRequest) Reset()         { *m = QueryBalancesRequest{} }
+func (m *QueryBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBalancesRequest) ProtoMessage()    {}
+func (*QueryBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23532e6ce1d14be9, []int{6}
+}
+func (m *QueryBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalancesRequest.Merge(m, src)
+}
+func (m *QueryBalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBalancesRequest proto.InternalMessageInfo
+
+func (m *QueryBalancesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryBalancesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBalancesResponse is the response type for the Query/Balances RPC
+// method.
+type QueryBalancesResponse struct {
+	Balances []TokenPairBalance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBalancesResponse) Reset()         { *m = QueryBalancesResponse{} }
+func (m *QueryBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBalancesResponse) ProtoMessage()    {}
+func (*QueryBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23532e6ce1d14be9, []int{7}
+}
+func (m *QueryBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalancesResponse.Merge(m, src)
+}
+func (m *QueryBalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBalancesResponse proto.InternalMessageInfo
+
+func (m *QueryBalancesResponse) GetBalances() []TokenPairBalance {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *QueryBalancesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// TokenPairBalance defines the balances of an account for both
+// representations of a token pair
+type TokenPairBalance struct {
+	// registered token pair
+	TokenPair TokenPair `protobuf:"bytes,1,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair"`
+	// bank balance of the Cosmos coin
+	Coin types.Coin `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin"`
+	// ERC20 balance of the token contract
+	Erc20Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=erc20_balance,json=erc20Balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"erc20_balance"`
+}
+
+func (m *TokenPairBalance) Reset()         { *m = TokenPairBalance{} }
+func (m *TokenPairBalance) String() string { return proto.CompactTextString(m) }
+func (*TokenPairBalance) ProtoMessage()    {}
+func (*TokenPairBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23532e6ce1d14be9, []int{8}
+}
+func (m *TokenPairBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenPairBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenPairBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenPairBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPairBalance.Merge(m, src)
+}
+func (m *TokenPairBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenPairBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPairBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenPairBalance proto.InternalMessageInfo
+
+func (m *TokenPairBalance) GetTokenPair() TokenPair {
+	if m != nil {
+		return m.TokenPair
+	}
+	return TokenPair{}
+}
+
+func (m *TokenPairBalance) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryTokenPairsRequest)(nil), "acrechain.erc20.v1.QueryTokenPairsRequest")
 	proto.RegisterType((*QueryTokenPairsResponse)(nil), "acrechain.erc20.v1.QueryTokenPairsResponse")
@@ -315,45 +485,59 @@ func init() {
 	proto.RegisterType((*QueryTokenPairResponse)(nil), "acrechain.erc20.v1.QueryTokenPairResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "acrechain.erc20.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "acrechain.erc20.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryBalancesRequest)(nil), "acrechain.erc20.v1.QueryBalancesRequest")
+	proto.RegisterType((*QueryBalancesResponse)(nil), "acrechain.erc20.v1.QueryBalancesResponse")
+	proto.RegisterType((*TokenPairBalance)(nil), "acrechain.erc20.v1.TokenPairBalance")
 }
 
 func init() { proto.RegisterFile("acrechain/erc20/query.proto", fileDescriptor_23532e6ce1d14be9) }
 
 var fileDescriptor_23532e6ce1d14be9 = []byte{
-	// 516 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0x2d, 0x8d, 0x94, 0x97, 0xed, 0x08, 0xb4, 0x98, 0xd6, 0xad, 0xac, 0x2a, 0x2d,
-	0x01, 0xee, 0x48, 0x58, 0x58, 0x89, 0x10, 0x0c, 0x0c, 0x84, 0x88, 0x09, 0x21, 0xc1, 0xd9, 0x3a,
-	0xb9, 0x16, 0x89, 0xcf, 0xf5, 0x39, 0x15, 0x05, 0xb1, 0xb0, 0xb2, 0x54, 0xe2, 0x2b, 0x30, 0xf0,
-	0x51, 0x3a, 0x46, 0x62, 0x61, 0x42, 0x28, 0xe1, 0x83, 0x20, 0xdf, 0x9d, 0x9d, 0x38, 0x0e, 0x4d,
-	0x36, 0xfb, 0xdd, 0x7b, 0xef, 0xff, 0xfb, 0xbf, 0x77, 0x07, 0xb7, 0x99, 0x17, 0x73, 0xef, 0x84,
-	0x05, 0x21, 0xe5, 0xb1, 0xd7, 0x79, 0x40, 0x4f, 0x47, 0x3c, 0x3e, 0x27, 0x51, 0x2c, 0x12, 0x81,
-	0x71, 0x7e, 0x48, 0xd4, 0x21, 0x39, 0x6b, 0x5b, 0x2d, 0x4f, 0xc8, 0xa1, 0x90, 0xd4, 0x65, 0x92,
-	0xeb, 0x64, 0x7a, 0xd6, 0x76, 0x79, 0xc2, 0xda, 0x34, 0x62, 0x7e, 0x10, 0xb2, 0x24, 0x10, 0xa1,
-	0xae, 0xb7, 0xf6, 0x16, 0x9b, 0xfb, 0x3c, 0xe4, 0x32, 0x90, 0xe6, 0xb8, 0xa4, 0xad, 0x45, 0xf4,
-	0xe1, 0xae, 0x2f, 0x84, 0x3f, 0xe0, 0x94, 0x45, 0x01, 0x65, 0x61, 0x28, 0x12, 0xd5, 0x38, 0x2b,
-	0x6d, 0xf8, 0xc2, 0x17, 0xea, 0x93, 0xa6, 0x5f, 0x3a, 0xea, 0xbc, 0x83, 0x9b, 0x2f, 0x53, 0xa2,
-	0x57, 0xe2, 0x3d, 0x0f, 0x7b, 0x2c, 0x88, 0x65, 0x9f, 0x9f, 0x8e, 0xb8, 0x4c, 0xf0, 0x53, 0x80,
-	0x19, 0xdd, 0x0e, 0x3a, 0x40, 0xc7, 0xf5, 0x4e, 0x93, 0x68, 0x2b, 0x24, 0xb5, 0x42, 0xb4, 0x6f,
-	0x63, 0x85, 0xf4, 0x98, 0xcf, 0x4d, 0x6d, 0x7f, 0xae, 0xd2, 0xf9, 0x81, 0x60, 0xbb, 0x24, 0x21,
-	0x23, 0x11, 0x4a, 0x8e, 0x9f, 0x40, 0x3d, 0x49, 0xa3, 0x6f, 0xa3, 0x34, 0xbc, 0x83, 0x0e, 0x36,
-	0x8f, 0xeb, 0x9d, 0x3d, 0x52, 0x9e, 0x21, 0xc9, 0x8b, 0xbb, 0xd7, 0x2e, 0x7f, 0xef, 0x57, 0xfa,
-	0x90, 0xe4, 0xdd, 0xf0, 0xb3, 0x02, 0xe9, 0x86, 0x22, 0x3d, 0x5a, 0x49, 0xaa, 0x11, 0x0a, 0xa8,
-	0xf7, 0xe1, 0x46, 0x91, 0x34, 0x9b, 0x45, 0x03, 0xb6, 0x94, 0x9e, 0x1a, 0x43, 0xad, 0xaf, 0x7f,
-	0x9c, 0x37, 0x8b, 0xb3, 0xcb, 0x7d, 0x75, 0x01, 0x66, 0xbe, 0xcc, 0xec, 0xd6, 0xb2, 0x55, 0xcb,
-	0x6d, 0x39, 0x0d, 0xc0, 0xaa, 0x7b, 0x8f, 0xc5, 0x6c, 0x98, 0x6d, 0xc5, 0x79, 0x01, 0xd7, 0x0b,
-	0x51, 0x23, 0xf8, 0x08, 0xaa, 0x91, 0x8a, 0x18, 0x31, 0x6b, 0x99, 0x98, 0xae, 0x31, 0x4a, 0x26,
-	0xbf, 0xf3, 0x7d, 0x13, 0xb6, 0x54, 0x47, 0xfc, 0x15, 0x01, 0xcc, 0x76, 0x84, 0x5b, 0xcb, 0x5a,
-	0x2c, 0xbf, 0x2b, 0xd6, 0xdd, 0xb5, 0x72, 0x35, 0xab, 0x73, 0xf8, 0xe5, 0xe7, 0xdf, 0x6f, 0x1b,
-	0x36, 0xde, 0xa5, 0x8b, 0x97, 0x79, 0xee, 0x2e, 0xe0, 0x0b, 0x04, 0xb5, 0xbc, 0x18, 0xdf, 0x59,
-	0x2d, 0x90, 0xb1, 0xb4, 0xd6, 0x49, 0x35, 0x28, 0xf7, 0x14, 0x4a, 0x13, 0x1f, 0x5e, 0x85, 0x42,
-	0x3f, 0xa9, 0x9f, 0xcf, 0xf8, 0x23, 0x54, 0xf5, 0x08, 0x71, 0xf3, 0xbf, 0x1a, 0x85, 0x6d, 0x59,
-	0x47, 0x2b, 0xf3, 0x0c, 0xc8, 0xbe, 0x02, 0xb9, 0x85, 0xb7, 0x4b, 0x20, 0x7a, 0x4d, 0xdd, 0xe7,
-	0x97, 0x13, 0x1b, 0x8d, 0x27, 0x36, 0xfa, 0x33, 0xb1, 0xd1, 0xc5, 0xd4, 0xae, 0x8c, 0xa7, 0x76,
-	0xe5, 0xd7, 0xd4, 0xae, 0xbc, 0x6e, 0xfb, 0x41, 0x72, 0x32, 0x72, 0x89, 0x27, 0x86, 0xf4, 0x71,
-	0xcc, 0xdc, 0x01, 0xef, 0xa5, 0x2f, 0xdb, 0x13, 0x83, 0xb9, 0x5e, 0x1f, 0x32, 0x5b, 0xe7, 0x11,
-	0x97, 0x6e, 0x55, 0xbd, 0xfd, 0x87, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x11, 0x6c, 0x47, 0xd8,
-	0xca, 0x04, 0x00, 0x00,
+	// 689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0xbb, 0xfc, 0x12, 0x1e, 0x9a, 0x98, 0xb1, 0x48, 0xa9, 0xb0, 0x90, 0x15, 0xcb, 0x2f,
+	0x99, 0xb1, 0xe5, 0xe2, 0xd5, 0x6a, 0x30, 0xc6, 0x83, 0xb5, 0x7a, 0x32, 0x26, 0x38, 0x5d, 0x26,
+	0xcb, 0x86, 0xb2, 0xb3, 0xec, 0x2c, 0x04, 0x24, 0x5c, 0xbc, 0xea, 0x81, 0xc4, 0x7f, 0xc2, 0xbb,
+	0xff, 0x04, 0x47, 0x12, 0x2f, 0xc6, 0x03, 0x21, 0xe0, 0x1f, 0x62, 0x76, 0x66, 0x76, 0xe9, 0x6e,
+	0x0b, 0x6d, 0x0c, 0x97, 0x76, 0xf7, 0xcd, 0x7b, 0xef, 0xfb, 0x79, 0x6f, 0xe6, 0xcd, 0xc2, 0x03,
+	0x6a, 0x07, 0xcc, 0xde, 0xa0, 0xae, 0x47, 0x58, 0x60, 0x57, 0x9e, 0x90, 0xed, 0x1d, 0x16, 0xec,
+	0x63, 0x3f, 0xe0, 0x21, 0x47, 0x28, 0x59, 0xc4, 0x72, 0x11, 0xef, 0x96, 0x8b, 0x8b, 0x36, 0x17,
+	0x5b, 0x5c, 0x90, 0x06, 0x15, 0x4c, 0x39, 0x93, 0xdd, 0x72, 0x83, 0x85, 0xb4, 0x4c, 0x7c, 0xea,
+	0xb8, 0x1e, 0x0d, 0x5d, 0xee, 0xa9, 0xf8, 0xa2, 0xd9, 0xea, 0x1b, 0x7b, 0xd9, 0xdc, 0x8d, 0xd7,
+	0xa7, 0xb2, 0xe2, 0x0e, 0xf3, 0x98, 0x70, 0x85, 0x5e, 0x6e, 0x63, 0x93, 0xbf, 0x7a, 0x71, 0xd2,
+	0xe1, 0xdc, 0x69, 0x32, 0x42, 0x7d, 0x97, 0x50, 0xcf, 0xe3, 0xa1, 0x14, 0x8e, 0x43, 0xf3, 0x0e,
+	0x77, 0xb8, 0x7c, 0x24, 0xd1, 0x93, 0xb2, 0x5a, 0x9f, 0xe0, 0xfe, 0xdb, 0x88, 0xf8, 0x3d, 0xdf,
+	0x64, 0x5e, 0x8d, 0xba, 0x81, 0xa8, 0xb3, 0xed, 0x1d, 0x26, 0x42, 0xb4, 0x0a, 0x70, 0x49, 0x5f,
+	0x30, 0x66, 0x8c, 0xf9, 0xd1, 0x4a, 0x09, 0x2b, 0x7c, 0x1c, 0xe1, 0x63, 0xd5, 0x17, 0x5d, 0x04,
+	0xae, 0x51, 0x87, 0xe9, 0xd8, 0x7a, 0x4b, 0xa4, 0xf5, 0xc3, 0x80, 0xf1, 0x36, 0x09, 0xe1, 0x73,
+	0x4f, 0x30, 0xf4, 0x02, 0x46, 0xc3, 0xc8, 0xba, 0xe6, 0x47, 0xe6, 0x82, 0x31, 0xd3, 0x3f, 0x3f,
+	0x5a, 0x99, 0xc2, 0xed, 0x3d, 0xc6, 0x49, 0x70, 0x75, 0xe0, 0xf8, 0x74, 0x3a, 0x57, 0x87, 0x30,
+	0xc9, 0x86, 0x5e, 0xa6, 0x48, 0xfb, 0x24, 0xe9, 0x5c, 0x57, 0x52, 0x85, 0x90, 0x42, 0x5d, 0x86,
+	0xb1, 0x34, 0x69, 0xdc, 0x8b, 0x3c, 0x0c, 0x4a, 0x3d, 0xd9, 0x86, 0x91, 0xba, 0x7a, 0xb1, 0x3e,
+	0x66, 0x7b, 0x97, 0xd4, 0x55, 0x05, 0xb8, 0xac, 0x4b, 0xf7, 0xae, 0xa7, 0xb2, 0x46, 0x92, 0xb2,
+	0xac, 0x3c, 0x20, 0x99, 0xbd, 0x46, 0x03, 0xba, 0x15, 0xef, 0x8a, 0xf5, 0x06, 0xee, 0xa5, 0xac,
+	0x5a, 0xf0, 0x29, 0x0c, 0xf9, 0xd2, 0xa2, 0xc5, 0x8a, 0x9d, 0xc4, 0x54, 0x8c, 0x56, 0xd2, 0xfe,
+	0xd6, 0x1e, 0xe4, 0x65, 0xc2, 0x2a, 0x6d, 0x52, 0xcf, 0x66, 0xc9, 0xf6, 0x17, 0xe0, 0x16, 0x5d,
+	0x5f, 0x0f, 0x98, 0x10, 0xba, 0xe8, 0xf8, 0x15, 0xad, 0x76, 0x68, 0xf7, 0x7f, 0x1e, 0x8c, 0xb1,
+	0x8c, 0xb4, 0xae, 0x66, 0x15, 0x86, 0x1b, 0xda, 0xa6, 0xcf, 0xc4, 0xec, 0xf5, 0xcd, 0x53, 0xce,
+	0xba, 0xb2, 0x24, 0xf6, 0xe6, 0x0e, 0xc6, 0x99, 0x01, 0x77, 0xb3, 0x6a, 0x37, 0xb1, 0xc9, 0x68,
+	0x05, 0x06, 0xa2, 0xe1, 0xd7, 0x6c, 0x13, 0x29, 0xb6, 0x98, 0xea, 0x39, 0x77, 0x3d, 0x1d, 0x29,
+	0x9d, 0xd1, 0x3b, 0xb8, 0x23, 0x73, 0xaf, 0xe9, 0x42, 0x0b, 0xfd, 0xd1, 0x06, 0x55, 0x71, 0xe4,
+	0xf2, 0xe7, 0x74, 0xba, 0xe4, 0xb8, 0xe1, 0xc6, 0x4e, 0x03, 0xdb, 0x7c, 0x8b, 0xe8, 0xdb, 0x46,
+	0xfd, 0x2d, 0x8b, 0xf5, 0x4d, 0x12, 0xee, 0xfb, 0x4c, 0xe0, 0x57, 0x5e, 0x58, 0xbf, 0x2d, 0x93,
+	0xe8, 0x6a, 0x2a, 0x3f, 0x07, 0x60, 0x50, 0xee, 0x06, 0xfa, 0x6a, 0x00, 0x5c, 0xce, 0x2a, 0x5a,
+	0xec, 0x54, 0x52, 0xe7, 0x3b, 0xa3, 0xb8, 0xd4, 0x93, 0xaf, 0x6a, 0xb0, 0x35, 0xfb, 0xe5, 0xd7,
+	0xdf, 0xef, 0x7d, 0x26, 0x9a, 0x24, 0xd9, 0x4b, 0xad, 0xe5, 0x4e, 0x40, 0x47, 0x06, 0x8c, 0x24,
+	0xc1, 0x68, 0xa1, 0xbb, 0x40, 0xcc, 0xb2, 0xd8, 0x8b, 0xab, 0x46, 0x79, 0x2c, 0x51, 0x4a, 0x68,
+	0xf6, 0x3a, 0x14, 0x72, 0x20, 0x5f, 0x0e, 0xd1, 0x67, 0x18, 0x52, 0xa3, 0x84, 0x4a, 0x57, 0x6a,
+	0xa4, 0xa6, 0xb6, 0x38, 0xd7, 0xd5, 0x4f, 0x83, 0x4c, 0x4b, 0x90, 0x09, 0x34, 0xde, 0x06, 0xa2,
+	0xc6, 0x15, 0x7d, 0x33, 0x60, 0x38, 0x9e, 0x17, 0x34, 0x7f, 0x65, 0xda, 0xcc, 0x34, 0x17, 0x17,
+	0x7a, 0xf0, 0xd4, 0x08, 0x4b, 0x12, 0xe1, 0x11, 0x7a, 0xd8, 0x86, 0x10, 0xcf, 0x15, 0x39, 0xd0,
+	0x57, 0xc1, 0x61, 0xf5, 0xf5, 0xf1, 0xb9, 0x69, 0x9c, 0x9c, 0x9b, 0xc6, 0xd9, 0xb9, 0x69, 0x1c,
+	0x5d, 0x98, 0xb9, 0x93, 0x0b, 0x33, 0xf7, 0xfb, 0xc2, 0xcc, 0x7d, 0x28, 0xb7, 0x9c, 0xc2, 0x67,
+	0x01, 0x6d, 0x34, 0x59, 0x2d, 0xfa, 0xe0, 0xd8, 0xbc, 0xd9, 0x92, 0x77, 0x2f, 0xee, 0x72, 0x74,
+	0x28, 0x1b, 0x43, 0xf2, 0x93, 0xb4, 0xf2, 0x6f, 0x00, 0x8c, 0x49, 0x9c, 0xee, 0x81, 0x07, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -374,6 +558,9 @@ type QueryClient interface {
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Balances retrieves the balances of an account for both representations of
+	// every registered token pair
+	Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error)
 }
 
 type queryClient struct {
@@ -411,6 +598,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error) {
+	out := new(QueryBalancesResponse)
+	err := c.cc.Invoke(ctx, "/acrechain.erc20.v1.Query/Balances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// TokenPairs retrieves registered token pairs
@@ -419,6 +615,9 @@ type QueryServer interface {
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Balances retrieves the balances of an account for both representations of
+	// every registered token pair
+	Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -434,6 +633,9 @@ func (*UnimplementedQueryServer) TokenPair(ctx context.Context, req *QueryTokenP
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) Balances(ctx context.Context, req *QueryBalancesRequest) (*QueryBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Balances not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -493,6 +695,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Balances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Balances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/acrechain.erc20.v1.Query/Balances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Balances(ctx, req.(*QueryBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "acrechain.erc20.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -509,6 +729,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "Balances",
+			Handler:    _Query_Balances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "acrechain/erc20/query.proto",
@@ -717,6 +941,150 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenPairBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPairBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPairBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Erc20Balance.Size()
+		i -= size
+		if _, err := m.Erc20Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TokenPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -804,18 +1172,69 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+func (m *QueryBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenPairBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Coin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Erc20Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
@@ -1314,6 +1733,394 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, TokenPairBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenPairBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPairBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPairBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
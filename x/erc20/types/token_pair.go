@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethermint "github.com/evmos/ethermint/types"
@@ -38,7 +41,11 @@ func (tp TokenPair) Validate() error {
 		return err
 	}
 
-	return nil
+	if tp.WithdrawalEndTime < 0 {
+		return fmt.Errorf("withdrawal end time cannot be negative: %d", tp.WithdrawalEndTime)
+	}
+
+	return ValidateTokenBehavior(tp.Behavior)
 }
 
 // IsNativeCoin returns true if the owner of the ERC20 contract is the
@@ -52,3 +59,59 @@ func (tp TokenPair) IsNativeCoin() bool {
 func (tp TokenPair) IsNativeERC20() bool {
 	return tp.ContractOwner == OWNER_EXTERNAL
 }
+
+// IsPendingReview returns true if governance can still revoke the token pair
+// registered through a MsgRegisterERC20WithDeposit at the given unix time.
+func (tp TokenPair) IsPendingReview(blockTime int64) bool {
+	return tp.ReviewEndTime > blockTime
+}
+
+// IsDeregistering returns true if the token pair is in the withdrawal period
+// of a deregistration.
+func (tp TokenPair) IsDeregistering() bool {
+	return tp.WithdrawalEndTime != 0
+}
+
+// ConversionEnabled returns true if the token pair allows conversions from its
+// ERC20 token, or from its Cosmos coin otherwise. During the withdrawal period
+// of a deregistration, only the conversions back to the native representation
+// of the token pair are allowed, as long as the token pair is enabled.
+func (tp TokenPair) ConversionEnabled(fromERC20 bool) bool {
+	if !tp.Enabled {
+		return false
+	}
+	return !tp.IsDeregistering() || fromERC20 == tp.IsNativeCoin()
+}
+
+// IsFeeOnTransfer returns true if the ERC20 token charges a fee on transfers
+func (tp TokenPair) IsFeeOnTransfer() bool {
+	return tp.Behavior == TOKEN_BEHAVIOR_FEE_ON_TRANSFER
+}
+
+// ValidateTokenBehavior returns an error if the token behavior cannot be
+// registered
+func ValidateTokenBehavior(behavior TokenBehavior) error {
+	switch behavior {
+	case TOKEN_BEHAVIOR_STANDARD, TOKEN_BEHAVIOR_FEE_ON_TRANSFER:
+		return nil
+	case TOKEN_BEHAVIOR_REBASING_FORBIDDEN:
+		return fmt.Errorf("rebasing tokens cannot be registered")
+	default:
+		return fmt.Errorf("invalid token behavior %d", behavior)
+	}
+}
+
+// DetectTokenBehavior returns the behavior of a token from the balance changes
+// of the sender and the recipient of a transfer of the given amount. A
+// transfer that does not debit the sender by exactly the amount, or credits
+// the recipient more than the amount, reveals a rebasing token.
+func DetectTokenBehavior(amount, sent, received *big.Int) TokenBehavior {
+	switch {
+	case sent.Cmp(amount) != 0, received.Sign() < 0, received.Cmp(amount) > 0:
+		return TOKEN_BEHAVIOR_REBASING_FORBIDDEN
+	case received.Cmp(amount) < 0:
+		return TOKEN_BEHAVIOR_FEE_ON_TRANSFER
+	default:
+		return TOKEN_BEHAVIOR_STANDARD
+	}
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"math/big"
 	"strings"
 	"testing"
 
@@ -58,10 +59,15 @@ func (suite *TokenPairTestSuite) TestTokenPair() {
 		pair       TokenPair
 		expectPass bool
 	}{
-		{msg: "Register token pair - invalid address (no hex)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid length 1)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid length 2)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb194FFF", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "pass", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE}, expectPass: true},
+		{msg: "Register token pair - invalid address (no hex)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "test", true, OWNER_MODULE, 0, TOKEN_BEHAVIOR_STANDARD, 0}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid length 1)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19", "test", true, OWNER_MODULE, 0, TOKEN_BEHAVIOR_STANDARD, 0}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid length 2)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb194FFF", "test", true, OWNER_MODULE, 0, TOKEN_BEHAVIOR_STANDARD, 0}, expectPass: false},
+		{msg: "Register token pair - rebasing token", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, 0, TOKEN_BEHAVIOR_REBASING_FORBIDDEN, 0}, expectPass: false},
+		{msg: "Register token pair - invalid behavior", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, 0, TokenBehavior(3), 0}, expectPass: false},
+		{msg: "Register token pair - negative withdrawal end time", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_EXTERNAL, 0, TOKEN_BEHAVIOR_STANDARD, -1}, expectPass: false},
+		{msg: "pass", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE, 0, TOKEN_BEHAVIOR_STANDARD, 0}, expectPass: true},
+		{msg: "pass - deregistering", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_EXTERNAL, 0, TOKEN_BEHAVIOR_STANDARD, 1}, expectPass: true},
+		{msg: "pass - fee on transfer", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, 0, TOKEN_BEHAVIOR_FEE_ON_TRANSFER, 0}, expectPass: true},
 	}
 
 	for i, tc := range testCases {
@@ -100,17 +106,17 @@ func (suite *TokenPairTestSuite) TestIsNativeCoin() {
 	}{
 		{
 			"no owner",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_UNSPECIFIED},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_UNSPECIFIED, 0, TOKEN_BEHAVIOR_STANDARD, 0},
 			false,
 		},
 		{
 			"external ERC20 owner",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, 0, TOKEN_BEHAVIOR_STANDARD, 0},
 			false,
 		},
 		{
 			"pass",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE, 0, TOKEN_BEHAVIOR_STANDARD, 0},
 			true,
 		},
 	}
@@ -133,17 +139,17 @@ func (suite *TokenPairTestSuite) TestIsNativeERC20() {
 	}{
 		{
 			"no owner",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_UNSPECIFIED},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_UNSPECIFIED, 0, TOKEN_BEHAVIOR_STANDARD, 0},
 			false,
 		},
 		{
 			"module owner",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE, 0, TOKEN_BEHAVIOR_STANDARD, 0},
 			false,
 		},
 		{
 			"pass",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, 0, TOKEN_BEHAVIOR_STANDARD, 0},
 			true,
 		},
 	}
@@ -157,3 +163,76 @@ func (suite *TokenPairTestSuite) TestIsNativeERC20() {
 		}
 	}
 }
+
+func (suite *TokenPairTestSuite) TestConversionEnabled() {
+	testCases := []struct {
+		name         string
+		pair         TokenPair
+		expFromERC20 bool
+		expFromCoin  bool
+	}{
+		{
+			"enabled",
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, 0, TOKEN_BEHAVIOR_STANDARD, 0},
+			true,
+			true,
+		},
+		{
+			"disabled",
+			TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_EXTERNAL, 0, TOKEN_BEHAVIOR_STANDARD, 0},
+			false,
+			false,
+		},
+		{
+			"deregistering native ERC20",
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, 0, TOKEN_BEHAVIOR_STANDARD, 1},
+			false,
+			true,
+		},
+		{
+			"deregistering native coin",
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE, 0, TOKEN_BEHAVIOR_STANDARD, 1},
+			true,
+			false,
+		},
+		{
+			"deregistering disabled native ERC20",
+			TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_EXTERNAL, 0, TOKEN_BEHAVIOR_STANDARD, 1},
+			false,
+			false,
+		},
+		{
+			"deregistering disabled native coin",
+			TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE, 0, TOKEN_BEHAVIOR_STANDARD, 1},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Require().Equal(tc.expFromERC20, tc.pair.ConversionEnabled(true), tc.name)
+		suite.Require().Equal(tc.expFromCoin, tc.pair.ConversionEnabled(false), tc.name)
+	}
+}
+
+func (suite *TokenPairTestSuite) TestDetectTokenBehavior() {
+	testCases := []struct {
+		name     string
+		sent     int64
+		received int64
+		expected TokenBehavior
+	}{
+		{"standard", 100, 100, TOKEN_BEHAVIOR_STANDARD},
+		{"fee on transfer", 100, 90, TOKEN_BEHAVIOR_FEE_ON_TRANSFER},
+		{"fee on transfer - whole amount", 100, 0, TOKEN_BEHAVIOR_FEE_ON_TRANSFER},
+		{"rebasing - sender debited less", 99, 99, TOKEN_BEHAVIOR_REBASING_FORBIDDEN},
+		{"rebasing - sender debited more", 101, 100, TOKEN_BEHAVIOR_REBASING_FORBIDDEN},
+		{"rebasing - recipient credited more", 100, 101, TOKEN_BEHAVIOR_REBASING_FORBIDDEN},
+		{"rebasing - recipient debited", 100, -1, TOKEN_BEHAVIOR_REBASING_FORBIDDEN},
+	}
+
+	for _, tc := range testCases {
+		behavior := DetectTokenBehavior(big.NewInt(100), big.NewInt(tc.sent), big.NewInt(tc.received))
+		suite.Require().Equal(tc.expected, behavior, tc.name)
+	}
+}
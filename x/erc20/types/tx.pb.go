@@ -237,46 +237,425 @@ func (m *MsgConvertERC20Response) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConvertERC20Response proto.InternalMessageInfo
 
+// MsgRegisterERC20WithDeposit defines a Msg to register a token pair for an
+// ERC20 token without a governance proposal.
+type MsgRegisterERC20WithDeposit struct {
+	// cosmos bech32 address of the account paying the registration deposit
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract address of ERC20 token
+	Erc20Address string `protobuf:"bytes,2,opt,name=erc20address,proto3" json:"erc20address,omitempty"`
+	// transfer behavior of the ERC20 token
+	Behavior TokenBehavior `protobuf:"varint,3,opt,name=behavior,proto3,enum=acrechain.erc20.v1.TokenBehavior" json:"behavior,omitempty"`
+}
+
+func (m *MsgRegisterERC20WithDeposit) Reset()         { *m = MsgRegisterERC20WithDeposit{} }
+func (m *MsgRegisterERC20WithDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterERC20WithDeposit) ProtoMessage()    {}
+func (*MsgRegisterERC20WithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37c302d85a6c4842, []int{4}
+}
+func (m *MsgRegisterERC20WithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterERC20WithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterERC20WithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterERC20WithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterERC20WithDeposit.Merge(m, src)
+}
+func (m *MsgRegisterERC20WithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterERC20WithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterERC20WithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterERC20WithDeposit proto.InternalMessageInfo
+
+func (m *MsgRegisterERC20WithDeposit) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRegisterERC20WithDeposit) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *MsgRegisterERC20WithDeposit) GetBehavior() TokenBehavior {
+	if m != nil {
+		return m.Behavior
+	}
+	return TOKEN_BEHAVIOR_STANDARD
+}
+
+// MsgRegisterERC20WithDepositResponse returns no fields
+type MsgRegisterERC20WithDepositResponse struct {
+}
+
+func (m *MsgRegisterERC20WithDepositResponse) Reset()         { *m = MsgRegisterERC20WithDepositResponse{} }
+func (m *MsgRegisterERC20WithDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterERC20WithDepositResponse) ProtoMessage()    {}
+func (*MsgRegisterERC20WithDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37c302d85a6c4842, []int{5}
+}
+func (m *MsgRegisterERC20WithDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterERC20WithDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterERC20WithDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterERC20WithDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterERC20WithDepositResponse.Merge(m, src)
+}
+func (m *MsgRegisterERC20WithDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterERC20WithDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterERC20WithDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterERC20WithDepositResponse proto.InternalMessageInfo
+
+// MsgConvertCoinBatch defines a Msg to convert several native Cosmos coins to
+// ERC20 tokens
+type MsgConvertCoinBatch struct {
+	// Cosmos coins which denominations are registered in token pairs. The coin
+	// amounts define the amounts of coins to convert.
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// recipient hex address to receive ERC20 tokens
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// cosmos bech32 address from the owner of the given Cosmos coins
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgConvertCoinBatch) Reset()         { *m = MsgConvertCoinBatch{} }
+func (m *MsgConvertCoinBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertCoinBatch) ProtoMessage()    {}
+func (*MsgConvertCoinBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37c302d85a6c4842, []int{6}
+}
+func (m *MsgConvertCoinBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertCoinBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertCoinBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertCoinBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertCoinBatch.Merge(m, src)
+}
+func (m *MsgConvertCoinBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertCoinBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertCoinBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertCoinBatch proto.InternalMessageInfo
+
+func (m *MsgConvertCoinBatch) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *MsgConvertCoinBatch) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *MsgConvertCoinBatch) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgConvertCoinBatchResponse returns no fields
+type MsgConvertCoinBatchResponse struct {
+}
+
+func (m *MsgConvertCoinBatchResponse) Reset()         { *m = MsgConvertCoinBatchResponse{} }
+func (m *MsgConvertCoinBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertCoinBatchResponse) ProtoMessage()    {}
+func (*MsgConvertCoinBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37c302d85a6c4842, []int{7}
+}
+func (m *MsgConvertCoinBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertCoinBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertCoinBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertCoinBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertCoinBatchResponse.Merge(m, src)
+}
+func (m *MsgConvertCoinBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertCoinBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertCoinBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertCoinBatchResponse proto.InternalMessageInfo
+
+// ERC20Amount defines an amount of ERC20 tokens of a contract
+type ERC20Amount struct {
+	// ERC20 token contract address registered in a token pair
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// amount of ERC20 tokens
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *ERC20Amount) Reset()         { *m = ERC20Amount{} }
+func (m *ERC20Amount) String() string { return proto.CompactTextString(m) }
+func (*ERC20Amount) ProtoMessage()    {}
+func (*ERC20Amount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37c302d85a6c4842, []int{8}
+}
+func (m *ERC20Amount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20Amount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20Amount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC20Amount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20Amount.Merge(m, src)
+}
+func (m *ERC20Amount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20Amount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20Amount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20Amount proto.InternalMessageInfo
+
+func (m *ERC20Amount) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// MsgConvertERC20Batch defines a Msg to convert several ERC20 tokens to native
+// Cosmos coins
+type MsgConvertERC20Batch struct {
+	// ERC20 tokens to convert
+	Tokens []ERC20Amount `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens"`
+	// bech32 address to receive native Cosmos coins
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// sender hex address from the owner of the given ERC20 tokens
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgConvertERC20Batch) Reset()         { *m = MsgConvertERC20Batch{} }
+func (m *MsgConvertERC20Batch) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertERC20Batch) ProtoMessage()    {}
+func (*MsgConvertERC20Batch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37c302d85a6c4842, []int{9}
+}
+func (m *MsgConvertERC20Batch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertERC20Batch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertERC20Batch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertERC20Batch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertERC20Batch.Merge(m, src)
+}
+func (m *MsgConvertERC20Batch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertERC20Batch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertERC20Batch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertERC20Batch proto.InternalMessageInfo
+
+func (m *MsgConvertERC20Batch) GetTokens() []ERC20Amount {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *MsgConvertERC20Batch) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *MsgConvertERC20Batch) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgConvertERC20BatchResponse returns no fields
+type MsgConvertERC20BatchResponse struct {
+}
+
+func (m *MsgConvertERC20BatchResponse) Reset()         { *m = MsgConvertERC20BatchResponse{} }
+func (m *MsgConvertERC20BatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertERC20BatchResponse) ProtoMessage()    {}
+func (*MsgConvertERC20BatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37c302d85a6c4842, []int{10}
+}
+func (m *MsgConvertERC20BatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertERC20BatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertERC20BatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertERC20BatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertERC20BatchResponse.Merge(m, src)
+}
+func (m *MsgConvertERC20BatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertERC20BatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertERC20BatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertERC20BatchResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgConvertCoin)(nil), "acrechain.erc20.v1.MsgConvertCoin")
 	proto.RegisterType((*MsgConvertCoinResponse)(nil), "acrechain.erc20.v1.MsgConvertCoinResponse")
 	proto.RegisterType((*MsgConvertERC20)(nil), "acrechain.erc20.v1.MsgConvertERC20")
 	proto.RegisterType((*MsgConvertERC20Response)(nil), "acrechain.erc20.v1.MsgConvertERC20Response")
+	proto.RegisterType((*MsgRegisterERC20WithDeposit)(nil), "acrechain.erc20.v1.MsgRegisterERC20WithDeposit")
+	proto.RegisterType((*MsgRegisterERC20WithDepositResponse)(nil), "acrechain.erc20.v1.MsgRegisterERC20WithDepositResponse")
+	proto.RegisterType((*MsgConvertCoinBatch)(nil), "acrechain.erc20.v1.MsgConvertCoinBatch")
+	proto.RegisterType((*MsgConvertCoinBatchResponse)(nil), "acrechain.erc20.v1.MsgConvertCoinBatchResponse")
+	proto.RegisterType((*ERC20Amount)(nil), "acrechain.erc20.v1.ERC20Amount")
+	proto.RegisterType((*MsgConvertERC20Batch)(nil), "acrechain.erc20.v1.MsgConvertERC20Batch")
+	proto.RegisterType((*MsgConvertERC20BatchResponse)(nil), "acrechain.erc20.v1.MsgConvertERC20BatchResponse")
 }
 
 func init() { proto.RegisterFile("acrechain/erc20/tx.proto", fileDescriptor_37c302d85a6c4842) }
 
 var fileDescriptor_37c302d85a6c4842 = []byte{
-	// 464 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x24, 0x21, 0xd8, 0xa9, 0x58, 0x19, 0xa4, 0x6e, 0x17, 0xd9, 0xc6, 0x2d, 0x48, 0xaa,
-	0x38, 0xd3, 0xa4, 0xbf, 0xa0, 0x09, 0x0a, 0x22, 0x05, 0xd9, 0xa3, 0x97, 0x32, 0x3b, 0x19, 0xb6,
-	0x8b, 0xc9, 0xbc, 0x30, 0x33, 0x5d, 0xda, 0xab, 0x17, 0x0f, 0x5e, 0x04, 0xcf, 0xfe, 0x0f, 0x7f,
-	0x42, 0x8f, 0x05, 0x2f, 0xe2, 0xa1, 0x48, 0xe2, 0x0f, 0x91, 0x9d, 0xdd, 0xac, 0x59, 0x8b, 0xb1,
-	0xa7, 0x99, 0x79, 0xdf, 0x7b, 0xdf, 0x7c, 0xef, 0x7b, 0x0f, 0x7b, 0x5c, 0x68, 0x29, 0x4e, 0x79,
-	0xaa, 0x98, 0xd4, 0x62, 0x70, 0xc0, 0xec, 0x39, 0x9d, 0x69, 0xb0, 0x40, 0x48, 0x85, 0x50, 0x87,
-	0xd0, 0xac, 0xef, 0x3f, 0x4a, 0x00, 0x92, 0x89, 0x64, 0x7c, 0x96, 0x32, 0xae, 0x14, 0x58, 0x6e,
-	0x53, 0x50, 0xa6, 0xa8, 0xf0, 0x1f, 0x24, 0x90, 0x80, 0xbb, 0xb2, 0xfc, 0x56, 0x46, 0x03, 0x01,
-	0x66, 0x0a, 0x86, 0xc5, 0xdc, 0x48, 0x96, 0xf5, 0x63, 0x69, 0x79, 0x9f, 0x09, 0x48, 0x55, 0x81,
-	0x87, 0x17, 0xf8, 0xde, 0xb1, 0x49, 0x46, 0xa0, 0x32, 0xa9, 0xed, 0x08, 0x52, 0x45, 0x0e, 0x71,
-	0x3b, 0xc7, 0x3d, 0xd4, 0x45, 0xbd, 0xcd, 0xc1, 0x0e, 0x2d, 0x08, 0x68, 0x4e, 0x40, 0x4b, 0x02,
-	0x9a, 0x27, 0x0e, 0xdb, 0x97, 0xd7, 0xbb, 0x8d, 0xc8, 0x25, 0x13, 0x1f, 0xdf, 0xd1, 0x52, 0xc8,
-	0x34, 0x93, 0xda, 0x6b, 0x76, 0x51, 0x6f, 0x23, 0xaa, 0xde, 0x64, 0x1b, 0x77, 0x8c, 0x54, 0x63,
-	0xa9, 0xbd, 0x96, 0x43, 0xca, 0x57, 0xe8, 0xe1, 0xed, 0xfa, 0xd7, 0x91, 0x34, 0x33, 0x50, 0x46,
-	0x86, 0x5f, 0x11, 0xde, 0xfa, 0x03, 0xbd, 0x88, 0x46, 0x83, 0x03, 0xb2, 0x8f, 0xef, 0x0b, 0x50,
-	0x56, 0x73, 0x61, 0x4f, 0xf8, 0x78, 0xac, 0xa5, 0x31, 0x4e, 0xe2, 0x46, 0xb4, 0xb5, 0x8c, 0x1f,
-	0x15, 0x61, 0xf2, 0x12, 0x77, 0xf8, 0x14, 0xce, 0x94, 0x2d, 0xa4, 0x0c, 0x69, 0x2e, 0xf4, 0xc7,
-	0xf5, 0xee, 0x93, 0x24, 0xb5, 0xa7, 0x67, 0x31, 0x15, 0x30, 0x65, 0xa5, 0x2d, 0xc5, 0xf1, 0xdc,
-	0x8c, 0xdf, 0x31, 0x7b, 0x31, 0x93, 0x86, 0xbe, 0x52, 0x36, 0x2a, 0xab, 0x6b, 0x4d, 0xb5, 0xfe,
-	0xd9, 0x54, 0xbb, 0xd6, 0xd4, 0x0e, 0x7e, 0xf8, 0x97, 0xf2, 0x65, 0x57, 0x83, 0x2f, 0x4d, 0xdc,
-	0x3a, 0x36, 0x09, 0xf9, 0x80, 0xf0, 0xe6, 0xaa, 0xe1, 0x21, 0xbd, 0x39, 0x6b, 0x5a, 0x77, 0xc6,
-	0x7f, 0xfa, 0xff, 0x9c, 0xca, 0xbd, 0xde, 0xfb, 0x6f, 0xbf, 0x3e, 0x37, 0x43, 0xd2, 0x65, 0x37,
-	0xb7, 0x8b, 0x89, 0xa2, 0xe0, 0xc4, 0x4d, 0xed, 0x23, 0xc2, 0x77, 0x6b, 0x26, 0xef, 0xad, 0xff,
-	0xc6, 0x25, 0xf9, 0xcf, 0x6e, 0x91, 0x54, 0x89, 0xd9, 0x77, 0x62, 0xf6, 0xc8, 0xe3, 0x75, 0x62,
-	0x5c, 0x60, 0xf8, 0xfa, 0x72, 0x1e, 0xa0, 0xab, 0x79, 0x80, 0x7e, 0xce, 0x03, 0xf4, 0x69, 0x11,
-	0x34, 0xae, 0x16, 0x41, 0xe3, 0xfb, 0x22, 0x68, 0xbc, 0xed, 0xaf, 0x0c, 0xee, 0x48, 0xf3, 0x78,
-	0x22, 0xdf, 0xe4, 0xcb, 0x2b, 0x60, 0xb2, 0xc2, 0x7a, 0xbe, 0xe4, 0xcd, 0xe7, 0x18, 0x77, 0xdc,
-	0x7a, 0x1f, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xcd, 0x56, 0x1c, 0x62, 0x03, 0x00, 0x00,
+	// 730 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0x3b, 0x14, 0x1b, 0x78, 0x10, 0xc0, 0x91, 0x60, 0x29, 0xb8, 0x85, 0x45, 0xa1, 0x60,
+	0xdc, 0x69, 0xcb, 0xc1, 0x13, 0x07, 0x8a, 0x9a, 0x18, 0x43, 0x62, 0x36, 0x26, 0x26, 0x5e, 0xc8,
+	0x74, 0x3b, 0xd9, 0x6e, 0x80, 0x9d, 0x66, 0x67, 0x68, 0xe0, 0x66, 0xbc, 0x18, 0xe3, 0xc5, 0xe8,
+	0xc5, 0x83, 0x07, 0xcf, 0xc6, 0x83, 0x47, 0xff, 0x04, 0x8e, 0x24, 0x5e, 0x8c, 0x07, 0x34, 0xe0,
+	0x1f, 0x62, 0x76, 0x66, 0xbb, 0xb6, 0xf4, 0xa7, 0x1c, 0x3c, 0xd1, 0x9d, 0xf7, 0x63, 0x3e, 0xef,
+	0x7d, 0xdf, 0x3c, 0x20, 0x4d, 0x9d, 0x80, 0x39, 0x55, 0xea, 0xf9, 0x84, 0x05, 0x4e, 0x31, 0x4f,
+	0xe4, 0xa1, 0x55, 0x0b, 0xb8, 0xe4, 0x18, 0xc7, 0x16, 0x4b, 0x59, 0xac, 0x7a, 0x21, 0x33, 0xef,
+	0x72, 0xee, 0xee, 0x31, 0x42, 0x6b, 0x1e, 0xa1, 0xbe, 0xcf, 0x25, 0x95, 0x1e, 0xf7, 0x85, 0x8e,
+	0xc8, 0x4c, 0xbb, 0xdc, 0xe5, 0xea, 0x27, 0x09, 0x7f, 0x45, 0xa7, 0x86, 0xc3, 0xc5, 0x3e, 0x17,
+	0xa4, 0x4c, 0x05, 0x23, 0xf5, 0x42, 0x99, 0x49, 0x5a, 0x20, 0x0e, 0xf7, 0xfc, 0xc8, 0x3e, 0x77,
+	0x91, 0x40, 0xdf, 0xa6, 0x8c, 0xe6, 0x11, 0x4c, 0x6c, 0x0b, 0x77, 0x8b, 0xfb, 0x75, 0x16, 0xc8,
+	0x2d, 0xee, 0xf9, 0x78, 0x1d, 0x86, 0xc3, 0xe0, 0x34, 0x5a, 0x40, 0xb9, 0xb1, 0xe2, 0xac, 0xa5,
+	0xb3, 0x5b, 0x61, 0x76, 0x2b, 0xca, 0x6e, 0x85, 0x8e, 0xa5, 0xe1, 0xe3, 0xd3, 0x6c, 0xc2, 0x56,
+	0xce, 0x38, 0x03, 0x23, 0x01, 0x73, 0x98, 0x57, 0x67, 0x41, 0x7a, 0x68, 0x01, 0xe5, 0x46, 0xed,
+	0xf8, 0x1b, 0xcf, 0x40, 0x4a, 0x30, 0xbf, 0xc2, 0x82, 0x74, 0x52, 0x59, 0xa2, 0x2f, 0x33, 0x0d,
+	0x33, 0xad, 0x57, 0xdb, 0x4c, 0xd4, 0xb8, 0x2f, 0x98, 0xf9, 0x15, 0xc1, 0xe4, 0x5f, 0xd3, 0x7d,
+	0x7b, 0xab, 0x98, 0xc7, 0xab, 0x30, 0xe5, 0x70, 0x5f, 0x06, 0xd4, 0x91, 0x3b, 0xb4, 0x52, 0x09,
+	0x98, 0x10, 0x0a, 0x71, 0xd4, 0x9e, 0x6c, 0x9c, 0x6f, 0xea, 0x63, 0xfc, 0x00, 0x52, 0x74, 0x9f,
+	0x1f, 0xf8, 0x52, 0xa3, 0x94, 0xac, 0x10, 0xf4, 0xc7, 0x69, 0x76, 0xd9, 0xf5, 0x64, 0xf5, 0xa0,
+	0x6c, 0x39, 0x7c, 0x9f, 0x44, 0x3d, 0xd3, 0x7f, 0xee, 0x88, 0xca, 0x2e, 0x91, 0x47, 0x35, 0x26,
+	0xac, 0x87, 0xbe, 0xb4, 0xa3, 0xe8, 0x96, 0xa2, 0x92, 0x5d, 0x8b, 0x1a, 0x6e, 0x29, 0x6a, 0x16,
+	0xae, 0x5f, 0x20, 0x8f, 0xab, 0x7a, 0x8f, 0x60, 0x6e, 0x5b, 0xb8, 0x36, 0x73, 0x3d, 0x21, 0x59,
+	0xa0, 0x8c, 0x4f, 0x3d, 0x59, 0xbd, 0xc7, 0x6a, 0x5c, 0x78, 0xb2, 0x29, 0x25, 0x6a, 0x4e, 0x89,
+	0x4d, 0x18, 0x57, 0x8a, 0x35, 0xaa, 0xd6, 0xfd, 0x6d, 0x39, 0xc3, 0x1b, 0x30, 0x52, 0x66, 0x55,
+	0x5a, 0xf7, 0xb8, 0x46, 0x9d, 0x28, 0x2e, 0x5a, 0xed, 0xe3, 0x65, 0x3d, 0xe1, 0xbb, 0xcc, 0x2f,
+	0x45, 0x8e, 0x76, 0x1c, 0x62, 0xde, 0x82, 0xa5, 0x1e, 0x64, 0x71, 0x05, 0x9f, 0x11, 0x5c, 0x6b,
+	0x95, 0xac, 0x44, 0xa5, 0x53, 0xc5, 0x14, 0xae, 0x84, 0x53, 0x10, 0x0a, 0x92, 0xec, 0x3d, 0x33,
+	0xf9, 0x50, 0x8a, 0x4f, 0x3f, 0xb3, 0xb9, 0x01, 0xa4, 0x08, 0x03, 0x84, 0xad, 0x33, 0x5f, 0x6a,
+	0xc0, 0x6e, 0xc0, 0x5c, 0x07, 0xda, 0xb8, 0x9a, 0xe7, 0x08, 0xc6, 0x54, 0xa9, 0x9b, 0x5a, 0xee,
+	0xff, 0x3f, 0x61, 0xe6, 0x2b, 0x04, 0xd3, 0x17, 0xc6, 0x45, 0x77, 0x74, 0x03, 0x52, 0x32, 0xd4,
+	0xaa, 0xd1, 0xd2, 0x6c, 0x27, 0x35, 0x9b, 0xe0, 0xa3, 0xc7, 0x18, 0x05, 0x5d, 0xaa, 0x5b, 0x06,
+	0xcc, 0x77, 0x42, 0x69, 0xb4, 0xab, 0xf8, 0x36, 0x05, 0xc9, 0x6d, 0xe1, 0xe2, 0x97, 0x08, 0xc6,
+	0x9a, 0xf7, 0x85, 0xd9, 0x09, 0xad, 0xb5, 0xef, 0x99, 0xb5, 0xfe, 0x3e, 0xb1, 0x2c, 0xb9, 0x17,
+	0xdf, 0x7e, 0xbf, 0x1b, 0x32, 0xf1, 0x02, 0x69, 0xdf, 0x9c, 0xc4, 0xd1, 0x01, 0x3b, 0x6a, 0xe9,
+	0xbc, 0x46, 0x30, 0xde, 0xb2, 0x23, 0x96, 0x7a, 0x5f, 0xa3, 0x9c, 0x32, 0xb7, 0x07, 0x70, 0x8a,
+	0x61, 0x56, 0x15, 0xcc, 0x12, 0x5e, 0xec, 0x05, 0xa3, 0x0e, 0xf0, 0x17, 0x04, 0xe9, 0xae, 0x6f,
+	0x9b, 0x74, 0xb9, 0xb4, 0x5b, 0x40, 0xe6, 0xee, 0x3f, 0x06, 0xc4, 0xc4, 0x6b, 0x8a, 0xf8, 0x26,
+	0x36, 0x3b, 0x11, 0x07, 0x51, 0x74, 0x84, 0xfc, 0x01, 0xc1, 0x54, 0xdb, 0x63, 0x5e, 0xe9, 0xaf,
+	0x95, 0x72, 0xcc, 0x90, 0x01, 0x1d, 0x63, 0x34, 0x4b, 0xa1, 0xe5, 0xf0, 0x72, 0x3f, 0x65, 0x77,
+	0xca, 0x8a, 0xe4, 0x23, 0x82, 0xab, 0xed, 0x4f, 0x23, 0x37, 0x80, 0x7e, 0x1a, 0x30, 0x3f, 0xa8,
+	0x67, 0x4c, 0x48, 0x14, 0xe1, 0x2a, 0x5e, 0xe9, 0x2b, 0xb7, 0x46, 0x2c, 0x3d, 0x3a, 0x3e, 0x33,
+	0xd0, 0xc9, 0x99, 0x81, 0x7e, 0x9d, 0x19, 0xe8, 0xcd, 0xb9, 0x91, 0x38, 0x39, 0x37, 0x12, 0xdf,
+	0xcf, 0x8d, 0xc4, 0xb3, 0x42, 0xd3, 0x2a, 0xd8, 0x0c, 0x68, 0x79, 0x8f, 0x3d, 0x0e, 0xb8, 0xe4,
+	0x0e, 0xdf, 0x6b, 0xca, 0x7d, 0xd8, 0xc8, 0x1e, 0x6e, 0x86, 0x72, 0x4a, 0xfd, 0x4b, 0x5e, 0xff,
+	0x33, 0x00, 0xa4, 0x44, 0x20, 0x50, 0x33, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -297,6 +676,16 @@ type MsgClient interface {
 	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
 	// contract that is registered on the token mapping.
 	ConvertERC20(ctx context.Context, in *MsgConvertERC20, opts ...grpc.CallOption) (*MsgConvertERC20Response, error)
+	// RegisterERC20WithDeposit registers a token pair for an ERC20 token with an
+	// allowed code hash, in exchange for the registration deposit.
+	RegisterERC20WithDeposit(ctx context.Context, in *MsgRegisterERC20WithDeposit, opts ...grpc.CallOption) (*MsgRegisterERC20WithDepositResponse, error)
+	// ConvertCoinBatch converts several native Cosmos coins to their ERC20
+	// representations. Either all the conversions succeed or none is applied.
+	ConvertCoinBatch(ctx context.Context, in *MsgConvertCoinBatch, opts ...grpc.CallOption) (*MsgConvertCoinBatchResponse, error)
+	// ConvertERC20Batch converts several ERC20 tokens to their native Cosmos
+	// coin representations. Either all the conversions succeed or none is
+	// applied.
+	ConvertERC20Batch(ctx context.Context, in *MsgConvertERC20Batch, opts ...grpc.CallOption) (*MsgConvertERC20BatchResponse, error)
 }
 
 type msgClient struct {
@@ -325,6 +714,33 @@ func (c *msgClient) ConvertERC20(ctx context.Context, in *MsgConvertERC20, opts
 	return out, nil
 }
 
+func (c *msgClient) RegisterERC20WithDeposit(ctx context.Context, in *MsgRegisterERC20WithDeposit, opts ...grpc.CallOption) (*MsgRegisterERC20WithDepositResponse, error) {
+	out := new(MsgRegisterERC20WithDepositResponse)
+	err := c.cc.Invoke(ctx, "/acrechain.erc20.v1.Msg/RegisterERC20WithDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConvertCoinBatch(ctx context.Context, in *MsgConvertCoinBatch, opts ...grpc.CallOption) (*MsgConvertCoinBatchResponse, error) {
+	out := new(MsgConvertCoinBatchResponse)
+	err := c.cc.Invoke(ctx, "/acrechain.erc20.v1.Msg/ConvertCoinBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConvertERC20Batch(ctx context.Context, in *MsgConvertERC20Batch, opts ...grpc.CallOption) (*MsgConvertERC20BatchResponse, error) {
+	out := new(MsgConvertERC20BatchResponse)
+	err := c.cc.Invoke(ctx, "/acrechain.erc20.v1.Msg/ConvertERC20Batch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ConvertCoin mints a ERC20 representation of the native Cosmos coin denom
@@ -333,6 +749,16 @@ type MsgServer interface {
 	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
 	// contract that is registered on the token mapping.
 	ConvertERC20(context.Context, *MsgConvertERC20) (*MsgConvertERC20Response, error)
+	// RegisterERC20WithDeposit registers a token pair for an ERC20 token with an
+	// allowed code hash, in exchange for the registration deposit.
+	RegisterERC20WithDeposit(context.Context, *MsgRegisterERC20WithDeposit) (*MsgRegisterERC20WithDepositResponse, error)
+	// ConvertCoinBatch converts several native Cosmos coins to their ERC20
+	// representations. Either all the conversions succeed or none is applied.
+	ConvertCoinBatch(context.Context, *MsgConvertCoinBatch) (*MsgConvertCoinBatchResponse, error)
+	// ConvertERC20Batch converts several ERC20 tokens to their native Cosmos
+	// coin representations. Either all the conversions succeed or none is
+	// applied.
+	ConvertERC20Batch(context.Context, *MsgConvertERC20Batch) (*MsgConvertERC20BatchResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -345,6 +771,15 @@ func (*UnimplementedMsgServer) ConvertCoin(ctx context.Context, req *MsgConvertC
 func (*UnimplementedMsgServer) ConvertERC20(ctx context.Context, req *MsgConvertERC20) (*MsgConvertERC20Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC20 not implemented")
 }
+func (*UnimplementedMsgServer) RegisterERC20WithDeposit(ctx context.Context, req *MsgRegisterERC20WithDeposit) (*MsgRegisterERC20WithDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterERC20WithDeposit not implemented")
+}
+func (*UnimplementedMsgServer) ConvertCoinBatch(ctx context.Context, req *MsgConvertCoinBatch) (*MsgConvertCoinBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoinBatch not implemented")
+}
+func (*UnimplementedMsgServer) ConvertERC20Batch(ctx context.Context, req *MsgConvertERC20Batch) (*MsgConvertERC20BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC20Batch not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -386,28 +821,94 @@ func _Msg_ConvertERC20_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "acrechain.erc20.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ConvertCoin",
-			Handler:    _Msg_ConvertCoin_Handler,
-		},
-		{
-			MethodName: "ConvertERC20",
-			Handler:    _Msg_ConvertERC20_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "acrechain/erc20/tx.proto",
-}
-
-func (m *MsgConvertCoin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RegisterERC20WithDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterERC20WithDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterERC20WithDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/acrechain.erc20.v1.Msg/RegisterERC20WithDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterERC20WithDeposit(ctx, req.(*MsgRegisterERC20WithDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertCoinBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertCoinBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertCoinBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/acrechain.erc20.v1.Msg/ConvertCoinBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertCoinBatch(ctx, req.(*MsgConvertCoinBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertERC20Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertERC20Batch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertERC20Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/acrechain.erc20.v1.Msg/ConvertERC20Batch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertERC20Batch(ctx, req.(*MsgConvertERC20Batch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "acrechain.erc20.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ConvertCoin",
+			Handler:    _Msg_ConvertCoin_Handler,
+		},
+		{
+			MethodName: "ConvertERC20",
+			Handler:    _Msg_ConvertERC20_Handler,
+		},
+		{
+			MethodName: "RegisterERC20WithDeposit",
+			Handler:    _Msg_RegisterERC20WithDeposit_Handler,
+		},
+		{
+			MethodName: "ConvertCoinBatch",
+			Handler:    _Msg_ConvertCoinBatch_Handler,
+		},
+		{
+			MethodName: "ConvertERC20Batch",
+			Handler:    _Msg_ConvertERC20Batch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "acrechain/erc20/tx.proto",
+}
+
+func (m *MsgConvertCoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
@@ -513,121 +1014,1092 @@ func (m *MsgConvertERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgConvertERC20Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgConvertERC20Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgConvertERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgConvertCoin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Coin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterERC20WithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterERC20WithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterERC20WithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Behavior != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Behavior))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterERC20WithDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterERC20WithDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterERC20WithDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCoinBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCoinBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCoinBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCoinBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCoinBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCoinBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20Amount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20Amount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20Amount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC20Batch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC20Batch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC20Batch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC20BatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC20BatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC20BatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgConvertCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertCoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterERC20WithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Behavior != 0 {
+		n += 1 + sovTx(uint64(m.Behavior))
+	}
+	return n
+}
+
+func (m *MsgRegisterERC20WithDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertCoinBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertCoinBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ERC20Amount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgConvertERC20Batch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertERC20BatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterERC20WithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterERC20WithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterERC20WithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Behavior", wireType)
+			}
+			m.Behavior = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Behavior |= TokenBehavior(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgConvertCoinResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgConvertERC20) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRegisterERC20WithDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterERC20WithDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterERC20WithDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgConvertERC20Response) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertCoinBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -650,15 +2122,15 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertCoinBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertCoinBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -685,7 +2157,8 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -774,7 +2247,7 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertCoinBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -797,10 +2270,10 @@ func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoinResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertCoinBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertCoinBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -824,7 +2297,7 @@ func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
+func (m *ERC20Amount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -847,10 +2320,10 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20: wiretype end group for non-group")
+			return fmt.Errorf("proto: ERC20Amount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ERC20Amount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -919,7 +2392,91 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20Batch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20Batch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20Batch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, ERC20Amount{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
@@ -951,7 +2508,7 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			}
 			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -1004,7 +2561,7 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertERC20BatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1027,10 +2584,10 @@ func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertERC20BatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertERC20BatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
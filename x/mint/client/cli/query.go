@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -25,6 +26,15 @@ func GetQueryCmd() *cobra.Command {
 	mintingQueryCmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdQueryDailyProvisions(),
+		GetCmdQueryDeveloperVestingBalance(),
+		GetCmdQueryAnnualProvisions(),
+		GetCmdQueryInflation(),
+		GetCmdQueryNextReductionTime(),
+		GetCmdQueryProjectedSchedule(),
+		GetCmdQueryRemainingMintable(),
+		GetCmdQueryScheduledParamsChanges(),
+		GetCmdQueryMintHistory(),
+		GetCmdQueryIncentiveStreams(),
 	)
 
 	return mintingQueryCmd
@@ -87,3 +97,273 @@ func GetCmdQueryDailyProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryDeveloperVestingBalance implements a command to return the remaining
+// developer vesting balance and its projected depletion time.
+func GetCmdQueryDeveloperVestingBalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "developer-vesting-balance",
+		Short: "Query the remaining developer vesting balance and its projected depletion time",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryDeveloperVestingBalanceRequest{}
+			res, err := queryClient.DeveloperVestingBalance(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAnnualProvisions implements a command to return the current minting
+// annual provisions value.
+func GetCmdQueryAnnualProvisions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "annual-provisions",
+		Short: "Query the current minting annual provisions value",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAnnualProvisionsRequest{}
+			res, err := queryClient.AnnualProvisions(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", res.AnnualProvisions))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryInflation implements a command to return the current effective
+// inflation rate.
+func GetCmdQueryInflation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflation",
+		Short: "Query the current effective inflation rate",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryInflationRequest{}
+			res, err := queryClient.Inflation(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", res.Inflation))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryNextReductionTime implements a command to return the time of the
+// next minting reduction.
+func GetCmdQueryNextReductionTime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-reduction-time",
+		Short: "Query the unix time of the next minting reduction",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryNextReductionTimeRequest{}
+			res, err := queryClient.NextReductionTime(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%d\n", res.NextReductionTime))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProjectedSchedule implements a command to return the expected
+// emission of the upcoming reduction periods.
+func GetCmdQueryProjectedSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-schedule [periods]",
+		Short: "Query the expected emission of the upcoming reduction periods",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			periods, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid number of periods %s: %w", args[0], err)
+			}
+
+			params := &types.QueryProjectedScheduleRequest{Periods: uint32(periods)}
+			res, err := queryClient.ProjectedSchedule(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRemainingMintable implements a command to return the amount that
+// can still be minted before the max supply is reached.
+func GetCmdQueryRemainingMintable() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remaining-mintable",
+		Short: "Query the amount that can still be minted before the max supply is reached",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRemainingMintableRequest{}
+			res, err := queryClient.RemainingMintable(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryScheduledParamsChanges implements a command to return the pending
+// minting parameter changes.
+func GetCmdQueryScheduledParamsChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-params-changes",
+		Short: "Query the pending minting parameter changes, ordered by activation time",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryScheduledParamsChangesRequest{}
+			res, err := queryClient.ScheduledParamsChanges(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMintHistory implements a command to return the recorded mints.
+func GetCmdQueryMintHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-history",
+		Short: "Query the recorded mints, oldest first unless --reverse is set",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryMintHistoryRequest{Pagination: pageReq}
+			res, err := queryClient.MintHistory(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "mint history")
+
+	return cmd
+}
+
+// GetCmdQueryIncentiveStreams implements a command to return the registered incentive streams.
+func GetCmdQueryIncentiveStreams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "incentive-streams",
+		Short: "Query the registered incentive streams",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryIncentiveStreamsRequest{}
+			res, err := queryClient.IncentiveStreams(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
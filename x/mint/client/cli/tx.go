@@ -0,0 +1,315 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+// FlagActivationTime is the flag for the unix time scheduled params take effect at.
+const FlagActivationTime = "activation-time"
+
+// NewUpdateParamsProposalCmd implements the command to submit an update mint params proposal
+func NewUpdateParamsProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-mint-params [params]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update the minting parameters",
+		Long: `Submit a proposal to replace the minting parameters along with an initial deposit.
+Upon passing, the params are applied immediately, or at the given activation time when it is in the future.
+A scheduled change only sets the params that differ from the current ones when the proposal passes.
+The params must be supplied via a JSON file, e.g. the output of the mint params query.`,
+		Example: fmt.Sprintf(`$ %s tx gov submit-proposal update-mint-params <path/to/params.json> --activation-time=1700000000 --from=<key_or_address>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			activationTime, err := cmd.Flags().GetInt64(FlagActivationTime)
+			if err != nil {
+				return err
+			}
+
+			params, err := ParseParams(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewUpdateParamsProposal(title, description, params, activationTime)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aacre", "deposit of proposal")
+	cmd.Flags().Int64(FlagActivationTime, 0, "unix time the params take effect at, zero to apply them when the proposal passes")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewCancelParamsChangeProposalCmd implements the command to submit a cancel scheduled params change proposal
+func NewCancelParamsChangeProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-params-change [change-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to cancel a scheduled change of the minting parameters",
+		Long:  `Submit a proposal to cancel a scheduled change of the minting parameters before its activation time along with an initial deposit.`,
+		Example: fmt.Sprintf(`$ %s tx gov submit-proposal cancel-params-change 1 --from=<key_or_address>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			changeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewCancelParamsChangeProposal(title, description, changeID)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewRegisterIncentiveStreamProposalCmd implements the command to submit a register incentive stream proposal
+func NewRegisterIncentiveStreamProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-incentive-stream [recipient] [share] [start-time] [end-time]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Submit a proposal to register an emission-funded incentive stream",
+		Long: `Submit a proposal to register an incentive stream along with an initial deposit.
+Upon passing, the recipient, a bech32 address or a module account name, receives the given share of each mint
+between the start and end unix times, taken out of the community pool share.`,
+		Example: fmt.Sprintf(`$ %s tx gov submit-proposal register-incentive-stream erc20 0.05 1700000000 1730000000 --from=<key_or_address>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			share, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			endTime, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewRegisterIncentiveStreamProposal(title, description, args[0], share, startTime, endTime)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCancelIncentiveStreamProposalCmd implements the command to submit a cancel incentive stream proposal
+func NewCancelIncentiveStreamProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-incentive-stream [stream-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to cancel an incentive stream",
+		Long:  `Submit a proposal to cancel an incentive stream before its end time along with an initial deposit.`,
+		Example: fmt.Sprintf(`$ %s tx gov submit-proposal cancel-incentive-stream 1 --from=<key_or_address>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewCancelIncentiveStreamProposal(title, description, streamID)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// parseProposalFlags reads the title, description and deposit flags of a proposal command.
+func parseProposalFlags(cmd *cobra.Command) (string, string, sdk.Coins, error) {
+	title, err := cmd.Flags().GetString(cli.FlagTitle)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	description, err := cmd.Flags().GetString(cli.FlagDescription)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	return title, description, deposit, nil
+}
+
+// addProposalFlags adds the required title, description and deposit flags of a proposal command.
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aacre", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+}
+
+// ParseParams reads and parses the minting parameters from a JSON file.
+func ParseParams(cdc codec.JSONCodec, paramsFile string) (types.Params, error) {
+	params := types.Params{}
+
+	contents, err := os.ReadFile(filepath.Clean(paramsFile))
+	if err != nil {
+		return params, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &params); err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
@@ -0,0 +1,15 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/ArableProtocol/acrechain/x/mint/client/cli"
+	"github.com/ArableProtocol/acrechain/x/mint/client/rest"
+)
+
+var (
+	UpdateParamsProposalHandler            = govclient.NewProposalHandler(cli.NewUpdateParamsProposalCmd, rest.UpdateParamsProposalRESTHandler)
+	CancelParamsChangeProposalHandler      = govclient.NewProposalHandler(cli.NewCancelParamsChangeProposalCmd, rest.CancelParamsChangeProposalRESTHandler)
+	RegisterIncentiveStreamProposalHandler = govclient.NewProposalHandler(cli.NewRegisterIncentiveStreamProposalCmd, rest.RegisterIncentiveStreamProposalRESTHandler)
+	CancelIncentiveStreamProposalHandler   = govclient.NewProposalHandler(cli.NewCancelIncentiveStreamProposalCmd, rest.CancelIncentiveStreamProposalRESTHandler)
+)
@@ -8,7 +8,31 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// BeginBlocker mints and distributes the provisions of the time elapsed since the last
+// mint when the minting mode is MINTING_MODE_BEGIN_BLOCK.
+func (k Keeper) BeginBlocker(ctx sdk.Context) {
+	// apply the scheduled parameter changes that are due before minting with them
+	k.ApplyDueParamsChanges(ctx)
+
+	if k.GetParams(ctx).MintingMode == types.MINTING_MODE_BEGIN_BLOCK {
+		k.mint(ctx)
+	}
+}
+
+// EndBlocker mints and distributes the provisions of the time elapsed since the last
+// mint when the minting mode is MINTING_MODE_END_BLOCK.
 func (k Keeper) EndBlocker(ctx sdk.Context) {
+	k.ApplyDueParamsChanges(ctx)
+
+	if k.GetParams(ctx).MintingMode == types.MINTING_MODE_END_BLOCK {
+		k.mint(ctx)
+	}
+}
+
+// mint mints and distributes the provisions of the time elapsed since the last mint.
+// The provisions only depend on the block time, so that minting twice in a block, as
+// happens when switching from EndBlock to BeginBlock minting, mints nothing more.
+func (k Keeper) mint(ctx sdk.Context) {
 	params := k.GetParams(ctx)
 	blockTime := ctx.BlockTime().Unix()
 
@@ -19,6 +43,7 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 
 	// fetch stored minter & params
 	minter := k.GetMinter(ctx)
+	k.SetPreviousDailyProvisions(ctx, minter.DailyProvisions)
 
 	// if it's the first block after minting rewards distribution start time,
 	// skip minting and just set last mint time
@@ -28,15 +53,33 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 		return
 	}
 
-	// reduce minting amount when reduction time come
-	if blockTime >= params.NextRewardsReductionTime {
-		minter.DailyProvisions = minter.DailyProvisions.Mul(params.ReductionFactor)
+	// stop minting for good once the max supply is reached, as the emission curves
+	// rebuild the daily provisions and burns can bring the supply back under it
+	remaining, capped := k.GetRemainingMintable(ctx)
+	if capped && !remaining.IsPositive() {
+		k.stopMinting(ctx, &minter, params)
+		minter.LastMintTime = ctx.BlockTime().Unix()
 		k.SetMinter(ctx, minter)
-		k.SetNextReductionTime(ctx, blockTime+params.ReductionPeriodInSeconds)
+		return
+	}
+
+	provisions := k.accrueProvisions(ctx, &minter, params)
+	if provisions.SkippedSeconds > 0 {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEmissionSkipped,
+				sdk.NewAttribute(types.AttributeKeySkippedSeconds, fmt.Sprintf("%d", provisions.SkippedSeconds)),
+				sdk.NewAttribute(types.AttributeKeySkippedAmount, provisions.Skipped.TruncateInt().String()),
+			),
+		)
 	}
 
 	// mint coins
-	mintedCoin := minter.BlockProvision(ctx.BlockTime().Unix(), params)
+	mintedCoin := sdk.NewCoin(params.MintDenom, provisions.Minted.TruncateInt())
+	if capped && mintedCoin.Amount.GTE(remaining) {
+		mintedCoin.Amount = remaining
+		k.stopMinting(ctx, &minter, params)
+	}
 	mintedCoins := sdk.NewCoins(mintedCoin)
 
 	// update last mint time
@@ -48,12 +91,23 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 		if err != nil {
 			panic(err)
 		}
+		k.addMintedTotal(ctx, mintedCoin.Amount)
 
 		// send the minted coins to the fee collector account
-		err = k.DistributeMintedCoin(ctx, mintedCoin)
+		distribution, err := k.distributeMintedCoin(ctx, mintedCoin)
 		if err != nil {
 			panic(err)
 		}
+		k.RecordMint(ctx, types.MintRecord{
+			Height:        ctx.BlockHeight(),
+			Time:          ctx.BlockTime().Unix(),
+			Amount:        mintedCoin.Amount,
+			Staking:       distribution.Staking,
+			CommunityPool: distribution.CommunityPool,
+		})
+
+		// the hooks run once the mint is recorded, as they may move or burn funds
+		k.afterDistributeMintedCoin(ctx, mintedCoin, distribution)
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.ModuleName,
@@ -64,3 +118,90 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 		)
 	}
 }
+
+// accruedProvisions is the outcome of walking the time elapsed since the last mint.
+type accruedProvisions struct {
+	// Minted is the amount to mint in the current block.
+	Minted sdk.Dec
+	// Skipped is the amount not minted because of the max elapsed seconds cap.
+	Skipped sdk.Dec
+	// SkippedSeconds is the time not minted for because of the cap.
+	SkippedSeconds int64
+}
+
+// accrueProvisions walks the time elapsed since the last mint, splitting it at each
+// reduction boundary so that every missed reduction period is applied at its own time,
+// and updates the daily provisions of the minter and the next reduction time along the
+// way. Only the most recent MaxElapsedSeconds of the window are minted for, the time
+// before is skipped.
+func (k Keeper) accrueProvisions(ctx sdk.Context, minter *types.Minter, params types.Params) accruedProvisions {
+	blockTime := ctx.BlockTime().Unix()
+	schedule := params.EmissionSchedule()
+	state := k.emissionState(ctx, *minter, params)
+
+	mintFrom := minter.LastMintTime
+	if params.MaxElapsedSeconds > 0 && blockTime-mintFrom > params.MaxElapsedSeconds {
+		mintFrom = blockTime - params.MaxElapsedSeconds
+	}
+
+	accrued := accruedProvisions{Minted: sdk.ZeroDec(), Skipped: sdk.ZeroDec()}
+	accrue := func(start, end int64) {
+		if end <= start {
+			return
+		}
+
+		// let the emission curve adjust the minting amount to the state of the segment
+		state.BlockTime, state.LastMintTime = end, start
+		minter.DailyProvisions = schedule.CurrentProvisions(minter.DailyProvisions, state)
+
+		var skippedSeconds int64
+		if mintFrom > start {
+			skippedSeconds = mintFrom - start
+			if skippedSeconds > end-start {
+				skippedSeconds = end - start
+			}
+			accrued.Skipped = accrued.Skipped.Add(minter.PeriodProvision(skippedSeconds))
+			accrued.SkippedSeconds += skippedSeconds
+		}
+		accrued.Minted = accrued.Minted.Add(minter.PeriodProvision(end - start - skippedSeconds))
+	}
+
+	segmentStart := minter.LastMintTime
+	nextReductionTime := params.NextRewardsReductionTime
+	for nextReductionTime <= blockTime {
+		// a reduction time before the last mint is applied at the start of the window
+		boundary := nextReductionTime
+		if boundary < segmentStart {
+			boundary = segmentStart
+		}
+
+		accrue(segmentStart, boundary)
+		minter.DailyProvisions = schedule.ReducedProvisions(minter.DailyProvisions)
+		segmentStart = boundary
+		nextReductionTime = boundary + params.ReductionPeriodInSeconds
+	}
+	accrue(segmentStart, blockTime)
+
+	if nextReductionTime != params.NextRewardsReductionTime {
+		k.SetNextReductionTime(ctx, nextReductionTime)
+	}
+
+	return accrued
+}
+
+// stopMinting sets the daily provisions to zero and records that the max supply is
+// reached, so that nothing is minted anymore under this max supply.
+func (k Keeper) stopMinting(ctx sdk.Context, minter *types.Minter, params types.Params) {
+	minter.DailyProvisions = sdk.ZeroDec()
+	if k.IsMaxSupplyReached(ctx, params.MaxSupply) {
+		return
+	}
+
+	k.setMaxSupplyReached(ctx, params.MaxSupply)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMaxSupplyReached,
+			sdk.NewAttribute(types.AttributeKeyMaxSupply, params.MaxSupply.String()),
+		),
+	)
+}
@@ -1,29 +1,36 @@
 package keeper_test
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/ArableProtocol/acrechain/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func (suite *KeeperTestSuite) TestEndBlocker() {
+	now := time.Now()
 	params := types.Params{
 		MintDenom:                "aacre",
 		GenesisDailyProvisions:   types.DefaultParams().GenesisDailyProvisions,
 		ReductionPeriodInSeconds: 1000,
 		ReductionFactor:          sdk.NewDecWithPrec(66, 2),
 		DistributionProportions: types.DistributionProportions{
-			Staking: sdk.NewDecWithPrec(2, 1),
+			Staking:          sdk.NewDecWithPrec(2, 1),
+			CommunityPool:    sdk.NewDecWithPrec(8, 1),
+			DeveloperRewards: sdk.ZeroDec(),
 		},
-		NextRewardsReductionTime:            time.Now().Add(time.Second * 1000).Unix(),
-		MintingRewardsDistributionStartTime: time.Now().Add(time.Second).Unix(),
+		NextRewardsReductionTime:            now.Add(time.Second * 1000).Unix(),
+		MintingRewardsDistributionStartTime: now.Add(time.Second).Unix(),
+		EmissionCurve:                       types.DefaultEmissionCurve(),
+		MaxSupply:                           sdk.ZeroInt(),
+		FeeBurnFraction:                     sdk.ZeroDec(),
 	}
 
 	suite.SetupTest()
 	suite.app.MintKeeper.SetParams(suite.ctx, params)
 
-	now := time.Now()
 	suite.ctx = suite.ctx.WithBlockTime(now)
 
 	// check minter information at genesis
@@ -80,9 +87,332 @@ func (suite *KeeperTestSuite) TestEndBlocker() {
 	suite.Require().Equal(minter.DailyProvisions, types.DefaultParams().GenesisDailyProvisions.Mul(params.ReductionFactor))
 	suite.Require().Equal(minter.LastMintTime, suite.ctx.BlockTime().Unix())
 	reductionTime = suite.app.MintKeeper.GetNextReductionTime(suite.ctx)
-	suite.Require().Equal(reductionTime, suite.ctx.BlockTime().Unix()+params.ReductionPeriodInSeconds)
+	suite.Require().Equal(reductionTime, params.NextRewardsReductionTime+params.ReductionPeriodInSeconds)
 	params = suite.app.MintKeeper.GetParams(suite.ctx)
 	suite.Require().Equal(params.NextRewardsReductionTime, reductionTime)
 	communityPool = suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
-	suite.Require().Equal(communityPool.String(), "5020390801481481481481.000000000000000000aacre")
+	suite.Require().Equal(communityPool.String(), "7600144733518518518518.000000000000000000aacre")
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockerEmissionCurves() {
+	now := time.Now()
+	genesisDailyProvisions := types.DefaultParams().GenesisDailyProvisions
+
+	testCases := []struct {
+		name     string
+		curve    func() types.EmissionCurve
+		expected func() sdk.Dec
+	}{
+		{
+			"geometric curve reduces by the reduction factor",
+			types.DefaultEmissionCurve,
+			func() sdk.Dec {
+				return genesisDailyProvisions.Mul(sdk.NewDecWithPrec(66, 2))
+			},
+		},
+		{
+			"linear curve reduces by a fixed amount",
+			func() types.EmissionCurve {
+				curve := types.DefaultEmissionCurve()
+				curve.CurveType = types.EMISSION_CURVE_LINEAR
+				curve.LinearReduction = sdk.NewDec(1000)
+				return curve
+			},
+			func() sdk.Dec {
+				return genesisDailyProvisions.Sub(sdk.NewDec(1000))
+			},
+		},
+		{
+			"linear curve does not reduce below zero",
+			func() types.EmissionCurve {
+				curve := types.DefaultEmissionCurve()
+				curve.CurveType = types.EMISSION_CURVE_LINEAR
+				curve.LinearReduction = genesisDailyProvisions.MulInt64(2)
+				return curve
+			},
+			sdk.ZeroDec,
+		},
+		{
+			"piecewise curve uses the last started entry",
+			func() types.EmissionCurve {
+				curve := types.DefaultEmissionCurve()
+				curve.CurveType = types.EMISSION_CURVE_PIECEWISE
+				curve.PiecewiseSchedule = []types.PiecewiseScheduleEntry{
+					{StartTime: now.Unix(), DailyProvisions: sdk.NewDec(300)},
+					{StartTime: now.Add(time.Second * 500).Unix(), DailyProvisions: sdk.NewDec(200)},
+					{StartTime: now.Add(time.Second * 2000).Unix(), DailyProvisions: sdk.NewDec(100)},
+				}
+				return curve
+			},
+			func() sdk.Dec {
+				return sdk.NewDec(200)
+			},
+		},
+		{
+			"target bonded curve is clamped to the maximum inflation",
+			func() types.EmissionCurve {
+				curve := types.DefaultEmissionCurve()
+				curve.CurveType = types.EMISSION_CURVE_TARGET_BONDED
+				return curve
+			},
+			func() sdk.Dec {
+				supply := suite.app.BankKeeper.GetSupply(suite.ctx, "aacre").Amount
+				return types.DefaultEmissionCurve().InflationMax.MulInt(supply).QuoInt64(365)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := types.DefaultParams()
+			params.MintDenom = "aacre"
+			params.ReductionPeriodInSeconds = 1000
+			params.ReductionFactor = sdk.NewDecWithPrec(66, 2)
+			params.NextRewardsReductionTime = now.Add(time.Second * 1000).Unix()
+			params.MintingRewardsDistributionStartTime = now.Unix()
+			params.EmissionCurve = tc.curve()
+			suite.app.MintKeeper.SetParams(suite.ctx, params)
+
+			// give the mint denom a supply for the curves depending on it
+			supply := sdk.NewCoins(sdk.NewCoin("aacre", sdk.NewInt(1_000_000_000).Mul(sdk.NewInt(1e18))))
+			suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, supply))
+			suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sdk.AccAddress([]byte("holder")), supply))
+
+			suite.ctx = suite.ctx.WithBlockTime(now)
+			suite.app.MintKeeper.BeginBlocker(suite.ctx)
+
+			suite.ctx = suite.ctx.WithBlockTime(now.Add(time.Second * 1001))
+			expected := tc.expected()
+			suite.app.MintKeeper.BeginBlocker(suite.ctx)
+
+			minter := suite.app.MintKeeper.GetMinter(suite.ctx)
+			suite.Require().Equal(expected, minter.DailyProvisions)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockerCatchUp() {
+	now := time.Now()
+	genesisDailyProvisions := types.DefaultParams().GenesisDailyProvisions
+	provision := func(factor sdk.Dec, seconds int64) sdk.Dec {
+		return types.NewMinter(genesisDailyProvisions.Mul(factor), 0).PeriodProvision(seconds)
+	}
+	half, quarter, eighth := sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(25, 2), sdk.NewDecWithPrec(125, 3)
+
+	testCases := []struct {
+		name              string
+		maxElapsedSeconds int64
+		expMinted         sdk.Dec
+		expSkippedSeconds int64
+	}{
+		{
+			"without cap, every missed reduction period is applied at its boundary",
+			0,
+			provision(sdk.OneDec(), 1000).Add(provision(half, 1000)).Add(provision(quarter, 1000)).Add(provision(eighth, 500)),
+			0,
+		},
+		{
+			"cap larger than the elapsed time",
+			5000,
+			provision(sdk.OneDec(), 1000).Add(provision(half, 1000)).Add(provision(quarter, 1000)).Add(provision(eighth, 500)),
+			0,
+		},
+		{
+			"cap skips the emission before the most recent seconds",
+			600,
+			provision(quarter, 100).Add(provision(eighth, 500)),
+			2900,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := types.DefaultParams()
+			params.MintDenom = "aacre"
+			params.ReductionPeriodInSeconds = 1000
+			params.ReductionFactor = half
+			params.NextRewardsReductionTime = now.Add(time.Second * 1000).Unix()
+			params.MintingRewardsDistributionStartTime = now.Unix()
+			params.MaxElapsedSeconds = tc.maxElapsedSeconds
+			suite.app.MintKeeper.SetParams(suite.ctx, params)
+
+			suite.ctx = suite.ctx.WithBlockTime(now)
+			suite.app.MintKeeper.BeginBlocker(suite.ctx)
+
+			// the chain halts for three and a half reduction periods
+			suite.ctx = suite.ctx.WithBlockTime(now.Add(time.Second * 3500)).WithEventManager(sdk.NewEventManager())
+			supplyBefore := suite.app.BankKeeper.GetSupply(suite.ctx, "aacre")
+			suite.app.MintKeeper.BeginBlocker(suite.ctx)
+			supplyAfter := suite.app.BankKeeper.GetSupply(suite.ctx, "aacre")
+
+			suite.Require().Equal(tc.expMinted.TruncateInt(), supplyAfter.Amount.Sub(supplyBefore.Amount))
+
+			minter := suite.app.MintKeeper.GetMinter(suite.ctx)
+			suite.Require().Equal(genesisDailyProvisions.Mul(eighth), minter.DailyProvisions)
+			suite.Require().Equal(now.Add(time.Second*4000).Unix(), suite.app.MintKeeper.GetNextReductionTime(suite.ctx))
+
+			skipped := false
+			for _, event := range suite.ctx.EventManager().Events() {
+				if event.Type != types.EventTypeEmissionSkipped {
+					continue
+				}
+				skipped = true
+				for _, attr := range event.Attributes {
+					if string(attr.Key) == types.AttributeKeySkippedSeconds {
+						suite.Require().Equal(fmt.Sprintf("%d", tc.expSkippedSeconds), string(attr.Value))
+					}
+				}
+			}
+			suite.Require().Equal(tc.expSkippedSeconds > 0, skipped)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockerMaxSupply() {
+	suite.SetupTest()
+
+	now := time.Now()
+	params := types.DefaultParams()
+	params.MintDenom = "aacre"
+	params.NextRewardsReductionTime = now.Add(time.Hour * 24 * 365).Unix()
+	params.MintingRewardsDistributionStartTime = now.Unix()
+	params.MaxSupply = sdk.NewInt(1_000_000)
+	suite.app.MintKeeper.SetParams(suite.ctx, params)
+
+	suite.ctx = suite.ctx.WithBlockTime(now)
+	suite.app.MintKeeper.BeginBlocker(suite.ctx)
+
+	// the provisions of the block exceed the remaining mintable amount
+	suite.ctx = suite.ctx.WithBlockTime(now.Add(time.Second * 5)).WithEventManager(sdk.NewEventManager())
+	suite.app.MintKeeper.BeginBlocker(suite.ctx)
+
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, "aacre")
+	suite.Require().Equal(params.MaxSupply, supply.Amount)
+	minter := suite.app.MintKeeper.GetMinter(suite.ctx)
+	suite.Require().True(minter.DailyProvisions.IsZero())
+
+	reached := false
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMaxSupplyReached {
+			reached = true
+		}
+	}
+	suite.Require().True(reached)
+
+	// minting stays stopped
+	suite.ctx = suite.ctx.WithBlockTime(now.Add(time.Second * 10))
+	suite.app.MintKeeper.BeginBlocker(suite.ctx)
+
+	supply = suite.app.BankKeeper.GetSupply(suite.ctx, "aacre")
+	suite.Require().Equal(params.MaxSupply, supply.Amount)
+	remaining, capped := suite.app.MintKeeper.GetRemainingMintable(suite.ctx)
+	suite.Require().True(capped)
+	suite.Require().True(remaining.IsZero())
+
+	// minting stays stopped after an export and import of the genesis state
+	genesis := suite.app.MintKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(types.ValidateGenesis(*genesis))
+	suite.Require().Equal(params.MaxSupply, genesis.MaxSupplyReached)
+
+	suite.SetupTest()
+	suite.app.MintKeeper.InitGenesis(suite.ctx, genesis)
+	suite.Require().True(suite.app.MintKeeper.IsMaxSupplyReached(suite.ctx, params.MaxSupply))
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockerMaxSupplyStaysReached() {
+	suite.SetupTest()
+
+	now := time.Now()
+	params := types.DefaultParams()
+	params.MintDenom = "aacre"
+	params.NextRewardsReductionTime = now.Add(time.Hour * 24 * 365).Unix()
+	params.MintingRewardsDistributionStartTime = now.Unix()
+	// the piecewise curve reloads the daily provisions from its table on every mint
+	params.EmissionCurve.CurveType = types.EMISSION_CURVE_PIECEWISE
+	params.EmissionCurve.PiecewiseSchedule = []types.PiecewiseScheduleEntry{
+		{StartTime: now.Unix(), DailyProvisions: sdk.NewDec(86_400_000)},
+	}
+	params.MaxSupply = suite.app.BankKeeper.GetSupply(suite.ctx, "aacre").Amount.AddRaw(1_000)
+	suite.app.MintKeeper.SetParams(suite.ctx, params)
+
+	suite.ctx = suite.ctx.WithBlockTime(now)
+	suite.app.MintKeeper.BeginBlocker(suite.ctx)
+
+	suite.ctx = suite.ctx.WithBlockTime(now.Add(time.Second * 5))
+	suite.app.MintKeeper.BeginBlocker(suite.ctx)
+
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, "aacre")
+	suite.Require().Equal(params.MaxSupply, supply.Amount)
+	suite.Require().True(suite.app.MintKeeper.IsMaxSupplyReached(suite.ctx, params.MaxSupply))
+
+	// burning brings the supply back under the max supply
+	burn := sdk.NewCoins(sdk.NewInt64Coin("aacre", 200))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, authtypes.FeeCollectorName, types.ModuleName, burn))
+	suite.Require().NoError(suite.app.BankKeeper.BurnCoins(suite.ctx, types.ModuleName, burn))
+	supply = suite.app.BankKeeper.GetSupply(suite.ctx, "aacre")
+	suite.Require().Equal(params.MaxSupply.SubRaw(200), supply.Amount)
+
+	// minting stays stopped although the curve rebuilds the daily provisions
+	suite.ctx = suite.ctx.WithBlockTime(now.Add(time.Second * 10))
+	suite.app.MintKeeper.BeginBlocker(suite.ctx)
+
+	suite.Require().Equal(supply, suite.app.BankKeeper.GetSupply(suite.ctx, "aacre"))
+	suite.Require().True(suite.app.MintKeeper.GetMinter(suite.ctx).DailyProvisions.IsZero())
+	remaining, capped := suite.app.MintKeeper.GetRemainingMintable(suite.ctx)
+	suite.Require().True(capped)
+	suite.Require().True(remaining.IsZero())
+
+	// minting resumes once governance raises the max supply
+	params.MaxSupply = params.MaxSupply.AddRaw(1_000)
+	suite.app.MintKeeper.SetParams(suite.ctx, params)
+
+	suite.ctx = suite.ctx.WithBlockTime(now.Add(time.Second * 15))
+	suite.app.MintKeeper.BeginBlocker(suite.ctx)
+
+	supply = suite.app.BankKeeper.GetSupply(suite.ctx, "aacre")
+	suite.Require().Equal(params.MaxSupply, supply.Amount)
+}
+
+func (suite *KeeperTestSuite) TestMintingMode() {
+	suite.SetupTest()
+
+	now := time.Unix(1_700_000_000, 0)
+	params := types.DefaultParams()
+	params.MintDenom = "aacre"
+	params.GenesisDailyProvisions = sdk.NewDec(86_400)
+	params.NextRewardsReductionTime = now.Add(time.Hour * 24 * 365).Unix()
+	params.MintingRewardsDistributionStartTime = now.Unix()
+	params.MintingMode = types.MINTING_MODE_END_BLOCK
+	suite.app.MintKeeper.SetParams(suite.ctx, params)
+	suite.app.MintKeeper.SetMinter(suite.ctx, types.NewMinter(params.GenesisDailyProvisions, 0))
+
+	suite.ctx = suite.ctx.WithBlockTime(now)
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+
+	// in end block mode only the EndBlocker mints
+	suite.ctx = suite.ctx.WithBlockTime(now.Add(10 * time.Second))
+	suite.app.MintKeeper.BeginBlocker(suite.ctx)
+	suite.Require().True(suite.app.BankKeeper.GetSupply(suite.ctx, "aacre").Amount.IsZero())
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(10), suite.app.BankKeeper.GetSupply(suite.ctx, "aacre").Amount)
+
+	// switching to begin block mode in the next block mints the elapsed time once
+	params.MintingMode = types.MINTING_MODE_BEGIN_BLOCK
+	suite.app.MintKeeper.SetParams(suite.ctx, params)
+	suite.ctx = suite.ctx.WithBlockTime(now.Add(20 * time.Second))
+	suite.app.MintKeeper.BeginBlocker(suite.ctx)
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(20), suite.app.BankKeeper.GetSupply(suite.ctx, "aacre").Amount)
+
+	// the staking share is in the fee collector for the distribution module of the same block
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	suite.Require().Equal(sdk.NewInt(2+2), suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, "aacre").Amount)
+
+	// minting twice in the same block mints nothing more
+	suite.app.MintKeeper.BeginBlocker(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(20), suite.app.BankKeeper.GetSupply(suite.ctx, "aacre").Amount)
 }
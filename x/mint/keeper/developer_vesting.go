@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+// CreateDeveloperVestingModuleAccount creates the developer vesting module account
+// and funds it with the given amount, which is added to the mint supply baseline
+// since it is not emitted by minting.
+func (k Keeper) CreateDeveloperVestingModuleAccount(ctx sdk.Context, amount sdk.Coin) error {
+	// The call to GetModuleAccount creates a module account if it does not exist.
+	k.accountKeeper.GetModuleAccount(ctx, types.DeveloperVestingModuleAcctName)
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+	k.SetMintSupplyBaseline(ctx, k.GetMintSupplyBaseline(ctx).Add(amount.Amount))
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.DeveloperVestingModuleAcctName, sdk.NewCoins(amount))
+}
+
+// GetDeveloperVestingBalance returns the remaining mint denom balance of the developer
+// vesting module account.
+func (k Keeper) GetDeveloperVestingBalance(ctx sdk.Context) sdk.Coin {
+	params := k.GetParams(ctx)
+	return k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.DeveloperVestingModuleAcctName), params.MintDenom)
+}
+
+// ProjectDeveloperVestingDepletionTime returns the unix time at which the developer vesting
+// balance is exhausted if the current emission schedule stays the same. It returns zero when
+// the balance is not projected to be exhausted.
+func (k Keeper) ProjectDeveloperVestingDepletionTime(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	proportion := params.DistributionProportions.DeveloperRewards
+	remaining := k.GetDeveloperVestingBalance(ctx).Amount.ToDec()
+	if !remaining.IsPositive() || !proportion.IsPositive() {
+		return 0
+	}
+
+	for _, period := range k.ProjectSchedule(ctx, types.MaxProjectedPeriods) {
+		dailyPayout := period.DailyProvisions.Mul(proportion)
+		if !dailyPayout.IsPositive() {
+			break
+		}
+
+		periodPayout := period.Emission.Mul(proportion)
+		if periodPayout.GTE(remaining) {
+			return period.StartTime + remaining.MulInt64(86400).Quo(dailyPayout).TruncateInt64()
+		}
+		remaining = remaining.Sub(periodPayout)
+	}
+
+	return 0
+}
+
+// distributeDeveloperRewards pays the developer rewards share of mintedCoin out of the developer
+// vesting module account and burns the corresponding minted coins. Once the vesting balance is
+// exhausted only the remaining balance is paid out, and the unpaid share is left to the
+// community pool. It returns the amount paid out of the vesting account, including the part
+// funded into the community pool, and the amount received by each recipient.
+func (k Keeper) distributeDeveloperRewards(ctx sdk.Context, mintedCoin sdk.Coin, proportion sdk.Dec, receivers []types.WeightedRecipient) (sdk.Int, []types.RecipientAmount, error) {
+	devRewardCoin, err := getProportions(mintedCoin, proportion)
+	if err != nil {
+		return sdk.Int{}, nil, err
+	}
+	if devRewardCoin.IsZero() {
+		return sdk.ZeroInt(), nil, nil
+	}
+
+	vestingAddr := k.accountKeeper.GetModuleAddress(types.DeveloperVestingModuleAcctName)
+	vestingBalance := k.bankKeeper.GetBalance(ctx, vestingAddr, mintedCoin.Denom)
+	if vestingBalance.Amount.LT(devRewardCoin.Amount) {
+		k.Logger(ctx).Info(
+			"paying out remaining developer vesting balance",
+			"err", insufficientDevVestingBalanceError{vestingBalance.Amount, devRewardCoin.Amount},
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDeveloperVestingExhausted,
+				sdk.NewAttribute(types.AttributeKeyBalance, vestingBalance.String()),
+				sdk.NewAttribute(types.AttributeKeyRequested, devRewardCoin.String()),
+			),
+		)
+		devRewardCoin = vestingBalance
+	}
+	if devRewardCoin.IsZero() {
+		return sdk.ZeroInt(), nil, nil
+	}
+
+	// burn the minted share of the developer rewards, since they are paid out of the vesting account
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(devRewardCoin)); err != nil {
+		return sdk.Int{}, nil, err
+	}
+	k.addMintedTotal(ctx, devRewardCoin.Amount.Neg())
+
+	// allocate developer rewards to addresses by weight. The share of an empty or failing receiver,
+	// and the remainder of the truncated shares, are left to the community pool.
+	distributed := make([]types.RecipientAmount, 0, len(receivers)+1)
+	paid := sdk.ZeroInt()
+	for _, w := range receivers {
+		devRewardPortion, err := getProportions(devRewardCoin, w.Weight)
+		if err != nil {
+			return sdk.Int{}, nil, err
+		}
+
+		if w.Recipient == emptyAddressReceiver || devRewardPortion.IsZero() {
+			continue
+		}
+		if !k.trySendToRecipient(ctx, types.DeveloperVestingModuleAcctName, w.Recipient, devRewardPortion) {
+			continue
+		}
+		k.emitDistributionEvent(ctx, w.Recipient, devRewardPortion)
+		distributed = append(distributed, types.RecipientAmount{Recipient: w.Recipient, Amount: devRewardPortion})
+		paid = paid.Add(devRewardPortion.Amount)
+	}
+
+	communityPoolCoin := devRewardCoin.SubAmount(paid)
+	if communityPoolCoin.IsPositive() {
+		if err := k.communityPoolKeeper.FundCommunityPool(ctx, sdk.NewCoins(communityPoolCoin), vestingAddr); err != nil {
+			return sdk.Int{}, nil, err
+		}
+		k.emitDistributionEvent(ctx, types.CommunityPoolRecipient, communityPoolCoin)
+		distributed = append(distributed, types.RecipientAmount{Recipient: types.CommunityPoolRecipient, Amount: communityPoolCoin})
+	}
+
+	return devRewardCoin.Amount, distributed, nil
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func (suite *KeeperTestSuite) TestDistributeDeveloperRewards() {
+	devAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	otherAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	blocked := authtypes.NewModuleAddress(stakingtypes.BondedPoolName)
+
+	testCases := []struct {
+		name             string
+		vestingAmount    sdk.Int
+		receivers        []types.WeightedRecipient
+		expDevRewards    sdk.Int
+		expVestingLeft   sdk.Int
+		expCommunityPool sdk.Int
+	}{
+		{
+			"sufficient vesting balance",
+			sdk.NewInt(1_000_000),
+			[]types.WeightedRecipient{{Recipient: devAddr.String(), Weight: sdk.OneDec()}},
+			sdk.NewInt(100_000),
+			sdk.NewInt(900_000),
+			sdk.NewInt(700_000),
+		},
+		{
+			"exhausted vesting balance pays out the remainder",
+			sdk.NewInt(40_000),
+			[]types.WeightedRecipient{{Recipient: devAddr.String(), Weight: sdk.OneDec()}},
+			sdk.NewInt(40_000),
+			sdk.ZeroInt(),
+			sdk.NewInt(760_000),
+		},
+		{
+			"empty vesting balance",
+			sdk.ZeroInt(),
+			[]types.WeightedRecipient{{Recipient: devAddr.String(), Weight: sdk.OneDec()}},
+			sdk.ZeroInt(),
+			sdk.ZeroInt(),
+			sdk.NewInt(800_000),
+		},
+		{
+			"no receivers funds the community pool",
+			sdk.NewInt(1_000_000),
+			nil,
+			sdk.ZeroInt(),
+			sdk.NewInt(900_000),
+			sdk.NewInt(800_000),
+		},
+		{
+			"blocked receiver funds the community pool",
+			sdk.NewInt(1_000_000),
+			[]types.WeightedRecipient{{Recipient: blocked.String(), Weight: sdk.OneDec()}},
+			sdk.ZeroInt(),
+			sdk.NewInt(900_000),
+			sdk.NewInt(800_000),
+		},
+		{
+			"truncated shares fund the community pool",
+			sdk.NewInt(1_000_000),
+			[]types.WeightedRecipient{
+				{Recipient: devAddr.String(), Weight: sdk.OneDec().QuoInt64(3)},
+				{Recipient: otherAddr.String(), Weight: sdk.OneDec().Sub(sdk.OneDec().QuoInt64(3))},
+			},
+			sdk.NewInt(33_333),
+			sdk.NewInt(900_000),
+			sdk.NewInt(700_001),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.app.MintKeeper.GetParams(suite.ctx)
+			params.DistributionProportions = types.DistributionProportions{
+				Staking:          sdk.NewDecWithPrec(2, 1),
+				CommunityPool:    sdk.NewDecWithPrec(7, 1),
+				DeveloperRewards: sdk.NewDecWithPrec(1, 1),
+			}
+			params.WeightedDeveloperRewardsReceivers = tc.receivers
+			suite.app.MintKeeper.SetParams(suite.ctx, params)
+
+			err := suite.app.MintKeeper.CreateDeveloperVestingModuleAccount(suite.ctx, sdk.NewCoin(params.MintDenom, tc.vestingAmount))
+			suite.Require().NoError(err)
+
+			mintedCoin := sdk.NewCoin(params.MintDenom, sdk.NewInt(1_000_000))
+			err = suite.app.MintKeeper.MintCoins(suite.ctx, sdk.NewCoins(mintedCoin))
+			suite.Require().NoError(err)
+			supplyBefore := suite.app.BankKeeper.GetSupply(suite.ctx, params.MintDenom)
+			communityPoolBefore := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(params.MintDenom)
+
+			err = suite.app.MintKeeper.DistributeMintedCoin(suite.ctx, mintedCoin)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(tc.expDevRewards, suite.app.BankKeeper.GetBalance(suite.ctx, devAddr, params.MintDenom).Amount)
+			suite.Require().Equal(tc.expVestingLeft, suite.app.MintKeeper.GetDeveloperVestingBalance(suite.ctx).Amount)
+			communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(params.MintDenom)
+			suite.Require().Equal(tc.expCommunityPool.ToDec(), communityPool.Sub(communityPoolBefore))
+
+			// the minted share paid out of the vesting account is burned
+			paidOut := tc.vestingAmount.Sub(tc.expVestingLeft)
+			supplyAfter := suite.app.BankKeeper.GetSupply(suite.ctx, params.MintDenom)
+			suite.Require().Equal(supplyBefore.Amount.Sub(paidOut), supplyAfter.Amount)
+
+			mintAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+			suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, mintAddr, params.MintDenom).IsZero())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestProjectDeveloperVestingDepletionTime() {
+	suite.SetupTest()
+
+	now := time.Unix(1_700_000_000, 0)
+	suite.ctx = suite.ctx.WithBlockTime(now)
+
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.DistributionProportions = types.DistributionProportions{
+		Staking:          sdk.NewDecWithPrec(2, 1),
+		CommunityPool:    sdk.NewDecWithPrec(7, 1),
+		DeveloperRewards: sdk.NewDecWithPrec(1, 1),
+	}
+	params.ReductionPeriodInSeconds = 86400 * 10
+	params.ReductionFactor = sdk.NewDecWithPrec(5, 1)
+	params.NextRewardsReductionTime = now.Unix() + 86400*10
+	suite.app.MintKeeper.SetParams(suite.ctx, params)
+	suite.app.MintKeeper.SetMinter(suite.ctx, types.NewMinter(sdk.NewDec(1_000_000), now.Unix()))
+
+	// no vesting balance
+	suite.Require().Equal(int64(0), suite.app.MintKeeper.ProjectDeveloperVestingDepletionTime(suite.ctx))
+
+	// 100_000 per day for 10 days, then 50_000 per day: 1_250_000 lasts 15 days
+	err := suite.app.MintKeeper.CreateDeveloperVestingModuleAccount(suite.ctx, sdk.NewCoin(params.MintDenom, sdk.NewInt(1_250_000)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(now.Unix()+86400*15, suite.app.MintKeeper.ProjectDeveloperVestingDepletionTime(suite.ctx))
+
+	// the geometric emission never pays out more than 2_000_000 in total
+	err = suite.app.MintKeeper.CreateDeveloperVestingModuleAccount(suite.ctx, sdk.NewCoin(params.MintDenom, sdk.NewInt(1_000_000)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(0), suite.app.MintKeeper.ProjectDeveloperVestingDepletionTime(suite.ctx))
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/ArableProtocol/acrechain/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -16,33 +18,182 @@ func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
 	return k.bankKeeper.MintCoins(ctx, types.ModuleName, newCoins)
 }
 
+// mintDistribution is the split of a minted coin between its recipients.
+type mintDistribution struct {
+	// Staking is the share sent to the fee collector.
+	Staking sdk.Int
+	// CommunityPool is the share funded into the community pool.
+	CommunityPool sdk.Int
+	// Recipients are the amounts received by each recipient, in order of distribution.
+	Recipients []types.RecipientAmount
+}
+
 // DistributeMintedCoins implements distribution of minted coins from mint to external modules.
 func (k Keeper) DistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) error {
+	distribution, err := k.distributeMintedCoin(ctx, mintedCoin)
+	if err != nil {
+		return err
+	}
+
+	k.afterDistributeMintedCoin(ctx, mintedCoin, distribution)
+	return nil
+}
+
+// distributeMintedCoin distributes the minted coin and returns how it was split. The mint
+// hooks are not called, they run once the EndBlocker has accounted for the mint.
+func (k Keeper) distributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) (mintDistribution, error) {
 	params := k.GetParams(ctx)
 	proportions := params.DistributionProportions
 
 	// allocate staking incentives into fee collector account to be moved to on next begin blocker by staking module account.
 	stakingIncentivesAmount, err := k.distributeToModule(ctx, k.feeCollectorName, mintedCoin, proportions.Staking)
 	if err != nil {
-		return err
+		return mintDistribution{}, err
+	}
+	k.emitDistributionEvent(ctx, k.feeCollectorName, sdk.NewCoin(mintedCoin.Denom, stakingIncentivesAmount))
+	recipients := []types.RecipientAmount{{Recipient: k.feeCollectorName, Amount: sdk.NewCoin(mintedCoin.Denom, stakingIncentivesAmount)}}
+
+	// allocate the weighted recipients' shares, either to module accounts or to plain accounts. The
+	// share of a recipient that cannot receive it is left to the community pool.
+	distributedAmount := stakingIncentivesAmount
+	for _, w := range proportions.WeightedRecipients {
+		recipientCoin, err := getProportions(mintedCoin, w.Weight)
+		if err != nil {
+			return mintDistribution{}, err
+		}
+		if !k.tryDistribute(ctx, w.Recipient, recipientCoin, func(cacheCtx sdk.Context) error {
+			_, err := k.distributeToRecipient(cacheCtx, w.Recipient, mintedCoin, w.Weight)
+			return err
+		}) {
+			continue
+		}
+		k.emitDistributionEvent(ctx, w.Recipient, recipientCoin)
+		recipients = append(recipients, types.RecipientAmount{Recipient: w.Recipient, Amount: recipientCoin})
+		distributedAmount = distributedAmount.Add(recipientCoin.Amount)
 	}
 
+	// allocate dev rewards to respective accounts from developer vesting module account.
+	devRewardAmount, devRewardRecipients, err := k.distributeDeveloperRewards(ctx, mintedCoin, proportions.DeveloperRewards, params.WeightedDeveloperRewardsReceivers)
+	if err != nil {
+		return mintDistribution{}, err
+	}
+	recipients = append(recipients, devRewardRecipients...)
+	distributedAmount = distributedAmount.Add(devRewardAmount)
+
+	// fund the active incentive streams out of the community pool share
+	streamsAmount, streamRecipients, err := k.distributeIncentiveStreams(ctx, mintedCoin, mintedCoin.Amount.Sub(distributedAmount))
+	if err != nil {
+		return mintDistribution{}, err
+	}
+	recipients = append(recipients, streamRecipients...)
+	distributedAmount = distributedAmount.Add(streamsAmount)
+
 	// subtract from original provision to ensure no coins left over after the allocations
-	communityPoolAmount := mintedCoin.Amount.Sub(stakingIncentivesAmount)
+	communityPoolAmount := mintedCoin.Amount.Sub(distributedAmount)
 	err = k.communityPoolKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, communityPoolAmount)), k.accountKeeper.GetModuleAddress(types.ModuleName))
 	if err != nil {
-		return err
+		return mintDistribution{}, err
 	}
+	k.emitDistributionEvent(ctx, types.CommunityPoolRecipient, sdk.NewCoin(mintedCoin.Denom, communityPoolAmount))
+	recipients = append(recipients, types.RecipientAmount{Recipient: types.CommunityPoolRecipient, Amount: sdk.NewCoin(mintedCoin.Denom, communityPoolAmount)})
+
+	return mintDistribution{
+		Staking:       stakingIncentivesAmount,
+		CommunityPool: communityPoolAmount,
+		Recipients:    recipients,
+	}, nil
+}
 
-	// call an hook after the minting and distribution of new coins
+// afterDistributeMintedCoin calls the mint hooks after the minting and distribution of new coins.
+func (k Keeper) afterDistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin, distribution mintDistribution) {
 	if k.hooks != nil {
-		k.hooks.AfterDistributeMintedCoin(ctx)
+		k.hooks.AfterDistributeMintedCoin(ctx, mintedCoin, distribution.Recipients)
+	}
+}
+
+// trySendToRecipient sends coin from the sender module to the recipient in a cached context that is only written when the
+// transfer succeeds. A failed transfer is logged and reported as not sent instead of halting the chain.
+func (k Keeper) trySendToRecipient(ctx sdk.Context, sender, recipient string, coin sdk.Coin) bool {
+	return k.tryDistribute(ctx, recipient, coin, func(cacheCtx sdk.Context) error {
+		return k.sendToRecipient(cacheCtx, sender, recipient, coin)
+	})
+}
+
+// tryDistribute runs the transfer of coin to the recipient in a cached context that is only written
+// when the transfer succeeds. A failed transfer is logged and reported as not sent.
+func (k Keeper) tryDistribute(ctx sdk.Context, recipient string, coin sdk.Coin, transfer func(sdk.Context) error) bool {
+	cacheCtx, write := ctx.CacheContext()
+	if err := transfer(cacheCtx); err != nil {
+		k.Logger(ctx).Error("failed to distribute minted coins", "recipient", recipient, "amount", coin.String(), "error", err.Error())
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMintDistributionFailed,
+				sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, coin.String()),
+			),
+		)
+		return false
+	}
+
+	write()
+	return true
+}
+
+// sendToRecipient sends coin from the sender module to the recipient, which is either a
+// bech32 account address or the name of a module account.
+func (k Keeper) sendToRecipient(ctx sdk.Context, sender, recipient string, coin sdk.Coin) error {
+	if err := k.validateRecipient(recipient); err != nil {
+		return err
+	}
+
+	if addr, err := sdk.AccAddressFromBech32(recipient); err == nil {
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, sender, addr, sdk.NewCoins(coin))
 	}
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, sender, recipient, sdk.NewCoins(coin))
+}
 
-	return err
+// validateRecipient checks that the recipient is either a bech32 account address allowed to
+// receive funds or the name of a registered module account.
+func (k Keeper) validateRecipient(recipient string) error {
+	if addr, err := sdk.AccAddressFromBech32(recipient); err == nil {
+		if k.bankKeeper.BlockedAddr(addr) {
+			return blockedRecipientError{recipient}
+		}
+		return nil
+	}
+
+	if k.accountKeeper.GetModuleAddress(recipient) == nil {
+		return unknownRecipientError{recipient}
+	}
+	return nil
+}
+
+// emitDistributionEvent emits the amount of minted coins received by a single recipient.
+func (k Keeper) emitDistributionEvent(ctx sdk.Context, recipient string, coin sdk.Coin) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintDistribution,
+			sdk.NewAttribute(types.AttributeBlockNumber, fmt.Sprintf("%d", ctx.BlockHeight())),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coin.String()),
+		),
+	)
+}
+
+// distributeToRecipient distributes mintedCoin multiplied by proportion to the recipient, which is
+// either a bech32 account address or the name of a module account.
+func (k Keeper) distributeToRecipient(ctx sdk.Context, recipient string, mintedCoin sdk.Coin, proportion sdk.Dec) (sdk.Int, error) {
+	if err := k.validateRecipient(recipient); err != nil {
+		return sdk.Int{}, err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(recipient); err == nil {
+		return k.distributeToAddress(ctx, recipient, mintedCoin, proportion)
+	}
+	return k.distributeToModule(ctx, recipient, mintedCoin, proportion)
 }
 
-// distributeToModule distributes mintedCoin multiplied by proportion to the recepient account.
+// distributeToAddress distributes mintedCoin multiplied by proportion to the recepient account.
 func (k Keeper) distributeToAddress(ctx sdk.Context, recipientAddr string, mintedCoin sdk.Coin, proportion sdk.Dec) (sdk.Int, error) {
 	distributionCoin, err := getProportions(mintedCoin, proportion)
 	if err != nil {
@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func (suite *KeeperTestSuite) TestDistributeMintedCoin() {
+	devFund := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	testCases := []struct {
+		name        string
+		proportions types.DistributionProportions
+		expPass     bool
+	}{
+		{
+			"staking and community pool only",
+			types.DistributionProportions{
+				Staking:          sdk.NewDecWithPrec(2, 1),
+				CommunityPool:    sdk.NewDecWithPrec(8, 1),
+				DeveloperRewards: sdk.ZeroDec(),
+			},
+			true,
+		},
+		{
+			"address and module account recipients",
+			types.DistributionProportions{
+				Staking:          sdk.NewDecWithPrec(2, 1),
+				CommunityPool:    sdk.NewDecWithPrec(5, 1),
+				DeveloperRewards: sdk.ZeroDec(),
+				WeightedRecipients: []types.WeightedRecipient{
+					{Recipient: devFund.String(), Weight: sdk.NewDecWithPrec(2, 1)},
+					{Recipient: distrtypes.ModuleName, Weight: sdk.NewDecWithPrec(1, 1)},
+				},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.app.MintKeeper.GetParams(suite.ctx)
+			params.DistributionProportions = tc.proportions
+			suite.app.MintKeeper.SetParams(suite.ctx, params)
+
+			mintedCoin := sdk.NewCoin(params.MintDenom, sdk.NewInt(1_000_003))
+			err := suite.app.MintKeeper.MintCoins(suite.ctx, sdk.NewCoins(mintedCoin))
+			suite.Require().NoError(err)
+
+			distrAddr := suite.app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+			distrBalanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, distrAddr, params.MintDenom)
+
+			err = suite.app.MintKeeper.DistributeMintedCoin(suite.ctx, mintedCoin)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// nothing is left over in the mint module account
+			mintAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+			suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, mintAddr, params.MintDenom).IsZero())
+
+			feeCollectorAddr := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			stakingAmount := mintedCoin.Amount.ToDec().Mul(tc.proportions.Staking).TruncateInt()
+			suite.Require().Equal(stakingAmount, suite.app.BankKeeper.GetBalance(suite.ctx, feeCollectorAddr, params.MintDenom).Amount)
+
+			// the community pool receives the remainder after all the other allocations
+			distributed := stakingAmount
+			for _, w := range tc.proportions.WeightedRecipients {
+				distributed = distributed.Add(mintedCoin.Amount.ToDec().Mul(w.Weight).TruncateInt())
+			}
+			communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+			suite.Require().Equal(mintedCoin.Amount.Sub(distributed), communityPool.AmountOf(params.MintDenom).TruncateInt())
+
+			if len(tc.proportions.WeightedRecipients) > 0 {
+				devFundAmount := mintedCoin.Amount.ToDec().Mul(sdk.NewDecWithPrec(2, 1)).TruncateInt()
+				suite.Require().Equal(devFundAmount, suite.app.BankKeeper.GetBalance(suite.ctx, devFund, params.MintDenom).Amount)
+
+				// distribution module account holds the community pool plus its own share
+				distrShare := mintedCoin.Amount.ToDec().Mul(sdk.NewDecWithPrec(1, 1)).TruncateInt()
+				distrBalance := suite.app.BankKeeper.GetBalance(suite.ctx, distrAddr, params.MintDenom)
+				suite.Require().Equal(distrBalanceBefore.Amount.Add(distrShare).Add(mintedCoin.Amount.Sub(distributed)), distrBalance.Amount)
+			}
+
+			// one distribution event per recipient, including staking and community pool
+			events := 0
+			for _, event := range suite.ctx.EventManager().Events() {
+				if event.Type == types.EventTypeMintDistribution {
+					events++
+				}
+			}
+			suite.Require().Equal(len(tc.proportions.WeightedRecipients)+2, events)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributeMintedCoinFailedRecipient() {
+	suite.SetupTest()
+
+	blocked := authtypes.NewModuleAddress(stakingtypes.BondedPoolName)
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.DistributionProportions = types.DistributionProportions{
+		Staking:          sdk.NewDecWithPrec(2, 1),
+		CommunityPool:    sdk.NewDecWithPrec(6, 1),
+		DeveloperRewards: sdk.ZeroDec(),
+		WeightedRecipients: []types.WeightedRecipient{
+			{Recipient: "unknown", Weight: sdk.NewDecWithPrec(1, 1)},
+			{Recipient: blocked.String(), Weight: sdk.NewDecWithPrec(1, 1)},
+		},
+	}
+	suite.app.MintKeeper.SetParams(suite.ctx, params)
+
+	mintedCoin := sdk.NewCoin(params.MintDenom, sdk.NewInt(1_000_000))
+	err := suite.app.MintKeeper.MintCoins(suite.ctx, sdk.NewCoins(mintedCoin))
+	suite.Require().NoError(err)
+
+	blockedBalanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, blocked, params.MintDenom)
+
+	err = suite.app.MintKeeper.DistributeMintedCoin(suite.ctx, mintedCoin)
+	suite.Require().NoError(err)
+
+	// the shares of the failed recipients are left to the community pool
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(800_000), communityPool.AmountOf(params.MintDenom).TruncateInt())
+	suite.Require().Equal(blockedBalanceBefore, suite.app.BankKeeper.GetBalance(suite.ctx, blocked, params.MintDenom))
+
+	failed := 0
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMintDistributionFailed {
+			failed++
+		}
+	}
+	suite.Require().Equal(2, failed)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParamsRecipients() {
+	blocked := authtypes.NewModuleAddress(stakingtypes.BondedPoolName)
+	allowed := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	testCases := []struct {
+		name      string
+		recipient string
+		expPass   bool
+	}{
+		{"address", allowed.String(), true},
+		{"module account", distrtypes.ModuleName, true},
+		{"unknown module account", "unknown", false},
+		{"blocked address", blocked.String(), false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.app.MintKeeper.GetParams(suite.ctx)
+			params.DistributionProportions = types.DistributionProportions{
+				Staking:          sdk.NewDecWithPrec(2, 1),
+				CommunityPool:    sdk.NewDecWithPrec(7, 1),
+				DeveloperRewards: sdk.ZeroDec(),
+				WeightedRecipients: []types.WeightedRecipient{
+					{Recipient: tc.recipient, Weight: sdk.NewDecWithPrec(1, 1)},
+				},
+			}
+
+			_, err := suite.app.MintKeeper.UpdateParams(suite.ctx, params, 0)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -23,16 +23,53 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 		LastMintTime:    lastMintTime,
 	}
 	k.SetMinter(ctx, minter)
+	k.SetPreviousDailyProvisions(ctx, minter.DailyProvisions)
 	k.SetParams(ctx, data.Params)
 
 	// The call to GetModuleAccount creates a module account if it does not exist.
 	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	k.SetNextReductionTime(ctx, data.Params.NextRewardsReductionTime)
+
+	for _, change := range data.ScheduledParamsChanges {
+		k.SetScheduledParamsChange(ctx, change)
+	}
+
+	for _, record := range data.MintHistory {
+		k.RecordMint(ctx, record)
+	}
+
+	for _, stream := range data.IncentiveStreams {
+		k.SetIncentiveStream(ctx, stream)
+	}
+
+	// minting stays stopped for good once the max supply was reached
+	if !data.MaxSupplyReached.IsNil() && data.MaxSupplyReached.IsPositive() {
+		k.setMaxSupplyReached(ctx, data.MaxSupplyReached)
+	}
+
+	// the whole supply of the mint denom at genesis was not emitted by the module, as
+	// the minted total restarts from zero
+	k.SetMintSupplyBaseline(ctx, k.bankKeeper.GetSupply(ctx, data.Params.MintDenom).Amount)
+
+	// fund the developer vesting module account only once, its balance is part of the
+	// bank genesis when the chain is restarted from an export
+	if !k.accountKeeper.HasAccount(ctx, k.accountKeeper.GetModuleAddress(types.DeveloperVestingModuleAcctName)) {
+		developerVestingCoin := sdk.NewCoin(data.Params.MintDenom, data.DeveloperVestingAmount)
+		if err := k.CreateDeveloperVestingModuleAccount(ctx, developerVestingCoin); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	params := k.GetParams(ctx)
+	developerVestingBalance := k.GetDeveloperVestingBalance(ctx)
 
-	return types.NewGenesisState(params)
+	genesis := types.NewGenesisState(params, developerVestingBalance.Amount)
+	genesis.ScheduledParamsChanges = k.GetScheduledParamsChanges(ctx)
+	genesis.MintHistory = k.GetMintHistory(ctx)
+	genesis.IncentiveStreams = k.GetIncentiveStreams(ctx)
+	genesis.MaxSupplyReached = k.getInt(ctx, types.MaxSupplyReachedKey)
+	return genesis
 }
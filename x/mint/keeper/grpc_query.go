@@ -3,7 +3,12 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/ArableProtocol/acrechain/x/mint/types"
 )
@@ -35,3 +40,102 @@ func (q Querier) DailyProvisions(c context.Context, _ *types.QueryDailyProvision
 
 	return &types.QueryDailyProvisionsResponse{DailyProvisions: minter.DailyProvisions}, nil
 }
+
+// DeveloperVestingBalance returns the remaining developer vesting balance and its projected depletion time.
+func (q Querier) DeveloperVestingBalance(c context.Context, _ *types.QueryDeveloperVestingBalanceRequest) (*types.QueryDeveloperVestingBalanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDeveloperVestingBalanceResponse{
+		Balance:                q.Keeper.GetDeveloperVestingBalance(ctx),
+		ProjectedDepletionTime: q.Keeper.ProjectDeveloperVestingDepletionTime(ctx),
+	}, nil
+}
+
+// AnnualProvisions returns the annual provisions of the mint module.
+func (q Querier) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisionsRequest) (*types.QueryAnnualProvisionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := q.Keeper.GetMinter(ctx)
+
+	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions()}, nil
+}
+
+// Inflation returns the current effective inflation rate of the mint denom.
+func (q Querier) Inflation(c context.Context, _ *types.QueryInflationRequest) (*types.QueryInflationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryInflationResponse{Inflation: q.Keeper.GetInflation(ctx)}, nil
+}
+
+// NextReductionTime returns the time of the next minting reduction.
+func (q Querier) NextReductionTime(c context.Context, _ *types.QueryNextReductionTimeRequest) (*types.QueryNextReductionTimeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryNextReductionTimeResponse{NextReductionTime: q.Keeper.GetNextReductionTime(ctx)}, nil
+}
+
+// ProjectedSchedule returns the expected emission of the upcoming reduction periods.
+func (q Querier) ProjectedSchedule(c context.Context, req *types.QueryProjectedScheduleRequest) (*types.QueryProjectedScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Periods == 0 || req.Periods > types.MaxProjectedPeriods {
+		return nil, status.Errorf(codes.InvalidArgument, "periods must be between 1 and %d", types.MaxProjectedPeriods)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryProjectedScheduleResponse{Schedule: q.Keeper.ProjectSchedule(ctx, req.Periods)}, nil
+}
+
+// RemainingMintable returns the amount that can still be minted before the max supply is reached.
+func (q Querier) RemainingMintable(c context.Context, _ *types.QueryRemainingMintableRequest) (*types.QueryRemainingMintableResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.Keeper.GetParams(ctx)
+	remaining, _ := q.Keeper.GetRemainingMintable(ctx)
+
+	return &types.QueryRemainingMintableResponse{MaxSupply: params.MaxSupply, RemainingMintable: remaining}, nil
+}
+
+// ScheduledParamsChanges returns the pending parameter changes, ordered by activation time.
+func (q Querier) ScheduledParamsChanges(c context.Context, _ *types.QueryScheduledParamsChangesRequest) (*types.QueryScheduledParamsChangesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryScheduledParamsChangesResponse{Changes: q.Keeper.GetScheduledParamsChanges(ctx)}, nil
+}
+
+// MintHistory returns the recorded mints, oldest first unless the pagination is reversed.
+func (q Querier) MintHistory(c context.Context, req *types.QueryMintHistoryRequest) (*types.QueryMintHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var records []types.MintRecord
+	store := prefix.NewStore(ctx.KVStore(q.Keeper.storeKey), types.MintHistoryKeyPrefix)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var record types.MintRecord
+		if err := q.Keeper.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMintHistoryResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}, nil
+}
+
+// IncentiveStreams returns the registered incentive streams.
+func (q Querier) IncentiveStreams(c context.Context, _ *types.QueryIncentiveStreamsRequest) (*types.QueryIncentiveStreamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryIncentiveStreamsResponse{Streams: q.Keeper.GetIncentiveStreams(ctx)}, nil
+}
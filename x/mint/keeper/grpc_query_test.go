@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ArableProtocol/acrechain/x/mint/keeper"
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestAnnualProvisionsAndInflation() {
+	suite.SetupTest()
+	querier := keeper.NewQuerier(suite.app.MintKeeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	suite.app.MintKeeper.SetMinter(suite.ctx, types.NewMinter(sdk.NewDec(1_000), 0))
+
+	res, err := querier.AnnualProvisions(ctx, &types.QueryAnnualProvisionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(365_000), res.AnnualProvisions)
+
+	// no supply yet
+	inflation, err := querier.Inflation(ctx, &types.QueryInflationRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.ZeroDec(), inflation.Inflation)
+
+	err = suite.app.MintKeeper.MintCoins(suite.ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdk.NewInt(3_650_000))))
+	suite.Require().NoError(err)
+
+	inflation, err = querier.Inflation(ctx, &types.QueryInflationRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(1, 1), inflation.Inflation)
+}
+
+func (suite *KeeperTestSuite) TestProjectedSchedule() {
+	suite.SetupTest()
+
+	now := time.Unix(1_700_000_000, 0)
+	suite.ctx = suite.ctx.WithBlockTime(now)
+	querier := keeper.NewQuerier(suite.app.MintKeeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.ReductionPeriodInSeconds = 86400 * 10
+	params.ReductionFactor = sdk.NewDecWithPrec(5, 1)
+	params.NextRewardsReductionTime = now.Unix() + 86400*2
+	suite.app.MintKeeper.SetParams(suite.ctx, params)
+	suite.app.MintKeeper.SetMinter(suite.ctx, types.NewMinter(sdk.NewDec(1_000), now.Unix()))
+
+	nextReduction, err := querier.NextReductionTime(ctx, &types.QueryNextReductionTimeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.NextRewardsReductionTime, nextReduction.NextReductionTime)
+
+	_, err = querier.ProjectedSchedule(ctx, &types.QueryProjectedScheduleRequest{})
+	suite.Require().Error(err)
+	_, err = querier.ProjectedSchedule(ctx, &types.QueryProjectedScheduleRequest{Periods: types.MaxProjectedPeriods + 1})
+	suite.Require().Error(err)
+
+	res, err := querier.ProjectedSchedule(ctx, &types.QueryProjectedScheduleRequest{Periods: 3})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ProjectedPeriod{
+		{
+			StartTime:       now.Unix(),
+			EndTime:         now.Unix() + 86400*2,
+			DailyProvisions: sdk.NewDec(1_000),
+			Emission:        sdk.NewDec(2_000),
+		},
+		{
+			StartTime:       now.Unix() + 86400*2,
+			EndTime:         now.Unix() + 86400*12,
+			DailyProvisions: sdk.NewDec(500),
+			Emission:        sdk.NewDec(5_000),
+		},
+		{
+			StartTime:       now.Unix() + 86400*12,
+			EndTime:         now.Unix() + 86400*22,
+			DailyProvisions: sdk.NewDec(250),
+			Emission:        sdk.NewDec(2_500),
+		},
+	}, res.Schedule)
+}
+
+func (suite *KeeperTestSuite) TestRemainingMintable() {
+	suite.SetupTest()
+
+	now := time.Unix(1_700_000_000, 0)
+	suite.ctx = suite.ctx.WithBlockTime(now)
+	querier := keeper.NewQuerier(suite.app.MintKeeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.ReductionPeriodInSeconds = 86400 * 10
+	params.ReductionFactor = sdk.NewDecWithPrec(5, 1)
+	params.NextRewardsReductionTime = now.Unix() + 86400*2
+	suite.app.MintKeeper.SetParams(suite.ctx, params)
+	suite.app.MintKeeper.SetMinter(suite.ctx, types.NewMinter(sdk.NewDec(1_000), now.Unix()))
+
+	// no cap
+	res, err := querier.RemainingMintable(ctx, &types.QueryRemainingMintableRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.ZeroInt(), res.MaxSupply)
+	suite.Require().Equal(sdk.ZeroInt(), res.RemainingMintable)
+
+	params.MaxSupply = sdk.NewInt(10_000)
+	suite.app.MintKeeper.SetParams(suite.ctx, params)
+	err = suite.app.MintKeeper.MintCoins(suite.ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdk.NewInt(3_000))))
+	suite.Require().NoError(err)
+
+	res, err = querier.RemainingMintable(ctx, &types.QueryRemainingMintableRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(10_000), res.MaxSupply)
+	suite.Require().Equal(sdk.NewInt(7_000), res.RemainingMintable)
+
+	// the projected emission stops at the max supply
+	schedule, err := querier.ProjectedSchedule(ctx, &types.QueryProjectedScheduleRequest{Periods: 3})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(2_000), schedule.Schedule[0].Emission)
+	suite.Require().Equal(sdk.NewDec(5_000), schedule.Schedule[1].Emission)
+	suite.Require().Equal(sdk.ZeroDec(), schedule.Schedule[2].Emission)
+	suite.Require().Equal(sdk.ZeroDec(), schedule.Schedule[2].DailyProvisions)
+
+	err = suite.app.MintKeeper.MintCoins(suite.ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdk.NewInt(8_000))))
+	suite.Require().NoError(err)
+
+	res, err = querier.RemainingMintable(ctx, &types.QueryRemainingMintableRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.ZeroInt(), res.RemainingMintable)
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+// RecordMint appends a mint record to the mint history and prunes the records beyond
+// the retention. Nothing is recorded when the retention is zero.
+func (k Keeper) RecordMint(ctx sdk.Context, record types.MintRecord) {
+	retention := k.GetParams(ctx).MintHistoryRetention
+	first, next := k.getMintHistoryBounds(ctx)
+
+	if retention > 0 {
+		store := ctx.KVStore(k.storeKey)
+		store.Set(types.MintHistoryKey(next), k.cdc.MustMarshal(&record))
+		next++
+	}
+
+	first = k.pruneMintHistory(ctx, first, next, retention)
+	k.setMintHistoryBounds(ctx, first, next)
+}
+
+// GetMintHistory returns the recorded mints, oldest first.
+func (k Keeper) GetMintHistory(ctx sdk.Context) []types.MintRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintHistoryKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	records := []types.MintRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.MintRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// pruneMintHistory deletes the oldest records until at most retention remain, up to
+// MaxMintHistoryPrunedPerBlock of them, and returns the sequence of the oldest one kept.
+func (k Keeper) pruneMintHistory(ctx sdk.Context, first, next, retention uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	for pruned := 0; next-first > retention && pruned < types.MaxMintHistoryPrunedPerBlock; pruned++ {
+		store.Delete(types.MintHistoryKey(first))
+		first++
+	}
+
+	return first
+}
+
+// getMintHistoryBounds returns the sequences of the oldest and of the next mint record.
+func (k Keeper) getMintHistoryBounds(ctx sdk.Context) (first, next uint64) {
+	b := ctx.KVStore(k.storeKey).Get(types.MintHistoryBoundsKey)
+	if b == nil {
+		return 0, 0
+	}
+
+	return binary.BigEndian.Uint64(b[:8]), binary.BigEndian.Uint64(b[8:])
+}
+
+func (k Keeper) setMintHistoryBounds(ctx sdk.Context, first, next uint64) {
+	b := make([]byte, 16)
+	binary.BigEndian.PutUint64(b[:8], first)
+	binary.BigEndian.PutUint64(b[8:], next)
+	ctx.KVStore(k.storeKey).Set(types.MintHistoryBoundsKey, b)
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/ArableProtocol/acrechain/x/mint/keeper"
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestMintHistory() {
+	suite.SetupTest()
+
+	now := time.Unix(1_700_000_000, 0)
+	params := types.DefaultParams()
+	params.MintDenom = "aacre"
+	params.GenesisDailyProvisions = sdk.NewDec(86_400)
+	params.NextRewardsReductionTime = now.Add(time.Hour * 24 * 365).Unix()
+	params.MintingRewardsDistributionStartTime = now.Unix()
+	params.MintHistoryRetention = 3
+	suite.app.MintKeeper.SetParams(suite.ctx, params)
+	suite.app.MintKeeper.SetMinter(suite.ctx, types.NewMinter(params.GenesisDailyProvisions, 0))
+
+	for i := int64(0); i <= 5; i++ {
+		suite.ctx = suite.ctx.WithBlockHeight(i + 1).WithBlockTime(now.Add(time.Duration(i*10) * time.Second))
+		suite.app.MintKeeper.BeginBlocker(suite.ctx)
+	}
+
+	// the first block only sets the last mint time, the oldest mints are pruned
+	history := suite.app.MintKeeper.GetMintHistory(suite.ctx)
+	suite.Require().Len(history, 3)
+	for i, record := range history {
+		suite.Require().Equal(int64(i+4), record.Height)
+		suite.Require().Equal(now.Unix()+int64(i+3)*10, record.Time)
+		suite.Require().Equal(sdk.NewInt(10), record.Amount)
+		suite.Require().Equal(sdk.NewInt(2), record.Staking)
+		suite.Require().Equal(sdk.NewInt(8), record.CommunityPool)
+	}
+
+	querier := keeper.NewQuerier(suite.app.MintKeeper)
+	_, err := querier.MintHistory(sdk.WrapSDKContext(suite.ctx), nil)
+	suite.Require().Error(err)
+
+	res, err := querier.MintHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryMintHistoryRequest{
+		Pagination: &query.PageRequest{Limit: 2, Reverse: true, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.MintRecord{history[2], history[1]}, res.Records)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	res, err = querier.MintHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryMintHistoryRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Reverse: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.MintRecord{history[0]}, res.Records)
+
+	// the history is exported and imported with the genesis state
+	genesis := suite.app.MintKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(types.ValidateGenesis(*genesis))
+	suite.Require().Equal(history, genesis.MintHistory)
+
+	// a zero retention stops recording and prunes the history
+	params.MintHistoryRetention = 0
+	suite.app.MintKeeper.SetParams(suite.ctx, params)
+	suite.ctx = suite.ctx.WithBlockHeight(7).WithBlockTime(now.Add(60 * time.Second))
+	suite.app.MintKeeper.BeginBlocker(suite.ctx)
+	suite.Require().Empty(suite.app.MintKeeper.GetMintHistory(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestMintHistoryPruningIsBounded() {
+	suite.SetupTest()
+
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	record := types.MintRecord{Amount: sdk.NewInt(10), Staking: sdk.NewInt(2), CommunityPool: sdk.NewInt(8)}
+	for i := 0; i < types.MaxMintHistoryPrunedPerBlock+10; i++ {
+		suite.app.MintKeeper.RecordMint(suite.ctx, record)
+	}
+	suite.Require().Len(suite.app.MintKeeper.GetMintHistory(suite.ctx), types.MaxMintHistoryPrunedPerBlock+10)
+
+	// lowering the retention prunes the excess over several blocks
+	params.MintHistoryRetention = 5
+	suite.app.MintKeeper.SetParams(suite.ctx, params)
+	suite.app.MintKeeper.RecordMint(suite.ctx, record)
+	suite.Require().Len(suite.app.MintKeeper.GetMintHistory(suite.ctx), 11)
+
+	suite.app.MintKeeper.RecordMint(suite.ctx, record)
+	suite.Require().Len(suite.app.MintKeeper.GetMintHistory(suite.ctx), 5)
+}
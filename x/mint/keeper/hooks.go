@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+var _ types.MintHooks = FeeBurnHooks{}
+
+// FeeBurnHooks burns the fee burn fraction of the mint denom fees collected in the
+// fee collector after each mint, offsetting the emission with the fees paid.
+type FeeBurnHooks struct {
+	k Keeper
+}
+
+// FeeBurnHooks returns the mint hooks burning the collected fees.
+func (k Keeper) FeeBurnHooks() FeeBurnHooks {
+	return FeeBurnHooks{k}
+}
+
+// AfterDistributeMintedCoin burns the fee burn fraction of the fees in the fee
+// collector. The minted coins distributed to the fee collector are not fees and are
+// left untouched.
+func (h FeeBurnHooks) AfterDistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin, distributed []types.RecipientAmount) {
+	params := h.k.GetParams(ctx)
+	if !params.FeeBurnFraction.IsPositive() {
+		return
+	}
+
+	feeCollector := h.k.accountKeeper.GetModuleAddress(h.k.feeCollectorName)
+	fees := h.k.bankKeeper.GetBalance(ctx, feeCollector, mintedCoin.Denom).Amount
+	for _, d := range distributed {
+		if d.Recipient == h.k.feeCollectorName && d.Amount.Denom == mintedCoin.Denom {
+			fees = fees.Sub(d.Amount.Amount)
+		}
+	}
+
+	burnCoin := sdk.NewCoin(mintedCoin.Denom, fees.ToDec().Mul(params.FeeBurnFraction).TruncateInt())
+	if !burnCoin.IsPositive() {
+		return
+	}
+
+	if err := h.k.bankKeeper.SendCoinsFromModuleToModule(ctx, h.k.feeCollectorName, types.ModuleName, sdk.NewCoins(burnCoin)); err != nil {
+		panic(err)
+	}
+	if err := h.k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(burnCoin)); err != nil {
+		panic(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeBurn,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, burnCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyMinted, mintedCoin.String()),
+		),
+	)
+}
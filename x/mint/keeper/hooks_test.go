@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/ArableProtocol/acrechain/x/mint/keeper"
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestFeeBurnHooks() {
+	suite.SetupTest()
+
+	now := time.Unix(1_700_000_000, 0)
+	params := types.DefaultParams()
+	params.MintDenom = "aacre"
+	params.GenesisDailyProvisions = sdk.NewDec(86_400_000)
+	params.NextRewardsReductionTime = now.Add(time.Hour * 24 * 365).Unix()
+	params.MintingRewardsDistributionStartTime = now.Unix()
+	params.FeeBurnFraction = sdk.NewDecWithPrec(5, 1)
+	suite.app.MintKeeper.SetParams(suite.ctx, params)
+	suite.app.MintKeeper.SetMinter(suite.ctx, types.NewMinter(params.GenesisDailyProvisions, 0))
+
+	suite.ctx = suite.ctx.WithBlockTime(now)
+	suite.app.MintKeeper.BeginBlocker(suite.ctx)
+
+	// collect fees in the fee collector
+	fees := sdk.NewCoins(sdk.NewCoin("aacre", sdk.NewInt(4_000)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, fees))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, types.ModuleName, authtypes.FeeCollectorName, fees))
+	// the fees are paid out of the supply that existed before minting
+	suite.app.MintKeeper.SetMintSupplyBaseline(suite.ctx, fees.AmountOf("aacre"))
+
+	suite.ctx = suite.ctx.WithBlockTime(now.Add(10 * time.Second)).WithEventManager(sdk.NewEventManager())
+	suite.app.MintKeeper.BeginBlocker(suite.ctx)
+
+	// half of the fees are burned, the staking share of the mint is kept
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	suite.Require().Equal(sdk.NewInt(2_500+2_000), suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, "aacre").Amount)
+	suite.Require().Equal(sdk.NewInt(4_000+10_000-2_000), suite.app.BankKeeper.GetSupply(suite.ctx, "aacre").Amount)
+
+	burned := false
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeFeeBurn {
+			burned = true
+		}
+	}
+	suite.Require().True(burned)
+
+	// the burn does not count against the minted total
+	suite.Require().Equal(sdk.NewInt(10_000), suite.app.MintKeeper.GetMintedTotal(suite.ctx))
+	msg, broken := keeper.AllInvariants(suite.app.MintKeeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+}
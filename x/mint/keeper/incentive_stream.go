@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+// RegisterIncentiveStream registers a stream paying the given share of each mint to the
+// recipient between the start and end times. The shares of the streams active at the
+// same time cannot exceed the community pool share they are taken from.
+func (k Keeper) RegisterIncentiveStream(ctx sdk.Context, recipient string, share sdk.Dec, startTime, endTime int64) (uint64, error) {
+	stream := types.NewIncentiveStream(0, recipient, share, startTime, endTime)
+	if err := stream.Validate(); err != nil {
+		return 0, err
+	}
+
+	if blockTime := ctx.BlockTime().Unix(); endTime <= blockTime {
+		return 0, incentiveStreamEndedError{endTime, blockTime}
+	}
+
+	if err := k.validateRecipient(recipient); err != nil {
+		return 0, err
+	}
+
+	totalShare := share
+	for _, other := range k.GetIncentiveStreams(ctx) {
+		if stream.Overlaps(other) {
+			totalShare = totalShare.Add(other.Share)
+		}
+	}
+	if limit := k.GetParams(ctx).DistributionProportions.CommunityPool; totalShare.GT(limit) {
+		return 0, incentiveStreamSharesError{totalShare, limit}
+	}
+
+	stream.Id = k.getNextIncentiveStreamID(ctx)
+	k.SetIncentiveStream(ctx, stream)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterIncentiveStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.Id)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(types.AttributeKeyShare, share.String()),
+		),
+	)
+
+	return stream.Id, nil
+}
+
+// CancelIncentiveStream removes an incentive stream before its end time.
+func (k Keeper) CancelIncentiveStream(ctx sdk.Context, id uint64) error {
+	stream, found := k.GetIncentiveStream(ctx, id)
+	if !found {
+		return unknownIncentiveStreamError{id}
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.IncentiveStreamKey(id))
+	k.emitIncentiveStreamEvent(ctx, types.EventTypeCancelIncentiveStream, stream)
+	return nil
+}
+
+// GetIncentiveStream returns the incentive stream with the given identifier.
+func (k Keeper) GetIncentiveStream(ctx sdk.Context, id uint64) (types.IncentiveStream, bool) {
+	b := ctx.KVStore(k.storeKey).Get(types.IncentiveStreamKey(id))
+	if b == nil {
+		return types.IncentiveStream{}, false
+	}
+
+	var stream types.IncentiveStream
+	k.cdc.MustUnmarshal(b, &stream)
+	return stream, true
+}
+
+// GetIncentiveStreams returns the registered incentive streams, ordered by identifier.
+func (k Keeper) GetIncentiveStreams(ctx sdk.Context) []types.IncentiveStream {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.IncentiveStreamKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	streams := []types.IncentiveStream{}
+	for ; iterator.Valid(); iterator.Next() {
+		var stream types.IncentiveStream
+		k.cdc.MustUnmarshal(iterator.Value(), &stream)
+		streams = append(streams, stream)
+	}
+
+	return streams
+}
+
+// SetIncentiveStream stores an incentive stream.
+func (k Keeper) SetIncentiveStream(ctx sdk.Context, stream types.IncentiveStream) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.IncentiveStreamKey(stream.Id), k.cdc.MustMarshal(&stream))
+
+	if stream.Id >= k.getNextIncentiveStreamID(ctx) {
+		store.Set(types.NextIncentiveStreamIDKey, sdk.Uint64ToBigEndian(stream.Id+1))
+	}
+}
+
+// distributeIncentiveStreams pays the share of mintedCoin of each active stream, out of at
+// most the available amount, and removes the streams that have ended. A payout that fails
+// is left to the community pool. It returns the amount paid out and the amount received by
+// each recipient.
+func (k Keeper) distributeIncentiveStreams(ctx sdk.Context, mintedCoin sdk.Coin, available sdk.Int) (sdk.Int, []types.RecipientAmount, error) {
+	blockTime := ctx.BlockTime().Unix()
+	distributedAmount := sdk.ZeroInt()
+	var distributed []types.RecipientAmount
+
+	for _, stream := range k.GetIncentiveStreams(ctx) {
+		if stream.EndTime <= blockTime {
+			ctx.KVStore(k.storeKey).Delete(types.IncentiveStreamKey(stream.Id))
+			k.emitIncentiveStreamEvent(ctx, types.EventTypeIncentiveStreamEnded, stream)
+			continue
+		}
+		if !stream.IsActive(blockTime) {
+			continue
+		}
+
+		streamCoin, err := getProportions(mintedCoin, stream.Share)
+		if err != nil {
+			return sdk.Int{}, nil, err
+		}
+		// the community pool share may have been lowered below the stream shares
+		if remaining := available.Sub(distributedAmount); streamCoin.Amount.GT(remaining) {
+			streamCoin.Amount = remaining
+		}
+		if streamCoin.IsZero() {
+			continue
+		}
+
+		// a payout that fails is left to the community pool and the stream goes on
+		if !k.trySendToRecipient(ctx, types.ModuleName, stream.Recipient, streamCoin) {
+			continue
+		}
+		k.emitDistributionEvent(ctx, stream.Recipient, streamCoin)
+		distributed = append(distributed, types.RecipientAmount{Recipient: stream.Recipient, Amount: streamCoin})
+		distributedAmount = distributedAmount.Add(streamCoin.Amount)
+
+		stream.Distributed = stream.Distributed.Add(streamCoin.Amount)
+		k.SetIncentiveStream(ctx, stream)
+	}
+
+	return distributedAmount, distributed, nil
+}
+
+// emitIncentiveStreamEvent emits an event of the given type for the stream.
+func (k Keeper) emitIncentiveStreamEvent(ctx sdk.Context, eventType string, stream types.IncentiveStream) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.Id)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient),
+			sdk.NewAttribute(types.AttributeKeyDistributed, stream.Distributed.String()),
+		),
+	)
+}
+
+func (k Keeper) getNextIncentiveStreamID(ctx sdk.Context) uint64 {
+	b := ctx.KVStore(k.storeKey).Get(types.NextIncentiveStreamIDKey)
+	if b == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(b)
+}
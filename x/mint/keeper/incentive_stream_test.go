@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ArableProtocol/acrechain/x/mint/keeper"
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterIncentiveStream() {
+	suite.SetupTest()
+
+	now := time.Unix(1_700_000_000, 0)
+	suite.ctx = suite.ctx.WithBlockTime(now)
+	start, end := now.Unix(), now.Unix()+100
+	recipient := sdk.AccAddress([]byte("incentive_stream____")).String()
+
+	testCases := []struct {
+		name      string
+		recipient string
+		share     sdk.Dec
+		start     int64
+		end       int64
+		expPass   bool
+	}{
+		{"zero share", recipient, sdk.ZeroDec(), start, end, false},
+		{"end before start", recipient, sdk.NewDecWithPrec(1, 1), end, start, false},
+		{"already ended", recipient, sdk.NewDecWithPrec(1, 1), start - 100, start, false},
+		{"unknown module", "unknown", sdk.NewDecWithPrec(1, 1), start, end, false},
+		{"invalid address", "acre1invalid", sdk.NewDecWithPrec(1, 1), start, end, false},
+		{"blocked address", authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(), sdk.NewDecWithPrec(1, 1), start, end, false},
+		{"address recipient", recipient, sdk.NewDecWithPrec(5, 1), start, end, true},
+		{"module recipient", types.DeveloperVestingModuleAcctName, sdk.NewDecWithPrec(25, 2), start + 50, end + 50, true},
+		{"overlapping shares above the community pool share", recipient, sdk.NewDecWithPrec(1, 2), start + 60, end, false},
+		{"after the overlapping streams", recipient, sdk.NewDecWithPrec(75, 2), end + 50, end + 100, true},
+	}
+
+	var expStreams []types.IncentiveStream
+	for _, tc := range testCases {
+		id, err := suite.app.MintKeeper.RegisterIncentiveStream(suite.ctx, tc.recipient, tc.share, tc.start, tc.end)
+		if !tc.expPass {
+			suite.Require().Error(err, tc.name)
+			continue
+		}
+		suite.Require().NoError(err, tc.name)
+		suite.Require().Equal(uint64(len(expStreams)+1), id, tc.name)
+		expStreams = append(expStreams, types.NewIncentiveStream(id, tc.recipient, tc.share, tc.start, tc.end))
+	}
+	suite.Require().Equal(expStreams, suite.app.MintKeeper.GetIncentiveStreams(suite.ctx))
+
+	querier := keeper.NewQuerier(suite.app.MintKeeper)
+	res, err := querier.IncentiveStreams(sdk.WrapSDKContext(suite.ctx), &types.QueryIncentiveStreamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expStreams, res.Streams)
+
+	// the streams are exported and imported with the genesis state
+	genesis := suite.app.MintKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(types.ValidateGenesis(*genesis))
+	suite.Require().Equal(expStreams, genesis.IncentiveStreams)
+
+	handler := suite.app.GovKeeper.Router().GetRoute(types.RouterKey)
+	suite.Require().Error(handler(suite.ctx, types.NewCancelIncentiveStreamProposal("title", "description", 10)))
+	suite.Require().NoError(handler(suite.ctx, types.NewCancelIncentiveStreamProposal("title", "description", 1)))
+	_, found := suite.app.MintKeeper.GetIncentiveStream(suite.ctx, 1)
+	suite.Require().False(found)
+
+	// the cancelled stream's share is available again
+	suite.Require().NoError(handler(suite.ctx, types.NewRegisterIncentiveStreamProposal("title", "description", recipient, sdk.NewDecWithPrec(5, 1), start, end)))
+	_, found = suite.app.MintKeeper.GetIncentiveStream(suite.ctx, 4)
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestDistributeIncentiveStreams() {
+	suite.SetupTest()
+
+	now := time.Unix(1_700_000_000, 0)
+	params := types.DefaultParams()
+	params.MintDenom = "aacre"
+	params.GenesisDailyProvisions = sdk.NewDec(864_000_000)
+	params.NextRewardsReductionTime = now.Add(time.Hour * 24 * 365).Unix()
+	params.MintingRewardsDistributionStartTime = now.Unix()
+	suite.app.MintKeeper.SetParams(suite.ctx, params)
+	suite.app.MintKeeper.SetMinter(suite.ctx, types.NewMinter(params.GenesisDailyProvisions, 0))
+
+	suite.ctx = suite.ctx.WithBlockHeight(1).WithBlockTime(now)
+	recipient := sdk.AccAddress([]byte("incentive_stream____"))
+	id, err := suite.app.MintKeeper.RegisterIncentiveStream(suite.ctx, recipient.String(), sdk.NewDecWithPrec(1, 1), now.Unix()+10, now.Unix()+30)
+	suite.Require().NoError(err)
+
+	blocks := []struct {
+		expRecipient     int64
+		expCommunityPool int64
+		expStreamFound   bool
+		expDistributed   int64
+	}{
+		// the first block only sets the last mint time
+		{0, 0, true, 0},
+		// the stream is active from its start time
+		{10_000, 65_000, true, 10_000},
+		{20_000, 65_000, true, 20_000},
+		// the stream is removed once it has ended
+		{20_000, 75_000, false, 0},
+	}
+
+	for i, block := range blocks {
+		suite.ctx = suite.ctx.WithBlockHeight(int64(i + 1)).WithBlockTime(now.Add(time.Duration(i*10) * time.Second))
+		suite.app.MintKeeper.BeginBlocker(suite.ctx)
+
+		suite.Require().Equal(sdk.NewInt(block.expRecipient), suite.app.BankKeeper.GetBalance(suite.ctx, recipient, params.MintDenom).Amount, "block %d", i)
+		if history := suite.app.MintKeeper.GetMintHistory(suite.ctx); i > 0 {
+			record := history[len(history)-1]
+			suite.Require().Equal(sdk.NewInt(100_000), record.Amount, "block %d", i)
+			suite.Require().Equal(sdk.NewInt(block.expCommunityPool), record.CommunityPool, "block %d", i)
+		}
+
+		stream, found := suite.app.MintKeeper.GetIncentiveStream(suite.ctx, id)
+		suite.Require().Equal(block.expStreamFound, found, "block %d", i)
+		if found {
+			suite.Require().Equal(sdk.NewInt(block.expDistributed), stream.Distributed, "block %d", i)
+		}
+	}
+	suite.Require().Empty(suite.app.MintKeeper.GetIncentiveStreams(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestDistributeIncentiveStreamFailedPayout() {
+	suite.SetupTest()
+
+	now := time.Unix(1_700_000_000, 0)
+	params := types.DefaultParams()
+	params.MintDenom = "aacre"
+	params.GenesisDailyProvisions = sdk.NewDec(864_000_000)
+	params.NextRewardsReductionTime = now.Add(time.Hour * 24 * 365).Unix()
+	params.MintingRewardsDistributionStartTime = now.Unix()
+	suite.app.MintKeeper.SetParams(suite.ctx, params)
+	suite.app.MintKeeper.SetMinter(suite.ctx, types.NewMinter(params.GenesisDailyProvisions, now.Unix()))
+
+	// a stream paying a blocked address, as could have been imported with the genesis state
+	blocked := authtypes.NewModuleAddress(stakingtypes.BondedPoolName)
+	stream := types.NewIncentiveStream(1, blocked.String(), sdk.NewDecWithPrec(1, 1), now.Unix(), now.Unix()+100)
+	suite.app.MintKeeper.SetIncentiveStream(suite.ctx, stream)
+	bondedBefore := suite.app.BankKeeper.GetBalance(suite.ctx, blocked, params.MintDenom)
+
+	suite.ctx = suite.ctx.WithBlockHeight(2).WithBlockTime(now.Add(10 * time.Second))
+	suite.Require().NotPanics(func() { suite.app.MintKeeper.BeginBlocker(suite.ctx) })
+
+	// the share of the stream is left to the community pool
+	suite.Require().Equal(bondedBefore, suite.app.BankKeeper.GetBalance(suite.ctx, blocked, params.MintDenom))
+	history := suite.app.MintKeeper.GetMintHistory(suite.ctx)
+	suite.Require().Len(history, 1)
+	suite.Require().Equal(sdk.NewInt(75_000), history[0].CommunityPool)
+
+	stream, found := suite.app.MintKeeper.GetIncentiveStream(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().True(stream.Distributed.IsZero())
+}
@@ -18,6 +18,111 @@ func (k Keeper) SetNextReductionTime(ctx sdk.Context, time int64) {
 	k.SetParams(ctx, params)
 }
 
+// GetInflation returns the annual provisions relative to the total supply of the mint denom.
+func (k Keeper) GetInflation(ctx sdk.Context) sdk.Dec {
+	params := k.GetParams(ctx)
+	supply := k.bankKeeper.GetSupply(ctx, params.MintDenom)
+	if supply.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	minter := k.GetMinter(ctx)
+	return minter.AnnualProvisions().QuoInt(supply.Amount)
+}
+
+// GetRemainingMintable returns the amount of the mint denom that can still be minted before
+// the total supply reaches the max supply, and whether the supply is capped at all. Nothing
+// remains once the max supply has been reached, even if burns bring the supply back under
+// it, until governance sets a different max supply.
+func (k Keeper) GetRemainingMintable(ctx sdk.Context) (sdk.Int, bool) {
+	params := k.GetParams(ctx)
+	if !params.MaxSupply.IsPositive() {
+		return sdk.ZeroInt(), false
+	}
+
+	if k.IsMaxSupplyReached(ctx, params.MaxSupply) {
+		return sdk.ZeroInt(), true
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, params.MintDenom)
+	if supply.Amount.GTE(params.MaxSupply) {
+		return sdk.ZeroInt(), true
+	}
+
+	return params.MaxSupply.Sub(supply.Amount), true
+}
+
+// ProjectSchedule walks the emission schedule forward and returns the expected emission of
+// the given number of reduction periods, starting with the current one. Curves that change
+// the daily provisions within a period are projected with the provisions at its start, in
+// the current state of the chain. The emission is clamped to the max supply.
+func (k Keeper) ProjectSchedule(ctx sdk.Context, periods uint32) []types.ProjectedPeriod {
+	params := k.GetParams(ctx)
+	minter := k.GetMinter(ctx)
+	schedule := params.EmissionSchedule()
+	dailyProvisions := minter.DailyProvisions
+
+	startTime := ctx.BlockTime().Unix()
+	if startTime < params.MintingRewardsDistributionStartTime {
+		startTime = params.MintingRewardsDistributionStartTime
+	}
+
+	// reductions that are due are applied by the next block, the ones due before the
+	// last mint at the start of its window
+	windowStart := minter.LastMintTime
+	if windowStart == 0 {
+		windowStart = startTime
+	}
+	endTime := params.NextRewardsReductionTime
+	for endTime <= startTime {
+		if endTime < windowStart {
+			endTime = windowStart
+		}
+		dailyProvisions = schedule.ReducedProvisions(dailyProvisions)
+		endTime += params.ReductionPeriodInSeconds
+	}
+
+	remaining, capped := k.GetRemainingMintable(ctx)
+	state := k.emissionState(ctx, minter, params)
+	projection := make([]types.ProjectedPeriod, 0, periods)
+	for i := uint32(0); i < periods; i++ {
+		state.BlockTime, state.LastMintTime = startTime, startTime
+		dailyProvisions = schedule.CurrentProvisions(dailyProvisions, state)
+
+		emission := dailyProvisions.MulInt64(endTime - startTime).QuoInt64(86400)
+		if capped {
+			if emission.GT(remaining.ToDec()) {
+				emission = remaining.ToDec()
+				dailyProvisions = sdk.ZeroDec()
+			}
+			remaining = remaining.Sub(emission.TruncateInt())
+		}
+
+		projection = append(projection, types.ProjectedPeriod{
+			StartTime:       startTime,
+			EndTime:         endTime,
+			DailyProvisions: dailyProvisions,
+			Emission:        emission,
+		})
+
+		dailyProvisions = schedule.ReducedProvisions(dailyProvisions)
+		startTime = endTime
+		endTime = startTime + params.ReductionPeriodInSeconds
+	}
+
+	return projection
+}
+
+// emissionState returns the state of the chain the emission schedule depends on.
+func (k Keeper) emissionState(ctx sdk.Context, minter types.Minter, params types.Params) types.EmissionState {
+	return types.EmissionState{
+		BlockTime:    ctx.BlockTime().Unix(),
+		LastMintTime: minter.LastMintTime,
+		BondedRatio:  k.stakingKeeper.BondedRatio(ctx),
+		TotalSupply:  k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount,
+	}
+}
+
 // get the minter.
 func (k Keeper) GetMinter(ctx sdk.Context) (minter types.Minter) {
 	store := ctx.KVStore(k.storeKey)
@@ -36,3 +141,73 @@ func (k Keeper) SetMinter(ctx sdk.Context, minter types.Minter) {
 	b := k.cdc.MustMarshal(&minter)
 	store.Set(types.MinterKey, b)
 }
+
+// GetPreviousDailyProvisions returns the daily provisions before the last mint.
+func (k Keeper) GetPreviousDailyProvisions(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.PreviousDailyProvisionsKey)
+	if b == nil {
+		return k.GetMinter(ctx).DailyProvisions
+	}
+
+	var dp sdk.DecProto
+	k.cdc.MustUnmarshal(b, &dp)
+	return dp.Dec
+}
+
+// SetPreviousDailyProvisions sets the daily provisions before the last mint.
+func (k Keeper) SetPreviousDailyProvisions(ctx sdk.Context, dailyProvisions sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&sdk.DecProto{Dec: dailyProvisions})
+	store.Set(types.PreviousDailyProvisionsKey, b)
+}
+
+// GetMintedTotal returns the cumulative amount of the mint denom emitted by the module,
+// net of the minted coins it burned.
+func (k Keeper) GetMintedTotal(ctx sdk.Context) sdk.Int {
+	return k.getInt(ctx, types.MintedTotalKey)
+}
+
+// GetMintSupplyBaseline returns the supply of the mint denom that was not emitted by the
+// module, recorded at genesis or on migration and raised by the developer vesting funding.
+func (k Keeper) GetMintSupplyBaseline(ctx sdk.Context) sdk.Int {
+	return k.getInt(ctx, types.MintSupplyBaselineKey)
+}
+
+// SetMintSupplyBaseline sets the supply of the mint denom that was not emitted by the module.
+func (k Keeper) SetMintSupplyBaseline(ctx sdk.Context, baseline sdk.Int) {
+	k.setInt(ctx, types.MintSupplyBaselineKey, baseline)
+}
+
+// IsMaxSupplyReached returns true if minting stopped upon reaching the given max supply.
+func (k Keeper) IsMaxSupplyReached(ctx sdk.Context, maxSupply sdk.Int) bool {
+	return maxSupply.IsPositive() && k.getInt(ctx, types.MaxSupplyReachedKey).Equal(maxSupply)
+}
+
+// setMaxSupplyReached records that minting stopped upon reaching the given max supply.
+func (k Keeper) setMaxSupplyReached(ctx sdk.Context, maxSupply sdk.Int) {
+	k.setInt(ctx, types.MaxSupplyReachedKey, maxSupply)
+}
+
+// addMintedTotal adds the given amount to the cumulative minted total.
+func (k Keeper) addMintedTotal(ctx sdk.Context, amount sdk.Int) {
+	k.setInt(ctx, types.MintedTotalKey, k.GetMintedTotal(ctx).Add(amount))
+}
+
+func (k Keeper) getInt(ctx sdk.Context, key []byte) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(key)
+	if b == nil {
+		return sdk.ZeroInt()
+	}
+
+	var ip sdk.IntProto
+	k.cdc.MustUnmarshal(b, &ip)
+	return ip.Int
+}
+
+func (k Keeper) setInt(ctx sdk.Context, key []byte, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&sdk.IntProto{Int: amount})
+	store.Set(key, b)
+}
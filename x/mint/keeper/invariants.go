@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+// RegisterInvariants registers all mint invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "last-mint-time", LastMintTimeInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "daily-provisions", DailyProvisionsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "minted-total", MintedTotalInvariant(k))
+}
+
+// AllInvariants runs all invariants of the mint module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleAccountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = LastMintTimeInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = DailyProvisionsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return MintedTotalInvariant(k)(ctx)
+	}
+}
+
+// ModuleAccountInvariant checks that the mint module account holds no residual balance,
+// since all minted coins are distributed in the block they are minted in.
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		balance := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+		broken := !balance.IsZero()
+
+		return sdk.FormatInvariant(types.ModuleName, "module account",
+			fmt.Sprintf("\tmint module account balance: %s\n", balance)), broken
+	}
+}
+
+// LastMintTimeInvariant checks that the last mint time is never in the future. A zero last
+// mint time means minting has not started yet.
+func LastMintTimeInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		minter := k.GetMinter(ctx)
+		broken := minter.LastMintTime != 0 && minter.LastMintTime > ctx.BlockTime().Unix()
+
+		return sdk.FormatInvariant(types.ModuleName, "last mint time",
+			fmt.Sprintf("\tlast mint time: %d\n\tblock time: %d\n", minter.LastMintTime, ctx.BlockTime().Unix())), broken
+	}
+}
+
+// DailyProvisionsInvariant checks that the last mint did not increase the daily provisions
+// when the emission curve set by governance only allows them to decrease.
+func DailyProvisionsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params := k.GetParams(ctx)
+		minter := k.GetMinter(ctx)
+		previous := k.GetPreviousDailyProvisions(ctx)
+		broken := params.EmissionSchedule().NonIncreasing() && minter.DailyProvisions.GT(previous)
+
+		return sdk.FormatInvariant(types.ModuleName, "daily provisions",
+			fmt.Sprintf("\tdaily provisions: %s\n\tprevious daily provisions: %s\n", minter.DailyProvisions, previous)), broken
+	}
+}
+
+// MintedTotalInvariant checks that the supply of the mint denom does not exceed the supply
+// baseline recorded at genesis plus the cumulative amount emitted by the module. The supply
+// may be lower, as other modules burn the mint denom, e.g. when slashing or burning deposits,
+// but it cannot grow beyond what the module emitted.
+func MintedTotalInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params := k.GetParams(ctx)
+		supply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount
+		baseline := k.GetMintSupplyBaseline(ctx)
+		mintedTotal := k.GetMintedTotal(ctx)
+		broken := supply.GT(baseline.Add(mintedTotal))
+
+		return sdk.FormatInvariant(types.ModuleName, "minted total",
+			fmt.Sprintf("\tsupply: %s\n\tsupply baseline: %s\n\tminted total: %s\n", supply, baseline, mintedTotal)), broken
+	}
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/ArableProtocol/acrechain/x/mint/keeper"
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	devAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		invariant func(keeper.Keeper) sdk.Invariant
+		expBroken bool
+	}{
+		{
+			"all invariants hold after minting",
+			func() {},
+			keeper.AllInvariants,
+			false,
+		},
+		{
+			"residual balance in the mint module account",
+			func() {
+				coins := sdk.NewCoins(sdk.NewCoin("aacre", sdk.NewInt(1)))
+				suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+			},
+			keeper.ModuleAccountInvariant,
+			true,
+		},
+		{
+			"last mint time in the future",
+			func() {
+				minter := suite.app.MintKeeper.GetMinter(suite.ctx)
+				minter.LastMintTime = suite.ctx.BlockTime().Unix() + 1
+				suite.app.MintKeeper.SetMinter(suite.ctx, minter)
+			},
+			keeper.LastMintTimeInvariant,
+			true,
+		},
+		{
+			"daily provisions increased with a geometric curve",
+			func() {
+				minter := suite.app.MintKeeper.GetMinter(suite.ctx)
+				minter.DailyProvisions = minter.DailyProvisions.MulInt64(2)
+				suite.app.MintKeeper.SetMinter(suite.ctx, minter)
+			},
+			keeper.DailyProvisionsInvariant,
+			true,
+		},
+		{
+			"daily provisions increased with a piecewise curve",
+			func() {
+				params := suite.app.MintKeeper.GetParams(suite.ctx)
+				params.EmissionCurve.CurveType = types.EMISSION_CURVE_PIECEWISE
+				params.EmissionCurve.PiecewiseSchedule = []types.PiecewiseScheduleEntry{
+					{StartTime: 0, DailyProvisions: sdk.NewDec(1)},
+				}
+				suite.app.MintKeeper.SetParams(suite.ctx, params)
+
+				minter := suite.app.MintKeeper.GetMinter(suite.ctx)
+				minter.DailyProvisions = minter.DailyProvisions.MulInt64(2)
+				suite.app.MintKeeper.SetMinter(suite.ctx, minter)
+			},
+			keeper.DailyProvisionsInvariant,
+			false,
+		},
+		{
+			"supply grows outside of minting",
+			func() {
+				coins := sdk.NewCoins(sdk.NewCoin("aacre", sdk.NewInt(1)))
+				suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+				suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, devAddr, coins))
+			},
+			keeper.MintedTotalInvariant,
+			true,
+		},
+		{
+			"supply burned outside of minting",
+			func() {
+				coins := sdk.NewCoins(sdk.NewCoin("aacre", sdk.NewInt(1)))
+				suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromAccountToModule(suite.ctx, devAddr, types.ModuleName, coins))
+				suite.Require().NoError(suite.app.BankKeeper.BurnCoins(suite.ctx, types.ModuleName, coins))
+			},
+			keeper.MintedTotalInvariant,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			now := time.Now()
+			params := types.DefaultParams()
+			params.MintDenom = "aacre"
+			params.ReductionPeriodInSeconds = 10
+			params.NextRewardsReductionTime = now.Add(time.Second * 10).Unix()
+			params.MintingRewardsDistributionStartTime = now.Unix()
+			params.DistributionProportions = types.DistributionProportions{
+				Staking:          sdk.NewDecWithPrec(2, 1),
+				CommunityPool:    sdk.NewDecWithPrec(7, 1),
+				DeveloperRewards: sdk.NewDecWithPrec(1, 1),
+			}
+			params.WeightedDeveloperRewardsReceivers = []types.WeightedRecipient{{Recipient: devAddr.String(), Weight: sdk.OneDec()}}
+			suite.app.MintKeeper.SetParams(suite.ctx, params)
+
+			err := suite.app.MintKeeper.CreateDeveloperVestingModuleAccount(suite.ctx, sdk.NewCoin(params.MintDenom, sdk.NewInt(1_000_000)))
+			suite.Require().NoError(err)
+
+			// mint across a reduction and past the exhaustion of the developer vesting
+			for i := 0; i < 5; i++ {
+				suite.ctx = suite.ctx.WithBlockTime(now.Add(time.Second * time.Duration(i*6)))
+				suite.app.MintKeeper.BeginBlocker(suite.ctx)
+			}
+			suite.Require().True(suite.app.MintKeeper.GetMintedTotal(suite.ctx).IsPositive())
+
+			tc.malleate()
+
+			_, broken := tc.invariant(suite.app.MintKeeper)(suite.ctx)
+			suite.Require().Equal(tc.expBroken, broken)
+		})
+	}
+}
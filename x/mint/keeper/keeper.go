@@ -19,6 +19,7 @@ type Keeper struct {
 	paramSpace          paramtypes.Subspace
 	accountKeeper       types.AccountKeeper
 	bankKeeper          types.BankKeeper
+	stakingKeeper       types.StakingKeeper
 	communityPoolKeeper types.CommunityPoolKeeper
 	hooks               types.MintHooks
 	feeCollectorName    string
@@ -32,6 +33,30 @@ func (e invalidRatioError) Error() string {
 	return fmt.Sprintf("mint allocation ratio (%s) is greater than 1", e.ActualRatio)
 }
 
+type unknownRecipientError struct {
+	Recipient string
+}
+
+func (e unknownRecipientError) Error() string {
+	return fmt.Sprintf("mint recipient (%s) is neither a valid address nor a module account", e.Recipient)
+}
+
+type blockedRecipientError struct {
+	Recipient string
+}
+
+func (e blockedRecipientError) Error() string {
+	return fmt.Sprintf("mint recipient (%s) is not allowed to receive funds", e.Recipient)
+}
+
+type unknownParamsChangeError struct {
+	ID uint64
+}
+
+func (e unknownParamsChangeError) Error() string {
+	return fmt.Sprintf("scheduled params change (%d) does not exist", e.ID)
+}
+
 type insufficientDevVestingBalanceError struct {
 	ActualBalance         sdk.Int
 	AttemptedDistribution sdk.Int
@@ -41,12 +66,38 @@ func (e insufficientDevVestingBalanceError) Error() string {
 	return fmt.Sprintf("developer vesting balance (%s) is smaller than requested distribution of (%s)", e.ActualBalance, e.AttemptedDistribution)
 }
 
+type incentiveStreamSharesError struct {
+	TotalShare sdk.Dec
+	Limit      sdk.Dec
+}
+
+func (e incentiveStreamSharesError) Error() string {
+	return fmt.Sprintf("incentive stream shares (%s) exceed the community pool share (%s)", e.TotalShare, e.Limit)
+}
+
+type incentiveStreamEndedError struct {
+	EndTime   int64
+	BlockTime int64
+}
+
+func (e incentiveStreamEndedError) Error() string {
+	return fmt.Sprintf("incentive stream end time (%d) is not after the block time (%d)", e.EndTime, e.BlockTime)
+}
+
+type unknownIncentiveStreamError struct {
+	ID uint64
+}
+
+func (e unknownIncentiveStreamError) Error() string {
+	return fmt.Sprintf("incentive stream (%d) does not exist", e.ID)
+}
+
 const emptyAddressReceiver = ""
 
 // NewKeeper creates a new mint Keeper instance.
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
-	ak types.AccountKeeper, bk types.BankKeeper, ck types.CommunityPoolKeeper,
+	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, ck types.CommunityPoolKeeper,
 	feeCollectorName string,
 ) Keeper {
 	// ensure mint module account is set
@@ -65,6 +116,7 @@ func NewKeeper(
 		paramSpace:          paramSpace,
 		accountKeeper:       ak,
 		bankKeeper:          bk,
+		stakingKeeper:       sk,
 		communityPoolKeeper: ck,
 		feeCollectorName:    feeCollectorName,
 	}
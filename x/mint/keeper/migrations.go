@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/ArableProtocol/acrechain/x/mint/migrations/v2"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := v2.MigrateParams(ctx, m.keeper.paramSpace); err != nil {
+		return err
+	}
+
+	// start tracking the daily provisions and the mint denom supply for the invariants
+	m.keeper.SetPreviousDailyProvisions(ctx, m.keeper.GetMinter(ctx).DailyProvisions)
+	params := m.keeper.GetParams(ctx)
+	m.keeper.SetMintSupplyBaseline(ctx, m.keeper.bankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+	return nil
+}
@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/ArableProtocol/acrechain/x/mint/keeper"
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	suite.SetupTest()
+
+	// write the distribution proportions in their v1 layout
+	store := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	store.Set(types.KeyDistributionProportions, []byte(`{"staking":"0.200000000000000000"}`))
+	store.Delete(types.KeyEmissionCurve)
+	store.Delete(types.KeyMaxElapsedSeconds)
+	store.Delete(types.KeyMaxSupply)
+	store.Delete(types.KeyMintHistoryRetention)
+	store.Delete(types.KeyFeeBurnFraction)
+	store.Delete(types.KeyMintingMode)
+
+	err := keeper.NewMigrator(suite.app.MintKeeper).Migrate1to2(suite.ctx)
+	suite.Require().NoError(err)
+
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	suite.Require().NoError(params.Validate())
+	suite.Require().Equal(sdk.NewDecWithPrec(2, 1), params.DistributionProportions.Staking)
+	suite.Require().Equal(sdk.NewDecWithPrec(8, 1), params.DistributionProportions.CommunityPool)
+	suite.Require().Equal(sdk.ZeroDec(), params.DistributionProportions.DeveloperRewards)
+	suite.Require().Empty(params.DistributionProportions.WeightedRecipients)
+	suite.Require().Empty(params.WeightedDeveloperRewardsReceivers)
+	suite.Require().Equal(types.DefaultEmissionCurve(), params.EmissionCurve)
+	suite.Require().Equal(types.DefaultMaxElapsedSeconds, params.MaxElapsedSeconds)
+	suite.Require().Equal(sdk.ZeroInt(), params.MaxSupply)
+	suite.Require().Equal(types.DefaultMintHistoryRetention, params.MintHistoryRetention)
+	suite.Require().Equal(sdk.ZeroDec(), params.FeeBurnFraction)
+	suite.Require().Equal(types.MINTING_MODE_BEGIN_BLOCK, params.MintingMode)
+
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, params.MintDenom).Amount
+	suite.Require().Equal(supply, suite.app.MintKeeper.GetMintSupplyBaseline(suite.ctx))
+}
@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/ArableProtocol/acrechain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -15,3 +18,201 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
+
+// UpdateParams sets the minting parameters when the activation time is zero or has
+// already passed, otherwise it schedules the parameters that differ from the current
+// ones to be applied once the activation time is reached, so that changes made in the
+// meantime to the other parameters are kept. It returns the identifier of the scheduled
+// change, zero when the params were applied immediately.
+func (k Keeper) UpdateParams(ctx sdk.Context, params types.Params, activationTime int64) (uint64, error) {
+	if err := k.validateParams(params); err != nil {
+		return 0, err
+	}
+
+	if activationTime <= ctx.BlockTime().Unix() {
+		k.applyParams(ctx, params)
+		return 0, nil
+	}
+
+	changed := k.GetParams(ctx).ChangedKeys(params)
+	if len(changed) == 0 {
+		return 0, fmt.Errorf("scheduled params do not change any parameter")
+	}
+
+	id := k.nextScheduledParamsChangeID(ctx)
+	k.SetScheduledParamsChange(ctx, types.ScheduledParamsChange{
+		Id:             id,
+		ActivationTime: activationTime,
+		Params:         params,
+		ChangedParams:  changed,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParamsChangeScheduled,
+			sdk.NewAttribute(types.AttributeKeyChangeID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyActivationTime, fmt.Sprintf("%d", activationTime)),
+		),
+	)
+
+	return id, nil
+}
+
+// validateParams checks that the params are valid and that their recipients are allowed
+// to receive minted coins.
+func (k Keeper) validateParams(params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	return k.validateParamsRecipients(params)
+}
+
+// validateParamsRecipients checks that the weighted recipients and the developer rewards
+// receivers of the params are allowed to receive minted coins.
+func (k Keeper) validateParamsRecipients(params types.Params) error {
+	for _, w := range params.DistributionProportions.WeightedRecipients {
+		if err := k.validateRecipient(w.Recipient); err != nil {
+			return err
+		}
+	}
+
+	for _, w := range params.WeightedDeveloperRewardsReceivers {
+		// an empty address funds the community pool
+		if w.Recipient == "" {
+			continue
+		}
+		if err := k.validateRecipient(w.Recipient); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetScheduledParamsChanges returns the pending parameter changes, ordered by
+// activation time.
+func (k Keeper) GetScheduledParamsChanges(ctx sdk.Context) []types.ScheduledParamsChange {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledParamsChangeKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	changes := []types.ScheduledParamsChange{}
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.ScheduledParamsChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// SetScheduledParamsChange stores a pending parameter change.
+func (k Keeper) SetScheduledParamsChange(ctx sdk.Context, change types.ScheduledParamsChange) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&change)
+	store.Set(types.ScheduledParamsChangeKey(change.ActivationTime, change.Id), b)
+
+	if change.Id >= k.getNextScheduledParamsChangeID(ctx) {
+		k.setNextScheduledParamsChangeID(ctx, change.Id+1)
+	}
+}
+
+// CancelScheduledParamsChange removes the pending parameter change with the given identifier.
+func (k Keeper) CancelScheduledParamsChange(ctx sdk.Context, id uint64) error {
+	for _, change := range k.GetScheduledParamsChanges(ctx) {
+		if change.Id != id {
+			continue
+		}
+
+		ctx.KVStore(k.storeKey).Delete(types.ScheduledParamsChangeKey(change.ActivationTime, change.Id))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeParamsChangeCanceled,
+				sdk.NewAttribute(types.AttributeKeyChangeID, fmt.Sprintf("%d", change.Id)),
+				sdk.NewAttribute(types.AttributeKeyActivationTime, fmt.Sprintf("%d", change.ActivationTime)),
+			),
+		)
+		return nil
+	}
+
+	return unknownParamsChangeError{id}
+}
+
+// ApplyDueParamsChanges applies the pending parameter changes whose activation time
+// has been reached, in order of activation time. Only the changed parameters are set
+// on top of the current ones, and a change that is no longer valid once merged is
+// dropped instead of halting the chain.
+func (k Keeper) ApplyDueParamsChanges(ctx sdk.Context) {
+	blockTime := ctx.BlockTime().Unix()
+	if blockTime < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	end := types.ScheduledParamsChangeKey(blockTime+1, 0)
+	iterator := store.Iterator(types.ScheduledParamsChangeKeyPrefix, end)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.ScheduledParamsChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		keys = append(keys, iterator.Key())
+
+		params := k.GetParams(ctx).WithChanges(change.Params, change.ChangedParams)
+		if err := k.validateParams(params); err != nil {
+			k.Logger(ctx).Error("failed to apply scheduled params change", "id", change.Id, "error", err.Error())
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeParamsChangeFailed,
+					sdk.NewAttribute(types.AttributeKeyChangeID, fmt.Sprintf("%d", change.Id)),
+					sdk.NewAttribute(types.AttributeKeyActivationTime, fmt.Sprintf("%d", change.ActivationTime)),
+				),
+			)
+			continue
+		}
+
+		k.applyParams(ctx, params)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeParamsChangeApplied,
+				sdk.NewAttribute(types.AttributeKeyChangeID, fmt.Sprintf("%d", change.Id)),
+				sdk.NewAttribute(types.AttributeKeyActivationTime, fmt.Sprintf("%d", change.ActivationTime)),
+			),
+		)
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// applyParams sets the given minting parameters. A next reduction time that has
+// already passed when the params take effect is replaced with the current one, so
+// that params prepared ahead of time do not replay a reduction.
+func (k Keeper) applyParams(ctx sdk.Context, params types.Params) {
+	if params.NextRewardsReductionTime < ctx.BlockTime().Unix() {
+		params.NextRewardsReductionTime = k.GetNextReductionTime(ctx)
+	}
+	k.SetParams(ctx, params)
+}
+
+// nextScheduledParamsChangeID returns the identifier for a new scheduled change and
+// increments the stored one.
+func (k Keeper) nextScheduledParamsChangeID(ctx sdk.Context) uint64 {
+	id := k.getNextScheduledParamsChangeID(ctx)
+	k.setNextScheduledParamsChangeID(ctx, id+1)
+	return id
+}
+
+func (k Keeper) getNextScheduledParamsChangeID(ctx sdk.Context) uint64 {
+	b := ctx.KVStore(k.storeKey).Get(types.NextScheduledParamsChangeIDKey)
+	if b == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(b)
+}
+
+func (k Keeper) setNextScheduledParamsChangeID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextScheduledParamsChangeIDKey, sdk.Uint64ToBigEndian(id))
+}
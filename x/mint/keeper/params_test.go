@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/ArableProtocol/acrechain/x/mint/keeper"
 	"github.com/ArableProtocol/acrechain/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -14,13 +15,188 @@ func (suite *KeeperTestSuite) TestParamsGetSet() {
 		ReductionPeriodInSeconds: 1000,
 		ReductionFactor:          sdk.NewDecWithPrec(66, 2),
 		DistributionProportions: types.DistributionProportions{
-			Staking: sdk.NewDecWithPrec(2, 1),
+			Staking:          sdk.NewDecWithPrec(2, 1),
+			CommunityPool:    sdk.NewDecWithPrec(8, 1),
+			DeveloperRewards: sdk.ZeroDec(),
 		},
 		NextRewardsReductionTime:            time.Now().Add(time.Second * 1000).Unix(),
 		MintingRewardsDistributionStartTime: time.Now().Add(time.Second).Unix(),
+		EmissionCurve:                       types.DefaultEmissionCurve(),
+		MaxSupply:                           sdk.ZeroInt(),
+		FeeBurnFraction:                     sdk.ZeroDec(),
 	}
 
 	suite.app.MintKeeper.SetParams(suite.ctx, params)
 	newParams := suite.app.MintKeeper.GetParams(suite.ctx)
 	suite.Require().Equal(params, newParams)
 }
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	suite.SetupTest()
+
+	now := time.Unix(1_700_000_000, 0)
+	suite.ctx = suite.ctx.WithBlockTime(now)
+
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.NextRewardsReductionTime = now.Unix() + 86400
+	suite.app.MintKeeper.SetParams(suite.ctx, params)
+
+	// invalid params are rejected
+	invalid := params
+	invalid.ReductionFactor = sdk.NewDec(2)
+	_, err := suite.app.MintKeeper.UpdateParams(suite.ctx, invalid, 0)
+	suite.Require().Error(err)
+
+	// a past activation time applies the params immediately
+	updated := params
+	updated.ReductionFactor = sdk.NewDecWithPrec(5, 1)
+	id, err := suite.app.MintKeeper.UpdateParams(suite.ctx, updated, now.Unix()-1)
+	suite.Require().NoError(err)
+	suite.Require().Zero(id)
+	suite.Require().Equal(updated, suite.app.MintKeeper.GetParams(suite.ctx))
+	suite.Require().Empty(suite.app.MintKeeper.GetScheduledParamsChanges(suite.ctx))
+
+	// a scheduled change must change at least one parameter
+	_, err = suite.app.MintKeeper.UpdateParams(suite.ctx, updated, now.Unix()+100)
+	suite.Require().Error(err)
+
+	// future activation times are scheduled and listed in order of activation
+	later := updated
+	later.MaxElapsedSeconds = 3600
+	id, err = suite.app.MintKeeper.UpdateParams(suite.ctx, later, now.Unix()+200)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), id)
+
+	sooner := updated
+	sooner.MaxElapsedSeconds = 60
+	id, err = suite.app.MintKeeper.UpdateParams(suite.ctx, sooner, now.Unix()+100)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), id)
+	suite.Require().Equal(updated, suite.app.MintKeeper.GetParams(suite.ctx))
+
+	querier := keeper.NewQuerier(suite.app.MintKeeper)
+	pending, err := querier.ScheduledParamsChanges(sdk.WrapSDKContext(suite.ctx), &types.QueryScheduledParamsChangesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ScheduledParamsChange{
+		{Id: 2, ActivationTime: now.Unix() + 100, Params: sooner, ChangedParams: []string{string(types.KeyMaxElapsedSeconds)}},
+		{Id: 1, ActivationTime: now.Unix() + 200, Params: later, ChangedParams: []string{string(types.KeyMaxElapsedSeconds)}},
+	}, pending.Changes)
+
+	// a change applied in the meantime is not reverted by the scheduled ones
+	intermediate := updated
+	intermediate.FeeBurnFraction = sdk.NewDecWithPrec(1, 1)
+	_, err = suite.app.MintKeeper.UpdateParams(suite.ctx, intermediate, 0)
+	suite.Require().NoError(err)
+
+	// the EndBlocker applies a change once its activation time is reached
+	suite.ctx = suite.ctx.WithBlockTime(now.Add(99 * time.Second))
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	suite.Require().Equal(int64(types.DefaultMaxElapsedSeconds), suite.app.MintKeeper.GetParams(suite.ctx).MaxElapsedSeconds)
+
+	suite.ctx = suite.ctx.WithBlockTime(now.Add(100 * time.Second))
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	applied := suite.app.MintKeeper.GetParams(suite.ctx)
+	suite.Require().Equal(int64(60), applied.MaxElapsedSeconds)
+	suite.Require().Equal(intermediate.FeeBurnFraction, applied.FeeBurnFraction)
+	suite.Require().Len(suite.app.MintKeeper.GetScheduledParamsChanges(suite.ctx), 1)
+
+	// a next reduction time that passed before activation is not replayed
+	suite.app.MintKeeper.SetNextReductionTime(suite.ctx, now.Unix()+86400*2)
+	suite.ctx = suite.ctx.WithBlockTime(now.Add(86400 * 1.5 * time.Second))
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	applied = suite.app.MintKeeper.GetParams(suite.ctx)
+	suite.Require().Equal(int64(3600), applied.MaxElapsedSeconds)
+	suite.Require().Equal(intermediate.FeeBurnFraction, applied.FeeBurnFraction)
+	suite.Require().Equal(now.Unix()+86400*2, applied.NextRewardsReductionTime)
+	suite.Require().Empty(suite.app.MintKeeper.GetScheduledParamsChanges(suite.ctx))
+
+	// scheduled changes are exported and imported with the genesis state
+	next := applied
+	next.MaxElapsedSeconds = 60
+	_, err = suite.app.MintKeeper.UpdateParams(suite.ctx, next, now.Unix()+86400*10)
+	suite.Require().NoError(err)
+	genesis := suite.app.MintKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(types.ValidateGenesis(*genesis))
+	suite.Require().Equal([]types.ScheduledParamsChange{
+		{Id: 3, ActivationTime: now.Unix() + 86400*10, Params: next, ChangedParams: []string{string(types.KeyMaxElapsedSeconds)}},
+	}, genesis.ScheduledParamsChanges)
+}
+
+func (suite *KeeperTestSuite) TestApplyInvalidScheduledParamsChange() {
+	suite.SetupTest()
+
+	now := time.Unix(1_700_000_000, 0)
+	suite.ctx = suite.ctx.WithBlockTime(now)
+
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.NextRewardsReductionTime = now.Unix() + 86400
+	suite.app.MintKeeper.SetParams(suite.ctx, params)
+
+	// a change that is not valid once merged with the current params is dropped
+	suite.app.MintKeeper.SetScheduledParamsChange(suite.ctx, types.ScheduledParamsChange{
+		Id:             1,
+		ActivationTime: now.Unix() + 100,
+		Params:         types.Params{},
+		ChangedParams:  []string{string(types.KeyMintDenom)},
+	})
+
+	suite.ctx = suite.ctx.WithBlockTime(now.Add(100 * time.Second))
+	suite.Require().NotPanics(func() { suite.app.MintKeeper.EndBlocker(suite.ctx) })
+	suite.Require().Equal(params.MintDenom, suite.app.MintKeeper.GetParams(suite.ctx).MintDenom)
+	suite.Require().Empty(suite.app.MintKeeper.GetScheduledParamsChanges(suite.ctx))
+
+	var failed bool
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeParamsChangeFailed {
+			failed = true
+		}
+	}
+	suite.Require().True(failed)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParamsProposal() {
+	suite.SetupTest()
+
+	now := time.Unix(1_700_000_000, 0)
+	suite.ctx = suite.ctx.WithBlockTime(now)
+
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.NextRewardsReductionTime = now.Unix() + 86400
+	params.MaxElapsedSeconds = 60
+
+	handler := suite.app.GovKeeper.Router().GetRoute(types.RouterKey)
+	err := handler(suite.ctx, types.NewUpdateParamsProposal("title", "description", params, now.Unix()+100))
+	suite.Require().NoError(err)
+	suite.Require().Len(suite.app.MintKeeper.GetScheduledParamsChanges(suite.ctx), 1)
+
+	err = handler(suite.ctx, types.NewUpdateParamsProposal("title", "description", params, 0))
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, suite.app.MintKeeper.GetParams(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestCancelParamsChangeProposal() {
+	suite.SetupTest()
+
+	now := time.Unix(1_700_000_000, 0)
+	suite.ctx = suite.ctx.WithBlockTime(now)
+
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.NextRewardsReductionTime = now.Unix() + 86400
+	suite.app.MintKeeper.SetParams(suite.ctx, params)
+
+	scheduled := params
+	scheduled.MaxElapsedSeconds = 60
+	id, err := suite.app.MintKeeper.UpdateParams(suite.ctx, scheduled, now.Unix()+100)
+	suite.Require().NoError(err)
+
+	handler := suite.app.GovKeeper.Router().GetRoute(types.RouterKey)
+	suite.Require().Error(handler(suite.ctx, types.NewCancelParamsChangeProposal("title", "description", id+1)))
+	suite.Require().NoError(handler(suite.ctx, types.NewCancelParamsChangeProposal("title", "description", id)))
+	suite.Require().Empty(suite.app.MintKeeper.GetScheduledParamsChanges(suite.ctx))
+	suite.Require().Error(handler(suite.ctx, types.NewCancelParamsChangeProposal("title", "description", id)))
+
+	// a canceled change is not applied at its activation time
+	suite.ctx = suite.ctx.WithBlockTime(now.Add(100 * time.Second))
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	suite.Require().Equal(params.MaxElapsedSeconds, suite.app.MintKeeper.GetParams(suite.ctx).MaxElapsedSeconds)
+}
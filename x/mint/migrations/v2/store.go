@@ -0,0 +1,48 @@
+package v2
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+// legacyDistributionProportions is the v1 layout of the distribution
+// proportions, where the whole remainder after staking rewards was sent to the
+// community pool.
+type legacyDistributionProportions struct {
+	Staking sdk.Dec `json:"staking"`
+}
+
+// MigrateParams migrates the x/mint distribution proportions from the single
+// staking field layout to the weighted recipients layout. The share that was
+// implicitly funded into the community pool is made explicit, so that the
+// emission split stays the same. Parameters introduced in v2 are set to their
+// disabled values, the emission curve to the geometric reduction used so far
+// and the catch-up cap and the mint history retention to their defaults. Minting
+// moves from EndBlock to BeginBlock, so that the staking rewards are distributed
+// in the block they are minted in.
+func MigrateParams(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	var legacy legacyDistributionProportions
+	if err := json.Unmarshal(paramstore.GetRaw(ctx, types.KeyDistributionProportions), &legacy); err != nil {
+		return err
+	}
+
+	proportions := types.DistributionProportions{
+		Staking:          legacy.Staking,
+		CommunityPool:    sdk.OneDec().Sub(legacy.Staking),
+		DeveloperRewards: sdk.ZeroDec(),
+	}
+
+	paramstore.Set(ctx, types.KeyDistributionProportions, proportions)
+	paramstore.Set(ctx, types.KeyWeightedDeveloperRewardsReceivers, []types.WeightedRecipient{})
+	paramstore.Set(ctx, types.KeyEmissionCurve, types.DefaultEmissionCurve())
+	paramstore.Set(ctx, types.KeyMaxElapsedSeconds, types.DefaultMaxElapsedSeconds)
+	paramstore.Set(ctx, types.KeyMaxSupply, sdk.ZeroInt())
+	paramstore.Set(ctx, types.KeyMintHistoryRetention, types.DefaultMintHistoryRetention)
+	paramstore.Set(ctx, types.KeyFeeBurnFraction, sdk.ZeroDec())
+	paramstore.Set(ctx, types.KeyMintingMode, types.MINTING_MODE_BEGIN_BLOCK)
+	return nil
+}
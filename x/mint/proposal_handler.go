@@ -0,0 +1,47 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/ArableProtocol/acrechain/x/mint/keeper"
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+// NewMintProposalHandler creates a governance handler to manage new proposal types.
+func NewMintProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UpdateParamsProposal:
+			return handleUpdateParamsProposal(ctx, k, c)
+		case *types.CancelParamsChangeProposal:
+			return handleCancelParamsChangeProposal(ctx, k, c)
+		case *types.RegisterIncentiveStreamProposal:
+			return handleRegisterIncentiveStreamProposal(ctx, k, c)
+		case *types.CancelIncentiveStreamProposal:
+			return handleCancelIncentiveStreamProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
+
+func handleUpdateParamsProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateParamsProposal) error {
+	_, err := k.UpdateParams(ctx, p.Params, p.ActivationTime)
+	return err
+}
+
+func handleCancelParamsChangeProposal(ctx sdk.Context, k keeper.Keeper, p *types.CancelParamsChangeProposal) error {
+	return k.CancelScheduledParamsChange(ctx, p.ChangeId)
+}
+
+func handleRegisterIncentiveStreamProposal(ctx sdk.Context, k keeper.Keeper, p *types.RegisterIncentiveStreamProposal) error {
+	_, err := k.RegisterIncentiveStream(ctx, p.Recipient, p.Share, p.StartTime, p.EndTime)
+	return err
+}
+
+func handleCancelIncentiveStreamProposal(ctx sdk.Context, k keeper.Keeper, p *types.CancelIncentiveStreamProposal) error {
+	return k.CancelIncentiveStream(ctx, p.StreamId)
+}
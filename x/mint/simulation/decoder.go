@@ -7,6 +7,7 @@ import (
 	"github.com/ArableProtocol/acrechain/x/mint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 )
 
@@ -20,6 +21,36 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &minterA)
 			cdc.MustUnmarshal(kvB.Value, &minterB)
 			return fmt.Sprintf("%v\n%v", minterA, minterB)
+		case bytes.Equal(kvA.Key, types.PreviousDailyProvisionsKey):
+			var decA, decB sdk.DecProto
+			cdc.MustUnmarshal(kvA.Value, &decA)
+			cdc.MustUnmarshal(kvB.Value, &decB)
+			return fmt.Sprintf("%v\n%v", decA.Dec, decB.Dec)
+		case bytes.Equal(kvA.Key, types.MintedTotalKey), bytes.Equal(kvA.Key, types.MintSupplyBaselineKey),
+			bytes.Equal(kvA.Key, types.MaxSupplyReachedKey):
+			var intA, intB sdk.IntProto
+			cdc.MustUnmarshal(kvA.Value, &intA)
+			cdc.MustUnmarshal(kvB.Value, &intB)
+			return fmt.Sprintf("%v\n%v", intA.Int, intB.Int)
+		case bytes.HasPrefix(kvA.Key, types.ScheduledParamsChangeKeyPrefix):
+			var changeA, changeB types.ScheduledParamsChange
+			cdc.MustUnmarshal(kvA.Value, &changeA)
+			cdc.MustUnmarshal(kvB.Value, &changeB)
+			return fmt.Sprintf("%v\n%v", changeA, changeB)
+		case bytes.HasPrefix(kvA.Key, types.MintHistoryKeyPrefix):
+			var recordA, recordB types.MintRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+		case bytes.Equal(kvA.Key, types.MintHistoryBoundsKey):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+		case bytes.HasPrefix(kvA.Key, types.IncentiveStreamKeyPrefix):
+			var streamA, streamB types.IncentiveStream
+			cdc.MustUnmarshal(kvA.Value, &streamA)
+			cdc.MustUnmarshal(kvB.Value, &streamB)
+			return fmt.Sprintf("%v\n%v", streamA, streamB)
+		case bytes.Equal(kvA.Key, types.NextScheduledParamsChangeIDKey), bytes.Equal(kvA.Key, types.NextIncentiveStreamIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
 		default:
 			panic(fmt.Sprintf("invalid mint key %X", kvA.Key))
 		}
@@ -0,0 +1,72 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/evmos/ethermint/encoding"
+
+	"github.com/ArableProtocol/acrechain/app"
+	"github.com/ArableProtocol/acrechain/x/mint/simulation"
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := encoding.MakeConfig(app.ModuleBasics).Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	minter := types.NewMinter(sdk.NewDec(1_000), 15)
+	previous := sdk.DecProto{Dec: sdk.NewDec(2_000)}
+	total := sdk.IntProto{Int: sdk.NewInt(3_000)}
+	record := types.MintRecord{Height: 10, Time: 20, Amount: sdk.NewInt(100), Staking: sdk.NewInt(25), CommunityPool: sdk.NewInt(75)}
+	stream := types.NewIncentiveStream(1, "erc20", sdk.NewDecWithPrec(5, 2), 10, 20)
+	change := types.ScheduledParamsChange{Id: 1, ActivationTime: 20, Params: types.DefaultParams()}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.MinterKey, Value: cdc.MustMarshal(&minter)},
+			{Key: types.PreviousDailyProvisionsKey, Value: cdc.MustMarshal(&previous)},
+			{Key: types.MintedTotalKey, Value: cdc.MustMarshal(&total)},
+			{Key: types.MintSupplyBaselineKey, Value: cdc.MustMarshal(&total)},
+			{Key: types.MaxSupplyReachedKey, Value: cdc.MustMarshal(&total)},
+			{Key: types.ScheduledParamsChangeKey(change.ActivationTime, change.Id), Value: cdc.MustMarshal(&change)},
+			{Key: types.NextScheduledParamsChangeIDKey, Value: sdk.Uint64ToBigEndian(2)},
+			{Key: types.MintHistoryKey(0), Value: cdc.MustMarshal(&record)},
+			{Key: types.IncentiveStreamKey(stream.Id), Value: cdc.MustMarshal(&stream)},
+			{Key: types.NextIncentiveStreamIDKey, Value: sdk.Uint64ToBigEndian(2)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Minter", fmt.Sprintf("%v\n%v", minter, minter)},
+		{"PreviousDailyProvisions", fmt.Sprintf("%v\n%v", previous.Dec, previous.Dec)},
+		{"MintedTotal", fmt.Sprintf("%v\n%v", total.Int, total.Int)},
+		{"MintSupplyBaseline", fmt.Sprintf("%v\n%v", total.Int, total.Int)},
+		{"MaxSupplyReached", fmt.Sprintf("%v\n%v", total.Int, total.Int)},
+		{"ScheduledParamsChange", fmt.Sprintf("%v\n%v", change, change)},
+		{"NextScheduledParamsChangeID", "2\n2"},
+		{"MintHistory", fmt.Sprintf("%v\n%v", record, record)},
+		{"IncentiveStream", fmt.Sprintf("%v\n%v", stream, stream)},
+		{"NextIncentiveStreamID", "2\n2"},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -3,9 +3,238 @@ package simulation
 // DONTCOVER
 
 import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+// Simulation parameter constants
+const (
+	GenesisDailyProvisions     = "genesis_daily_provisions"
+	ReductionFactor            = "reduction_factor"
+	ReductionPeriodInSeconds   = "reduction_period_in_seconds"
+	DistributionProportions    = "distribution_proportions"
+	MintingRewardsStartOffset  = "minting_rewards_start_offset"
+	EmissionCurve              = "emission_curve"
+	MaxElapsedSeconds          = "max_elapsed_seconds"
+	MaxSupply                  = "max_supply"
+	MintHistoryRetention       = "mint_history_retention"
+	FeeBurnFraction            = "fee_burn_fraction"
+	MintingMode                = "minting_mode"
+	DeveloperVestingAmount     = "developer_vesting_amount"
+	WeightedDeveloperReceivers = "weighted_developer_rewards_receivers"
 )
 
+// GenGenesisDailyProvisions randomized GenesisDailyProvisions
+func GenGenesisDailyProvisions(r *rand.Rand) sdk.Dec {
+	return sdk.NewDec(r.Int63n(1_000_000_000) + 1)
+}
+
+// GenReductionFactor randomized ReductionFactor between 0.5 and 1
+func GenReductionFactor(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(51)+50), 2)
+}
+
+// GenReductionPeriodInSeconds randomized ReductionPeriodInSeconds between a minute and a day
+func GenReductionPeriodInSeconds(r *rand.Rand) int64 {
+	return int64(r.Intn(86400-60) + 60)
+}
+
+// GenDistributionProportions randomized DistributionProportions
+func GenDistributionProportions(r *rand.Rand) types.DistributionProportions {
+	staking := sdk.NewDecWithPrec(int64(r.Intn(101)), 2)
+	developerRewards := sdk.NewDecWithPrec(int64(r.Intn(101)), 2).Mul(sdk.OneDec().Sub(staking)).Quo(sdk.NewDec(4))
+	return types.DistributionProportions{
+		Staking:          staking,
+		CommunityPool:    sdk.OneDec().Sub(staking).Sub(developerRewards),
+		DeveloperRewards: developerRewards,
+	}
+}
+
+// GenMintingRewardsStartOffset randomized offset of the minting rewards distribution start
+// time from the genesis time, up to an hour
+func GenMintingRewardsStartOffset(r *rand.Rand) int64 {
+	return int64(r.Intn(3600))
+}
+
+// GenEmissionCurve randomized EmissionCurve
+func GenEmissionCurve(r *rand.Rand, genesisDailyProvisions sdk.Dec, startTime int64) types.EmissionCurve {
+	curve := types.DefaultEmissionCurve()
+	curve.CurveType = types.EmissionCurveType(r.Intn(len(types.EmissionCurveType_name)))
+
+	switch curve.CurveType {
+	case types.EMISSION_CURVE_LINEAR:
+		curve.LinearReduction = genesisDailyProvisions.QuoInt64(int64(r.Intn(20) + 1))
+	case types.EMISSION_CURVE_PIECEWISE:
+		entryTime := startTime
+		dailyProvisions := genesisDailyProvisions
+		for i := 0; i < r.Intn(5)+1; i++ {
+			entryTime += int64(r.Intn(3600) + 1)
+			dailyProvisions = dailyProvisions.Mul(GenReductionFactor(r))
+			curve.PiecewiseSchedule = append(curve.PiecewiseSchedule, types.PiecewiseScheduleEntry{
+				StartTime:       entryTime,
+				DailyProvisions: dailyProvisions,
+			})
+		}
+	case types.EMISSION_CURVE_TARGET_BONDED:
+		curve.GoalBonded = sdk.NewDecWithPrec(int64(r.Intn(90)+10), 2)
+		curve.InflationMin = sdk.NewDecWithPrec(int64(r.Intn(10)), 2)
+		curve.InflationMax = curve.InflationMin.Add(sdk.NewDecWithPrec(int64(r.Intn(20)), 2))
+		curve.InflationRateChange = sdk.NewDecWithPrec(int64(r.Intn(99)), 2)
+	}
+
+	return curve
+}
+
+// GenMaxElapsedSeconds randomized MaxElapsedSeconds, zero disabling the cap
+func GenMaxElapsedSeconds(r *rand.Rand) int64 {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return int64(r.Intn(600) + 1)
+}
+
+// GenMaxSupply randomized MaxSupply, zero disabling the cap
+func GenMaxSupply(r *rand.Rand) sdk.Int {
+	if r.Intn(2) == 0 {
+		return sdk.ZeroInt()
+	}
+	return sdk.NewInt(r.Int63n(1_000_000_000_000_000) + 1)
+}
+
+// GenMintHistoryRetention randomized MintHistoryRetention, zero disabling the history
+func GenMintHistoryRetention(r *rand.Rand) uint64 {
+	return uint64(r.Intn(1_000))
+}
+
+// GenFeeBurnFraction randomized FeeBurnFraction, zero disabling the burn
+func GenFeeBurnFraction(r *rand.Rand) sdk.Dec {
+	if r.Intn(2) == 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 2)
+}
+
+// GenMintingMode randomized MintingMode
+func GenMintingMode(r *rand.Rand) types.MintingMode {
+	return types.MintingMode(r.Intn(len(types.MintingMode_name)))
+}
+
+// GenDeveloperVestingAmount randomized DeveloperVestingAmount
+func GenDeveloperVestingAmount(r *rand.Rand) sdk.Int {
+	return sdk.NewInt(r.Int63n(1_000_000_000_000))
+}
+
 // RandomizedGenState generates a random GenesisState for mint.
 func RandomizedGenState(simState *module.SimulationState) {
+	var genesisDailyProvisions sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, GenesisDailyProvisions, &genesisDailyProvisions, simState.Rand,
+		func(r *rand.Rand) { genesisDailyProvisions = GenGenesisDailyProvisions(r) },
+	)
+
+	var reductionFactor sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ReductionFactor, &reductionFactor, simState.Rand,
+		func(r *rand.Rand) { reductionFactor = GenReductionFactor(r) },
+	)
+
+	var reductionPeriodInSeconds int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ReductionPeriodInSeconds, &reductionPeriodInSeconds, simState.Rand,
+		func(r *rand.Rand) { reductionPeriodInSeconds = GenReductionPeriodInSeconds(r) },
+	)
+
+	var distributionProportions types.DistributionProportions
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DistributionProportions, &distributionProportions, simState.Rand,
+		func(r *rand.Rand) { distributionProportions = GenDistributionProportions(r) },
+	)
+
+	var startOffset int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MintingRewardsStartOffset, &startOffset, simState.Rand,
+		func(r *rand.Rand) { startOffset = GenMintingRewardsStartOffset(r) },
+	)
+	mintingRewardsDistributionStartTime := simState.GenTimestamp.Unix() + startOffset
+
+	var emissionCurve types.EmissionCurve
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EmissionCurve, &emissionCurve, simState.Rand,
+		func(r *rand.Rand) {
+			emissionCurve = GenEmissionCurve(r, genesisDailyProvisions, mintingRewardsDistributionStartTime)
+		},
+	)
+
+	var maxElapsedSeconds int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxElapsedSeconds, &maxElapsedSeconds, simState.Rand,
+		func(r *rand.Rand) { maxElapsedSeconds = GenMaxElapsedSeconds(r) },
+	)
+
+	var maxSupply sdk.Int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxSupply, &maxSupply, simState.Rand,
+		func(r *rand.Rand) { maxSupply = GenMaxSupply(r) },
+	)
+
+	var mintHistoryRetention uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MintHistoryRetention, &mintHistoryRetention, simState.Rand,
+		func(r *rand.Rand) { mintHistoryRetention = GenMintHistoryRetention(r) },
+	)
+
+	var feeBurnFraction sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FeeBurnFraction, &feeBurnFraction, simState.Rand,
+		func(r *rand.Rand) { feeBurnFraction = GenFeeBurnFraction(r) },
+	)
+
+	var mintingMode types.MintingMode
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MintingMode, &mintingMode, simState.Rand,
+		func(r *rand.Rand) { mintingMode = GenMintingMode(r) },
+	)
+
+	var developerVestingAmount sdk.Int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DeveloperVestingAmount, &developerVestingAmount, simState.Rand,
+		func(r *rand.Rand) { developerVestingAmount = GenDeveloperVestingAmount(r) },
+	)
+
+	// pay the developer rewards to a random account, or to the community pool
+	var weightedDeveloperReceivers []types.WeightedRecipient
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, WeightedDeveloperReceivers, &weightedDeveloperReceivers, simState.Rand,
+		func(r *rand.Rand) {
+			if len(simState.Accounts) == 0 || r.Intn(2) == 0 {
+				return
+			}
+			weightedDeveloperReceivers = []types.WeightedRecipient{
+				{Recipient: simState.Accounts[r.Intn(len(simState.Accounts))].Address.String(), Weight: sdk.OneDec()},
+			}
+		},
+	)
+
+	mintDenom := sdk.DefaultBondDenom
+	params := types.NewParams(
+		mintDenom, genesisDailyProvisions, reductionFactor, reductionPeriodInSeconds, distributionProportions,
+		mintingRewardsDistributionStartTime+reductionPeriodInSeconds, mintingRewardsDistributionStartTime,
+		weightedDeveloperReceivers, emissionCurve, maxElapsedSeconds, maxSupply, mintHistoryRetention,
+		feeBurnFraction, mintingMode,
+	)
+
+	mintGenesis := types.NewGenesisState(params, developerVestingAmount)
+
+	bz, err := json.MarshalIndent(&mintGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated minting parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(mintGenesis)
 }
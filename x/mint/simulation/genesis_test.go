@@ -0,0 +1,57 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/ArableProtocol/acrechain/x/mint/simulation"
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+// TestRandomizedGenState checks that the randomized genesis states are valid for a range
+// of seeds, so that every emission curve is generated.
+func TestRandomizedGenState(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	genTimestamp := time.Unix(1_700_000_000, 0)
+
+	curves := make(map[types.EmissionCurveType]bool)
+	for seed := int64(0); seed < 50; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		simState := module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          cdc,
+			Rand:         r,
+			NumBonded:    3,
+			Accounts:     simtypes.RandomAccounts(r, 3),
+			InitialStake: 1000,
+			GenState:     make(map[string]json.RawMessage),
+			GenTimestamp: genTimestamp,
+		}
+
+		simulation.RandomizedGenState(&simState)
+
+		var mintGenesis types.GenesisState
+		simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &mintGenesis)
+
+		require.NoError(t, types.ValidateGenesis(mintGenesis))
+		require.Equal(t, "stake", mintGenesis.Params.MintDenom)
+		require.GreaterOrEqual(t, mintGenesis.Params.MintingRewardsDistributionStartTime, genTimestamp.Unix())
+		require.Equal(t,
+			mintGenesis.Params.MintingRewardsDistributionStartTime+mintGenesis.Params.ReductionPeriodInSeconds,
+			mintGenesis.Params.NextRewardsReductionTime,
+		)
+		curves[mintGenesis.Params.EmissionCurve.CurveType] = true
+	}
+
+	require.Len(t, curves, len(types.EmissionCurveType_name))
+}
@@ -0,0 +1,59 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyReductionFactor),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenReductionFactor(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyReductionPeriodInSeconds),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenReductionPeriodInSeconds(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyDistributionProportions),
+			func(r *rand.Rand) string {
+				proportions := GenDistributionProportions(r)
+				return fmt.Sprintf(
+					"{\"staking\":\"%s\",\"community_pool\":\"%s\",\"weighted_recipients\":[],\"developer_rewards\":\"%s\"}",
+					proportions.Staking, proportions.CommunityPool, proportions.DeveloperRewards,
+				)
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxElapsedSeconds),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxElapsedSeconds(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMintHistoryRetention),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMintHistoryRetention(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyFeeBurnFraction),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenFeeBurnFraction(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMintingMode),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMintingMode(r))
+			},
+		),
+	}
+}
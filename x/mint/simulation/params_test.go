@@ -0,0 +1,34 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
+
+	"github.com/ArableProtocol/acrechain/app"
+	"github.com/ArableProtocol/acrechain/x/mint/simulation"
+	"github.com/ArableProtocol/acrechain/x/mint/types"
+)
+
+// TestParamChanges checks that the randomized param changes apply to the mint params.
+func TestParamChanges(t *testing.T) {
+	acreApp := app.Setup(false, feemarkettypes.DefaultGenesisState())
+	ctx := acreApp.BaseApp.NewContext(false, tmproto.Header{})
+	subspace := acreApp.GetSubspace(types.ModuleName)
+
+	r := rand.New(rand.NewSource(1))
+	paramChanges := simulation.ParamChanges(r)
+	require.Len(t, paramChanges, 7)
+
+	for _, pc := range paramChanges {
+		require.Equal(t, types.ModuleName, pc.Subspace())
+		require.NoError(t, subspace.Update(ctx, []byte(pc.Key()), []byte(pc.SimValue()(r))), pc.Key())
+	}
+
+	params := acreApp.MintKeeper.GetParams(ctx)
+	require.NoError(t, params.Validate())
+}
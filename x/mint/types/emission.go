@@ -0,0 +1,231 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// secondsPerYear is the number of seconds the annual inflation rate change of the
+// target bonded curve is spread over.
+const secondsPerYear = 86400 * 365
+
+// EmissionState holds the chain state an emission schedule can depend on.
+type EmissionState struct {
+	// BlockTime is the unix time of the current block.
+	BlockTime int64
+	// LastMintTime is the unix time of the previous mint.
+	LastMintTime int64
+	// BondedRatio is the current staking bonded ratio.
+	BondedRatio sdk.Dec
+	// TotalSupply is the current total supply of the mint denom.
+	TotalSupply sdk.Int
+}
+
+// EmissionSchedule defines how the daily provisions of the minter evolve over time.
+type EmissionSchedule interface {
+	// ReducedProvisions returns the daily provisions once a reduction period has elapsed.
+	ReducedProvisions(dailyProvisions sdk.Dec) sdk.Dec
+	// CurrentProvisions returns the daily provisions to mint with in the given state.
+	CurrentProvisions(dailyProvisions sdk.Dec, state EmissionState) sdk.Dec
+	// NonIncreasing returns whether the daily provisions can only decrease over time.
+	NonIncreasing() bool
+}
+
+var (
+	_ EmissionSchedule = geometricSchedule{}
+	_ EmissionSchedule = linearSchedule{}
+	_ EmissionSchedule = piecewiseSchedule{}
+	_ EmissionSchedule = targetBondedSchedule{}
+)
+
+// EmissionSchedule returns the emission schedule selected by the params.
+func (p Params) EmissionSchedule() EmissionSchedule {
+	curve := p.EmissionCurve
+	switch curve.CurveType {
+	case EMISSION_CURVE_LINEAR:
+		return linearSchedule{reduction: curve.LinearReduction}
+	case EMISSION_CURVE_PIECEWISE:
+		return piecewiseSchedule{entries: curve.PiecewiseSchedule}
+	case EMISSION_CURVE_TARGET_BONDED:
+		return targetBondedSchedule{
+			goalBonded:          curve.GoalBonded,
+			inflationMin:        curve.InflationMin,
+			inflationMax:        curve.InflationMax,
+			inflationRateChange: curve.InflationRateChange,
+		}
+	default:
+		return geometricSchedule{factor: p.ReductionFactor}
+	}
+}
+
+// geometricSchedule multiplies the daily provisions by a factor on each reduction.
+type geometricSchedule struct {
+	factor sdk.Dec
+}
+
+func (s geometricSchedule) ReducedProvisions(dailyProvisions sdk.Dec) sdk.Dec {
+	return dailyProvisions.Mul(s.factor)
+}
+
+func (s geometricSchedule) CurrentProvisions(dailyProvisions sdk.Dec, _ EmissionState) sdk.Dec {
+	return dailyProvisions
+}
+
+// NonIncreasing is true, as the reduction factor is at most 1.
+func (s geometricSchedule) NonIncreasing() bool {
+	return true
+}
+
+// linearSchedule decreases the daily provisions by a fixed amount on each reduction,
+// down to zero.
+type linearSchedule struct {
+	reduction sdk.Dec
+}
+
+func (s linearSchedule) ReducedProvisions(dailyProvisions sdk.Dec) sdk.Dec {
+	reduced := dailyProvisions.Sub(s.reduction)
+	if reduced.IsNegative() {
+		return sdk.ZeroDec()
+	}
+	return reduced
+}
+
+func (s linearSchedule) CurrentProvisions(dailyProvisions sdk.Dec, _ EmissionState) sdk.Dec {
+	return dailyProvisions
+}
+
+func (s linearSchedule) NonIncreasing() bool {
+	return true
+}
+
+// piecewiseSchedule sets the daily provisions from a table sorted by start time. The
+// current daily provisions are kept until the first entry starts.
+type piecewiseSchedule struct {
+	entries []PiecewiseScheduleEntry
+}
+
+func (s piecewiseSchedule) ReducedProvisions(dailyProvisions sdk.Dec) sdk.Dec {
+	return dailyProvisions
+}
+
+func (s piecewiseSchedule) CurrentProvisions(dailyProvisions sdk.Dec, state EmissionState) sdk.Dec {
+	for _, entry := range s.entries {
+		if entry.StartTime > state.BlockTime {
+			break
+		}
+		dailyProvisions = entry.DailyProvisions
+	}
+	return dailyProvisions
+}
+
+// NonIncreasing is false, as the table may raise the daily provisions.
+func (s piecewiseSchedule) NonIncreasing() bool {
+	return false
+}
+
+// targetBondedSchedule moves the inflation rate towards the maximum when the bonded ratio
+// is below the goal and towards the minimum when it is above, proportionally to the
+// elapsed time.
+type targetBondedSchedule struct {
+	goalBonded          sdk.Dec
+	inflationMin        sdk.Dec
+	inflationMax        sdk.Dec
+	inflationRateChange sdk.Dec
+}
+
+func (s targetBondedSchedule) ReducedProvisions(dailyProvisions sdk.Dec) sdk.Dec {
+	return dailyProvisions
+}
+
+func (s targetBondedSchedule) CurrentProvisions(dailyProvisions sdk.Dec, state EmissionState) sdk.Dec {
+	if !state.TotalSupply.IsPositive() {
+		return dailyProvisions
+	}
+
+	inflation := dailyProvisions.MulInt64(365).QuoInt(state.TotalSupply)
+	elapsed := state.BlockTime - state.LastMintTime
+	if elapsed > 0 {
+		change := sdk.OneDec().Sub(state.BondedRatio.Quo(s.goalBonded)).
+			Mul(s.inflationRateChange).
+			MulInt64(elapsed).
+			QuoInt64(secondsPerYear)
+		inflation = inflation.Add(change)
+	}
+
+	if inflation.GT(s.inflationMax) {
+		inflation = s.inflationMax
+	}
+	if inflation.LT(s.inflationMin) {
+		inflation = s.inflationMin
+	}
+
+	return inflation.MulInt(state.TotalSupply).QuoInt64(365)
+}
+
+func (s targetBondedSchedule) NonIncreasing() bool {
+	return false
+}
+
+// DefaultEmissionCurve returns the geometric emission curve, with the settings of the
+// other curves set to their defaults.
+func DefaultEmissionCurve() EmissionCurve {
+	return EmissionCurve{
+		CurveType:           EMISSION_CURVE_GEOMETRIC,
+		LinearReduction:     sdk.ZeroDec(),
+		GoalBonded:          sdk.NewDecWithPrec(67, 2),
+		InflationMin:        sdk.NewDecWithPrec(7, 2),
+		InflationMax:        sdk.NewDecWithPrec(20, 2),
+		InflationRateChange: sdk.NewDecWithPrec(13, 2),
+	}
+}
+
+func validateEmissionCurve(i interface{}) error {
+	v, ok := i.(EmissionCurve)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := EmissionCurveType_name[int32(v.CurveType)]; !ok {
+		return fmt.Errorf("invalid emission curve type: %d", v.CurveType)
+	}
+
+	for _, dec := range []struct {
+		name  string
+		value sdk.Dec
+	}{
+		{"linear reduction", v.LinearReduction},
+		{"goal bonded", v.GoalBonded},
+		{"inflation min", v.InflationMin},
+		{"inflation max", v.InflationMax},
+		{"inflation rate change", v.InflationRateChange},
+	} {
+		if dec.value.IsNil() || dec.value.IsNegative() {
+			return fmt.Errorf("%s should not be negative", dec.name)
+		}
+	}
+
+	if v.GoalBonded.IsZero() || v.GoalBonded.GT(sdk.OneDec()) {
+		return fmt.Errorf("goal bonded must be positive and not greater than 1: %s", v.GoalBonded)
+	}
+
+	if v.InflationMin.GT(v.InflationMax) {
+		return fmt.Errorf("inflation min (%s) cannot be greater than inflation max (%s)", v.InflationMin, v.InflationMax)
+	}
+
+	for i, entry := range v.PiecewiseSchedule {
+		if entry.DailyProvisions.IsNil() || entry.DailyProvisions.IsNegative() {
+			return fmt.Errorf("daily provisions of piecewise schedule entry %d should not be negative", i)
+		}
+		if i > 0 && entry.StartTime <= v.PiecewiseSchedule[i-1].StartTime {
+			return fmt.Errorf("piecewise schedule must be sorted by strictly increasing start time, entry %d", i)
+		}
+	}
+
+	if v.CurveType == EMISSION_CURVE_PIECEWISE && len(v.PiecewiseSchedule) == 0 {
+		return errors.New("piecewise emission curve requires a schedule")
+	}
+
+	return nil
+}
@@ -2,6 +2,36 @@ package types
 
 // Minting module event constants.
 const (
+	EventTypeMintDistribution          = "mint_distribution"
+	EventTypeMintDistributionFailed    = "mint_distribution_failed"
+	EventTypeDeveloperVestingExhausted = "developer_vesting_exhausted"
+	EventTypeEmissionSkipped           = "emission_skipped"
+	EventTypeMaxSupplyReached          = "max_supply_reached"
+	EventTypeParamsChangeScheduled     = "params_change_scheduled"
+	EventTypeParamsChangeApplied       = "params_change_applied"
+	EventTypeParamsChangeFailed        = "params_change_failed"
+	EventTypeParamsChangeCanceled      = "params_change_canceled"
+	EventTypeFeeBurn                   = "fee_burn"
+	EventTypeRegisterIncentiveStream   = "register_incentive_stream"
+	EventTypeCancelIncentiveStream     = "cancel_incentive_stream"
+	EventTypeIncentiveStreamEnded      = "incentive_stream_ended"
+
 	AttributeKeyBlockProvisions = "block_provisions"
 	AttributeBlockNumber        = "block_number"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyBalance         = "balance"
+	AttributeKeyRequested       = "requested"
+	AttributeKeySkippedSeconds  = "skipped_seconds"
+	AttributeKeySkippedAmount   = "skipped_amount"
+	AttributeKeyMaxSupply       = "max_supply"
+	AttributeKeyChangeID        = "change_id"
+	AttributeKeyActivationTime  = "activation_time"
+	AttributeKeyMinted          = "minted"
+	AttributeKeyStreamID        = "stream_id"
+	AttributeKeyShare           = "share"
+	AttributeKeyDistributed     = "distributed"
+
+	// CommunityPoolRecipient is the recipient reported in distribution events
+	// for the share funded into the community pool.
+	CommunityPoolRecipient = "community_pool"
 )
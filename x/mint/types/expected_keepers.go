@@ -18,10 +18,18 @@ type AccountKeeper interface {
 // dependencies.
 type BankKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+	BlockedAddr(addr sdk.AccAddress) bool
+}
+
+// StakingKeeper defines the contract needed to be fulfilled for the staking keeper.
+type StakingKeeper interface {
+	BondedRatio(ctx sdk.Context) sdk.Dec
 }
 
 // CommunityPoolKeeper defines the contract needed to be fulfilled for distribution keeper.
@@ -1,16 +1,27 @@
 package types
 
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewGenesisState creates a new GenesisState object.
-func NewGenesisState(params Params) *GenesisState {
+func NewGenesisState(params Params, developerVestingAmount sdk.Int) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:                 params,
+		DeveloperVestingAmount: developerVestingAmount,
+		MaxSupplyReached:       sdk.ZeroInt(),
 	}
 }
 
 // DefaultGenesisState creates a default GenesisState object.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:                 DefaultParams(),
+		DeveloperVestingAmount: sdk.ZeroInt(),
+		MaxSupplyReached:       sdk.ZeroInt(),
 	}
 }
 
@@ -20,6 +31,56 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
+	if data.DeveloperVestingAmount.IsNil() || data.DeveloperVestingAmount.IsNegative() {
+		return errors.New("developer vesting amount must be non-negative")
+	}
+	if !data.MaxSupplyReached.IsNil() && data.MaxSupplyReached.IsNegative() {
+		return errors.New("max supply reached must be non-negative")
+	}
+
+	seenIDs := make(map[uint64]bool)
+	for _, change := range data.ScheduledParamsChanges {
+		if change.Id == 0 || seenIDs[change.Id] {
+			return fmt.Errorf("invalid or duplicate scheduled params change id %d", change.Id)
+		}
+		seenIDs[change.Id] = true
 
+		if change.ActivationTime < 0 {
+			return fmt.Errorf("scheduled params change %d has a negative activation time", change.Id)
+		}
+		if err := change.Params.Validate(); err != nil {
+			return fmt.Errorf("scheduled params change %d: %w", change.Id, err)
+		}
+		if len(change.ChangedParams) == 0 {
+			return fmt.Errorf("scheduled params change %d changes no parameter", change.Id)
+		}
+		if err := validateParamKeys(change.ChangedParams); err != nil {
+			return fmt.Errorf("scheduled params change %d: %w", change.Id, err)
+		}
+	}
+
+	for i, record := range data.MintHistory {
+		if record.Amount.IsNil() || record.Staking.IsNil() || record.CommunityPool.IsNil() {
+			return fmt.Errorf("mint record %d has a nil amount", i)
+		}
+		if record.Amount.IsNegative() || record.Staking.IsNegative() || record.CommunityPool.IsNegative() {
+			return fmt.Errorf("mint record %d has a negative amount", i)
+		}
+		if record.Staking.Add(record.CommunityPool).GT(record.Amount) {
+			return fmt.Errorf("mint record %d distributes more than it minted", i)
+		}
+	}
+
+	seenStreamIDs := make(map[uint64]bool)
+	for _, stream := range data.IncentiveStreams {
+		if stream.Id == 0 || seenStreamIDs[stream.Id] {
+			return fmt.Errorf("invalid or duplicate incentive stream id %d", stream.Id)
+		}
+		seenStreamIDs[stream.Id] = true
+
+		if err := stream.Validate(); err != nil {
+			return fmt.Errorf("incentive stream %d: %w", stream.Id, err)
+		}
+	}
 	return nil
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -27,6 +28,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the paramaters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// developer_vesting_amount defines the amount of mint_denom the developer
+	// vesting module account is funded with at genesis.
+	DeveloperVestingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=developer_vesting_amount,json=developerVestingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"developer_vesting_amount" yaml:"developer_vesting_amount"`
+	// scheduled_params_changes are the pending minting parameter changes.
+	ScheduledParamsChanges []ScheduledParamsChange `protobuf:"bytes,3,rep,name=scheduled_params_changes,json=scheduledParamsChanges,proto3" json:"scheduled_params_changes" yaml:"scheduled_params_changes"`
+	// mint_history are the recorded mints, oldest first.
+	MintHistory []MintRecord `protobuf:"bytes,4,rep,name=mint_history,json=mintHistory,proto3" json:"mint_history" yaml:"mint_history"`
+	// incentive_streams are the registered incentive streams.
+	IncentiveStreams []IncentiveStream `protobuf:"bytes,5,rep,name=incentive_streams,json=incentiveStreams,proto3" json:"incentive_streams" yaml:"incentive_streams"`
+	// max_supply_reached is the max supply at which minting stopped for good,
+	// zero if minting never stopped upon reaching the max supply.
+	MaxSupplyReached github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=max_supply_reached,json=maxSupplyReached,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply_reached" yaml:"max_supply_reached"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +82,27 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetScheduledParamsChanges() []ScheduledParamsChange {
+	if m != nil {
+		return m.ScheduledParamsChanges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintHistory() []MintRecord {
+	if m != nil {
+		return m.MintHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIncentiveStreams() []IncentiveStream {
+	if m != nil {
+		return m.IncentiveStreams
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "acrechain.mint.v1beta1.GenesisState")
 }
@@ -78,21 +112,37 @@ func init() {
 }
 
 var fileDescriptor_aa878f7d5f8358ad = []byte{
-	// 209 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x4c, 0x2e, 0x4a,
-	0x4d, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xab, 0xd2, 0x03, 0xa9, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x14, 0x71, 0x98, 0x09, 0xd6, 0x0a, 0x56, 0xa2,
-	0xe4, 0xc3, 0xc5, 0xe3, 0x0e, 0xb1, 0x21, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x86, 0x8b, 0xad,
-	0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x4e, 0x0f, 0xbb,
-	0x8d, 0x7a, 0x01, 0x60, 0x55, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0xf5, 0x38, 0x79,
-	0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb,
-	0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x41, 0x7a, 0x66, 0x49, 0x46,
-	0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0xbe, 0x63, 0x51, 0x62, 0x52, 0x4e, 0x6a, 0x00, 0xc8, 0xfe,
-	0xe4, 0xfc, 0x1c, 0x7d, 0x84, 0x23, 0x2b, 0x20, 0xce, 0x2c, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62,
-	0x03, 0x3b, 0xd0, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x58, 0x53, 0xce, 0x19, 0x01, 0x00,
-	0x00,
+	// 480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6e, 0xd4, 0x3c,
+	0x14, 0xc5, 0x27, 0x5f, 0xdb, 0x91, 0xbe, 0x4c, 0x17, 0x25, 0xa0, 0x51, 0x28, 0x52, 0x66, 0x88,
+	0x10, 0x9d, 0x4d, 0x13, 0x5a, 0x76, 0x88, 0x4d, 0xc3, 0x02, 0x0a, 0x42, 0x2a, 0x19, 0x89, 0x05,
+	0x9b, 0xc8, 0x71, 0xae, 0x12, 0x8b, 0xc4, 0x8e, 0x6c, 0x4f, 0x34, 0x79, 0x06, 0x36, 0x7d, 0x28,
+	0x16, 0x5d, 0x76, 0x89, 0x58, 0x8c, 0xd0, 0xcc, 0x1b, 0xf0, 0x04, 0xc8, 0x76, 0xfa, 0x07, 0xb5,
+	0x59, 0xb0, 0x4a, 0x6c, 0x9f, 0x73, 0x7e, 0xd7, 0xb9, 0xb9, 0xf6, 0x33, 0x84, 0x39, 0xe0, 0x02,
+	0x11, 0x1a, 0x56, 0x84, 0xca, 0xb0, 0x39, 0x4a, 0x41, 0xa2, 0xa3, 0x30, 0x07, 0x0a, 0x82, 0x88,
+	0xa0, 0xe6, 0x4c, 0x32, 0x67, 0x7c, 0xad, 0x0a, 0x94, 0x2a, 0xe8, 0x54, 0xfb, 0x8f, 0x72, 0x96,
+	0x33, 0x2d, 0x09, 0xd5, 0x9b, 0x51, 0xef, 0x3f, 0xed, 0xc9, 0xd4, 0x56, 0x2d, 0xf1, 0xbf, 0xef,
+	0xd8, 0xbb, 0x6f, 0x0d, 0x62, 0x2e, 0x91, 0x04, 0xe7, 0xb5, 0x3d, 0xac, 0x11, 0x47, 0x95, 0x70,
+	0xad, 0xa9, 0x35, 0x1b, 0x1d, 0x7b, 0xc1, 0xfd, 0xc8, 0xe0, 0x4c, 0xab, 0xa2, 0xed, 0x8b, 0xd5,
+	0x64, 0x10, 0x77, 0x1e, 0xe7, 0x9b, 0x65, 0xbb, 0x19, 0x34, 0x50, 0xb2, 0x1a, 0x78, 0xd2, 0x80,
+	0x90, 0x84, 0xe6, 0x09, 0xaa, 0xd8, 0x82, 0x4a, 0xf7, 0xbf, 0xa9, 0x35, 0xfb, 0x3f, 0xfa, 0xa4,
+	0x0c, 0x3f, 0x57, 0x93, 0xe7, 0x39, 0x91, 0xc5, 0x22, 0x0d, 0x30, 0xab, 0x42, 0xcc, 0x44, 0xc5,
+	0x44, 0xf7, 0x38, 0x14, 0xd9, 0xd7, 0x50, 0xb6, 0x35, 0x88, 0xe0, 0x94, 0xca, 0xdf, 0xab, 0xc9,
+	0xa4, 0x45, 0x55, 0xf9, 0xca, 0xef, 0xcb, 0xf5, 0xe3, 0xf1, 0xf5, 0xd1, 0x67, 0x73, 0x72, 0xa2,
+	0x0f, 0x9c, 0x73, 0xcb, 0x76, 0x05, 0x2e, 0x20, 0x5b, 0x94, 0x90, 0x25, 0xa6, 0xc4, 0x04, 0x17,
+	0x88, 0xe6, 0x20, 0xdc, 0xad, 0xe9, 0xd6, 0x6c, 0x74, 0x7c, 0xd8, 0x77, 0xbd, 0xf9, 0x95, 0xcf,
+	0xdc, 0xf3, 0x8d, 0x76, 0x45, 0x07, 0xaa, 0xf8, 0x9b, 0x92, 0xfa, 0xc2, 0xfd, 0x78, 0x2c, 0xee,
+	0xf3, 0x0b, 0x27, 0xb5, 0x77, 0x15, 0x26, 0x29, 0x88, 0x90, 0x8c, 0xb7, 0xee, 0xb6, 0xae, 0xc2,
+	0xef, 0xab, 0xe2, 0x23, 0xa1, 0x32, 0x06, 0xcc, 0x78, 0x16, 0x3d, 0xe9, 0xd0, 0x0f, 0x0d, 0xfa,
+	0x76, 0x8a, 0x1f, 0x8f, 0xd4, 0xf2, 0x9d, 0x59, 0x39, 0x8d, 0xfd, 0x80, 0x50, 0x0c, 0x54, 0x92,
+	0x06, 0x12, 0x21, 0x39, 0xa8, 0x6e, 0xee, 0x68, 0xd0, 0x41, 0x1f, 0xe8, 0xf4, 0xca, 0x30, 0xd7,
+	0xfa, 0x68, 0xda, 0xd1, 0x5c, 0x43, 0xbb, 0x93, 0xe7, 0xc7, 0x7b, 0xe4, 0x6f, 0x8b, 0x70, 0x5a,
+	0xdb, 0xa9, 0xd0, 0x32, 0x11, 0x8b, 0xba, 0x2e, 0xdb, 0x84, 0x03, 0x52, 0x9f, 0xc0, 0x1d, 0xea,
+	0xae, 0x7f, 0xf8, 0xe7, 0xae, 0x3f, 0xee, 0xee, 0x79, 0x27, 0xd1, 0x8f, 0xf7, 0x2a, 0xb4, 0x9c,
+	0xeb, 0xbd, 0xd8, 0x6c, 0x45, 0xef, 0x2f, 0xd6, 0x9e, 0x75, 0xb9, 0xf6, 0xac, 0x5f, 0x6b, 0xcf,
+	0x3a, 0xdf, 0x78, 0x83, 0xcb, 0x8d, 0x37, 0xf8, 0xb1, 0xf1, 0x06, 0x5f, 0x5e, 0xdc, 0x02, 0x9e,
+	0x70, 0x94, 0x96, 0x70, 0xa6, 0x7e, 0x7c, 0xcc, 0xca, 0xf0, 0x66, 0x3a, 0x96, 0x66, 0x3e, 0x34,
+	0x3e, 0x1d, 0xea, 0xc9, 0x78, 0xf9, 0x67, 0x00, 0xf2, 0xee, 0x19, 0x2d, 0x92, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -115,6 +165,68 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxSupplyReached.Size()
+		i -= size
+		if _, err := m.MaxSupplyReached.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.IncentiveStreams) > 0 {
+		for iNdEx := len(m.IncentiveStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MintHistory) > 0 {
+		for iNdEx := len(m.MintHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ScheduledParamsChanges) > 0 {
+		for iNdEx := len(m.ScheduledParamsChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledParamsChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.DeveloperVestingAmount.Size()
+		i -= size
+		if _, err := m.DeveloperVestingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -147,6 +259,28 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DeveloperVestingAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ScheduledParamsChanges) > 0 {
+		for _, e := range m.ScheduledParamsChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintHistory) > 0 {
+		for _, e := range m.MintHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IncentiveStreams) > 0 {
+		for _, e := range m.IncentiveStreams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.MaxSupplyReached.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -218,6 +352,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperVestingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeveloperVestingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledParamsChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledParamsChanges = append(m.ScheduledParamsChanges, ScheduledParamsChange{})
+			if err := m.ScheduledParamsChanges[len(m.ScheduledParamsChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintHistory = append(m.MintHistory, MintRecord{})
+			if err := m.MintHistory[len(m.MintHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveStreams = append(m.IncentiveStreams, IncentiveStream{})
+			if err := m.IncentiveStreams[len(m.IncentiveStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupplyReached", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupplyReached.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
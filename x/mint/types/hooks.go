@@ -4,9 +4,16 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// RecipientAmount is the amount of a minted coin received by a single recipient, a
+// bech32 address, the name of a module account or the community pool.
+type RecipientAmount struct {
+	Recipient string
+	Amount    sdk.Coin
+}
+
 // MintHooks defines an interface for mint module's hooks.
 type MintHooks interface {
-	AfterDistributeMintedCoin(ctx sdk.Context)
+	AfterDistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin, distributed []RecipientAmount)
 }
 
 var _ MintHooks = MultiMintHooks{}
@@ -21,9 +28,10 @@ func NewMultiMintHooks(hooks ...MintHooks) MultiMintHooks {
 }
 
 // AfterDistributeMintedCoin is a hook that runs after minter mints and distributes coins
-// at the beginning of each block.
-func (h MultiMintHooks) AfterDistributeMintedCoin(ctx sdk.Context) {
+// at the end of each block. It receives the minted coin and the amount received by each
+// recipient.
+func (h MultiMintHooks) AfterDistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin, distributed []RecipientAmount) {
 	for i := range h {
-		h[i].AfterDistributeMintedCoin(ctx)
+		h[i].AfterDistributeMintedCoin(ctx, mintedCoin, distributed)
 	}
 }
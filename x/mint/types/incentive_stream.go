@@ -0,0 +1,59 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewIncentiveStream returns a new incentive stream that has not distributed anything yet.
+func NewIncentiveStream(id uint64, recipient string, share sdk.Dec, startTime, endTime int64) IncentiveStream {
+	return IncentiveStream{
+		Id:          id,
+		Recipient:   recipient,
+		Share:       share,
+		StartTime:   startTime,
+		EndTime:     endTime,
+		Distributed: sdk.ZeroInt(),
+	}
+}
+
+// IsActive returns whether the stream is paid out at the given unix time.
+func (s IncentiveStream) IsActive(time int64) bool {
+	return s.StartTime <= time && time < s.EndTime
+}
+
+// Overlaps returns whether the streams are active at the same time at some point.
+func (s IncentiveStream) Overlaps(other IncentiveStream) bool {
+	return s.StartTime < other.EndTime && other.StartTime < s.EndTime
+}
+
+// Validate performs a stateless check of the stream fields.
+func (s IncentiveStream) Validate() error {
+	if err := validateIncentiveStream(s.Recipient, s.Share, s.StartTime, s.EndTime); err != nil {
+		return err
+	}
+
+	if s.Distributed.IsNil() || s.Distributed.IsNegative() {
+		return errors.New("incentive stream distributed amount must be non-negative")
+	}
+
+	return nil
+}
+
+func validateIncentiveStream(recipient string, share sdk.Dec, startTime, endTime int64) error {
+	if err := validateRecipient(recipient); err != nil {
+		return fmt.Errorf("invalid incentive stream recipient: %w", err)
+	}
+
+	if share.IsNil() || !share.IsPositive() || share.GT(sdk.OneDec()) {
+		return fmt.Errorf("incentive stream share must be between 0 (exclusive) and 1: %s", share)
+	}
+
+	if startTime < 0 || endTime <= startTime {
+		return fmt.Errorf("incentive stream must end after it starts: start %d, end %d", startTime, endTime)
+	}
+
+	return nil
+}
@@ -1,8 +1,52 @@
 package types
 
-// MinterKey is the key to use for the keeper store at which
-// the Minter and its DailyProvisions are stored.
-var MinterKey = []byte{0x00}
+import "encoding/binary"
+
+var (
+	// MinterKey is the key to use for the keeper store at which
+	// the Minter and its DailyProvisions are stored.
+	MinterKey = []byte{0x00}
+
+	// PreviousDailyProvisionsKey is the key at which the daily provisions
+	// before the last mint are stored.
+	PreviousDailyProvisionsKey = []byte{0x01}
+
+	// MintedTotalKey is the key at which the cumulative amount of the mint
+	// denom emitted by the module is stored.
+	MintedTotalKey = []byte{0x02}
+
+	// MintSupplyBaselineKey is the key at which the supply of the mint denom
+	// that was not emitted by the module is stored.
+	MintSupplyBaselineKey = []byte{0x03}
+
+	// ScheduledParamsChangeKeyPrefix is the prefix of the keys at which the
+	// pending parameter changes are stored, ordered by activation time.
+	ScheduledParamsChangeKeyPrefix = []byte{0x04}
+
+	// NextScheduledParamsChangeIDKey is the key at which the identifier of the
+	// next scheduled parameter change is stored.
+	NextScheduledParamsChangeIDKey = []byte{0x05}
+
+	// MintHistoryKeyPrefix is the prefix of the keys at which the mint records
+	// are stored, ordered by sequence.
+	MintHistoryKeyPrefix = []byte{0x06}
+
+	// MintHistoryBoundsKey is the key at which the sequences of the oldest and
+	// of the next mint record are stored.
+	MintHistoryBoundsKey = []byte{0x07}
+
+	// IncentiveStreamKeyPrefix is the prefix of the keys at which the incentive
+	// streams are stored, ordered by identifier.
+	IncentiveStreamKeyPrefix = []byte{0x08}
+
+	// NextIncentiveStreamIDKey is the key at which the identifier of the next
+	// incentive stream is stored.
+	NextIncentiveStreamIDKey = []byte{0x09}
+
+	// MaxSupplyReachedKey is the key at which the max supply at which minting
+	// stopped is stored.
+	MaxSupplyReachedKey = []byte{0x0A}
+)
 
 const (
 	// ModuleName is the module name.
@@ -13,4 +57,45 @@ const (
 
 	// QuerierRoute is the querier route for the minting store.
 	QuerierRoute = StoreKey
+
+	// RouterKey is the message route for the minting module.
+	RouterKey = ModuleName
+
+	// DeveloperVestingModuleAcctName is the module account name holding the
+	// developer vesting funds, which are paid out instead of being minted.
+	DeveloperVestingModuleAcctName = "developer_vesting_unvested"
+
+	// MaxProjectedPeriods is the maximum number of reduction periods walked
+	// when projecting the emission schedule.
+	MaxProjectedPeriods = 100
+
+	// MaxMintHistoryPrunedPerBlock is the maximum number of mint records pruned
+	// in a single block, so that lowering the retention does not stall a block.
+	MaxMintHistoryPrunedPerBlock = 1_000
 )
+
+// ScheduledParamsChangeKey returns the key of a scheduled parameter change,
+// which sorts by activation time and then by identifier.
+func ScheduledParamsChangeKey(activationTime int64, id uint64) []byte {
+	key := make([]byte, len(ScheduledParamsChangeKeyPrefix)+16)
+	copy(key, ScheduledParamsChangeKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(ScheduledParamsChangeKeyPrefix):], uint64(activationTime))
+	binary.BigEndian.PutUint64(key[len(ScheduledParamsChangeKeyPrefix)+8:], id)
+	return key
+}
+
+// MintHistoryKey returns the key of the mint record with the given sequence.
+func MintHistoryKey(sequence uint64) []byte {
+	key := make([]byte, len(MintHistoryKeyPrefix)+8)
+	copy(key, MintHistoryKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(MintHistoryKeyPrefix):], sequence)
+	return key
+}
+
+// IncentiveStreamKey returns the key of the incentive stream with the given identifier.
+func IncentiveStreamKey(id uint64) []byte {
+	key := make([]byte, len(IncentiveStreamKeyPrefix)+8)
+	copy(key, IncentiveStreamKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(IncentiveStreamKeyPrefix):], id)
+	return key
+}
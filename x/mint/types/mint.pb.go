@@ -27,6 +27,76 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// EmissionCurveType enumerates the supported emission curves.
+type EmissionCurveType int32
+
+const (
+	// EMISSION_CURVE_GEOMETRIC multiplies the daily provisions by the reduction
+	// factor on each reduction period.
+	EMISSION_CURVE_GEOMETRIC EmissionCurveType = 0
+	// EMISSION_CURVE_LINEAR decreases the daily provisions by a fixed amount on
+	// each reduction period.
+	EMISSION_CURVE_LINEAR EmissionCurveType = 1
+	// EMISSION_CURVE_PIECEWISE sets the daily provisions from an explicit table
+	// of timestamps.
+	EMISSION_CURVE_PIECEWISE EmissionCurveType = 2
+	// EMISSION_CURVE_TARGET_BONDED adjusts the inflation rate towards the goal
+	// bonded ratio.
+	EMISSION_CURVE_TARGET_BONDED EmissionCurveType = 3
+)
+
+var EmissionCurveType_name = map[int32]string{
+	0: "EMISSION_CURVE_GEOMETRIC",
+	1: "EMISSION_CURVE_LINEAR",
+	2: "EMISSION_CURVE_PIECEWISE",
+	3: "EMISSION_CURVE_TARGET_BONDED",
+}
+
+var EmissionCurveType_value = map[string]int32{
+	"EMISSION_CURVE_GEOMETRIC":     0,
+	"EMISSION_CURVE_LINEAR":        1,
+	"EMISSION_CURVE_PIECEWISE":     2,
+	"EMISSION_CURVE_TARGET_BONDED": 3,
+}
+
+func (x EmissionCurveType) String() string {
+	return proto.EnumName(EmissionCurveType_name, int32(x))
+}
+
+func (EmissionCurveType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2fa6c02acf2a0105, []int{0}
+}
+
+// MintingMode enumerates the points of the block at which the module mints.
+type MintingMode int32
+
+const (
+	// MINTING_MODE_END_BLOCK mints in EndBlock, after the transactions of the
+	// block. The staking rewards are distributed in the next block.
+	MINTING_MODE_END_BLOCK MintingMode = 0
+	// MINTING_MODE_BEGIN_BLOCK mints in BeginBlock, before the distribution
+	// module, so that the staking rewards are distributed in the same block.
+	MINTING_MODE_BEGIN_BLOCK MintingMode = 1
+)
+
+var MintingMode_name = map[int32]string{
+	0: "MINTING_MODE_END_BLOCK",
+	1: "MINTING_MODE_BEGIN_BLOCK",
+}
+
+var MintingMode_value = map[string]int32{
+	"MINTING_MODE_END_BLOCK":   0,
+	"MINTING_MODE_BEGIN_BLOCK": 1,
+}
+
+func (x MintingMode) String() string {
+	return proto.EnumName(MintingMode_name, int32(x))
+}
+
+func (MintingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2fa6c02acf2a0105, []int{1}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// last mint time
@@ -75,17 +145,194 @@ func (m *Minter) GetLastMintTime() int64 {
 	return 0
 }
 
+// PiecewiseScheduleEntry defines the daily provisions from a given time on.
+type PiecewiseScheduleEntry struct {
+	// start_time is the unix time the daily provisions apply from.
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty" yaml:"start_time"`
+	// daily_provisions is the daily provisions value from start_time on.
+	DailyProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=daily_provisions,json=dailyProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"daily_provisions" yaml:"daily_provisions"`
+}
+
+func (m *PiecewiseScheduleEntry) Reset()         { *m = PiecewiseScheduleEntry{} }
+func (m *PiecewiseScheduleEntry) String() string { return proto.CompactTextString(m) }
+func (*PiecewiseScheduleEntry) ProtoMessage()    {}
+func (*PiecewiseScheduleEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fa6c02acf2a0105, []int{1}
+}
+func (m *PiecewiseScheduleEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PiecewiseScheduleEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PiecewiseScheduleEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PiecewiseScheduleEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PiecewiseScheduleEntry.Merge(m, src)
+}
+func (m *PiecewiseScheduleEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PiecewiseScheduleEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PiecewiseScheduleEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PiecewiseScheduleEntry proto.InternalMessageInfo
+
+func (m *PiecewiseScheduleEntry) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+// EmissionCurve defines the curve the daily provisions follow over time
+// together with the settings of every supported curve.
+type EmissionCurve struct {
+	// curve_type is the emission curve in use.
+	CurveType EmissionCurveType `protobuf:"varint,1,opt,name=curve_type,json=curveType,proto3,enum=acrechain.mint.v1beta1.EmissionCurveType" json:"curve_type,omitempty" yaml:"curve_type"`
+	// linear_reduction is the amount the daily provisions decrease by on each
+	// reduction period of the linear curve.
+	LinearReduction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=linear_reduction,json=linearReduction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"linear_reduction" yaml:"linear_reduction"`
+	// piecewise_schedule is the table of the piecewise curve, sorted by start
+	// time.
+	PiecewiseSchedule []PiecewiseScheduleEntry `protobuf:"bytes,3,rep,name=piecewise_schedule,json=piecewiseSchedule,proto3" json:"piecewise_schedule" yaml:"piecewise_schedule"`
+	// goal_bonded is the bonded ratio targeted by the target bonded curve.
+	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded" yaml:"goal_bonded"`
+	// inflation_min is the minimum inflation rate of the target bonded curve.
+	InflationMin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=inflation_min,json=inflationMin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_min" yaml:"inflation_min"`
+	// inflation_max is the maximum inflation rate of the target bonded curve.
+	InflationMax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=inflation_max,json=inflationMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_max" yaml:"inflation_max"`
+	// inflation_rate_change is the maximum annual change of the inflation rate
+	// of the target bonded curve.
+	InflationRateChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=inflation_rate_change,json=inflationRateChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_rate_change" yaml:"inflation_rate_change"`
+}
+
+func (m *EmissionCurve) Reset()         { *m = EmissionCurve{} }
+func (m *EmissionCurve) String() string { return proto.CompactTextString(m) }
+func (*EmissionCurve) ProtoMessage()    {}
+func (*EmissionCurve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fa6c02acf2a0105, []int{2}
+}
+func (m *EmissionCurve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionCurve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionCurve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionCurve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionCurve.Merge(m, src)
+}
+func (m *EmissionCurve) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionCurve) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionCurve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionCurve proto.InternalMessageInfo
+
+func (m *EmissionCurve) GetCurveType() EmissionCurveType {
+	if m != nil {
+		return m.CurveType
+	}
+	return EMISSION_CURVE_GEOMETRIC
+}
+
+func (m *EmissionCurve) GetPiecewiseSchedule() []PiecewiseScheduleEntry {
+	if m != nil {
+		return m.PiecewiseSchedule
+	}
+	return nil
+}
+
+// WeightedRecipient defines a recipient of a share of the minted coins.
+type WeightedRecipient struct {
+	// recipient is either a bech32 account address or the name of a module
+	// account.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty" yaml:"recipient"`
+	// weight defines the proportion of the minted coins sent to the recipient.
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *WeightedRecipient) Reset()         { *m = WeightedRecipient{} }
+func (m *WeightedRecipient) String() string { return proto.CompactTextString(m) }
+func (*WeightedRecipient) ProtoMessage()    {}
+func (*WeightedRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fa6c02acf2a0105, []int{3}
+}
+func (m *WeightedRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedRecipient.Merge(m, src)
+}
+func (m *WeightedRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedRecipient proto.InternalMessageInfo
+
+func (m *WeightedRecipient) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
 type DistributionProportions struct {
 	// staking defines the proportion of the minted minted_denom that is to be
 	// allocated as staking rewards.
 	Staking github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=staking,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"staking"`
+	// community_pool defines the proportion of the minted minted_denom that is
+	// to be allocated to the community pool.
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool" yaml:"community_pool"`
+	// weighted_recipients defines the additional recipients of the minted
+	// minted_denom (e.g. a dev fund or an ecosystem incentives address) together
+	// with their proportions.
+	WeightedRecipients []WeightedRecipient `protobuf:"bytes,3,rep,name=weighted_recipients,json=weightedRecipients,proto3" json:"weighted_recipients" yaml:"weighted_recipients"`
+	// developer_rewards defines the proportion of the minted minted_denom that
+	// is paid out of the developer vesting module account to the developer
+	// rewards receivers.
+	DeveloperRewards github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=developer_rewards,json=developerRewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"developer_rewards" yaml:"developer_rewards"`
 }
 
 func (m *DistributionProportions) Reset()         { *m = DistributionProportions{} }
 func (m *DistributionProportions) String() string { return proto.CompactTextString(m) }
 func (*DistributionProportions) ProtoMessage()    {}
 func (*DistributionProportions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2fa6c02acf2a0105, []int{1}
+	return fileDescriptor_2fa6c02acf2a0105, []int{4}
 }
 func (m *DistributionProportions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -114,6 +361,13 @@ func (m *DistributionProportions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DistributionProportions proto.InternalMessageInfo
 
+func (m *DistributionProportions) GetWeightedRecipients() []WeightedRecipient {
+	if m != nil {
+		return m.WeightedRecipients
+	}
+	return nil
+}
+
 // Params holds parameters for the mint module.
 type Params struct {
 	// type of coin to mint
@@ -130,12 +384,33 @@ type Params struct {
 	NextRewardsReductionTime int64 `protobuf:"varint,6,opt,name=next_rewards_reduction_time,json=nextRewardsReductionTime,proto3" json:"next_rewards_reduction_time,omitempty"`
 	// the time to start providing minter rewards
 	MintingRewardsDistributionStartTime int64 `protobuf:"varint,7,opt,name=minting_rewards_distribution_start_time,json=mintingRewardsDistributionStartTime,proto3" json:"minting_rewards_distribution_start_time,omitempty"`
+	// weighted_developer_rewards_receivers defines the accounts receiving the
+	// developer rewards. An empty recipient funds the community pool instead.
+	WeightedDeveloperRewardsReceivers []WeightedRecipient `protobuf:"bytes,8,rep,name=weighted_developer_rewards_receivers,json=weightedDeveloperRewardsReceivers,proto3" json:"weighted_developer_rewards_receivers" yaml:"weighted_developer_rewards_receivers"`
+	// emission_curve defines the curve the daily provisions follow over time.
+	EmissionCurve EmissionCurve `protobuf:"bytes,9,opt,name=emission_curve,json=emissionCurve,proto3" json:"emission_curve" yaml:"emission_curve"`
+	// max_elapsed_seconds caps the time a single block mints for, so that the
+	// first block after a halt does not mint the whole backlog. The emission of
+	// the time beyond the cap is skipped. Zero disables the cap.
+	MaxElapsedSeconds int64 `protobuf:"varint,10,opt,name=max_elapsed_seconds,json=maxElapsedSeconds,proto3" json:"max_elapsed_seconds,omitempty" yaml:"max_elapsed_seconds"`
+	// max_supply is the hard cap on the total supply of the mint denom. Minting
+	// stops permanently once it is reached. Zero disables the cap.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply" yaml:"max_supply"`
+	// mint_history_retention is the number of mint records kept in the mint
+	// history, the oldest ones being pruned. Zero disables the history.
+	MintHistoryRetention uint64 `protobuf:"varint,12,opt,name=mint_history_retention,json=mintHistoryRetention,proto3" json:"mint_history_retention,omitempty" yaml:"mint_history_retention"`
+	// fee_burn_fraction is the fraction of the mint denom fees collected in the
+	// fee collector that is burned in every block that mints, offsetting the
+	// emission. Zero disables the burn.
+	FeeBurnFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=fee_burn_fraction,json=feeBurnFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_burn_fraction" yaml:"fee_burn_fraction"`
+	// minting_mode is the point of the block at which the module mints.
+	MintingMode MintingMode `protobuf:"varint,14,opt,name=minting_mode,json=mintingMode,proto3,enum=acrechain.mint.v1beta1.MintingMode" json:"minting_mode,omitempty" yaml:"minting_mode"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2fa6c02acf2a0105, []int{2}
+	return fileDescriptor_2fa6c02acf2a0105, []int{5}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -199,302 +474,3942 @@ func (m *Params) GetMintingRewardsDistributionStartTime() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Minter)(nil), "acrechain.mint.v1beta1.Minter")
-	proto.RegisterType((*DistributionProportions)(nil), "acrechain.mint.v1beta1.DistributionProportions")
-	proto.RegisterType((*Params)(nil), "acrechain.mint.v1beta1.Params")
+func (m *Params) GetWeightedDeveloperRewardsReceivers() []WeightedRecipient {
+	if m != nil {
+		return m.WeightedDeveloperRewardsReceivers
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("acrechain/mint/v1beta1/mint.proto", fileDescriptor_2fa6c02acf2a0105) }
+func (m *Params) GetEmissionCurve() EmissionCurve {
+	if m != nil {
+		return m.EmissionCurve
+	}
+	return EmissionCurve{}
+}
 
-var fileDescriptor_2fa6c02acf2a0105 = []byte{
-	// 538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4d, 0x6f, 0xd3, 0x30,
-	0x18, 0xc7, 0x1b, 0x56, 0x3a, 0xcd, 0x20, 0x40, 0x11, 0xda, 0xc2, 0x26, 0xd2, 0x52, 0x10, 0xf4,
-	0x42, 0xc2, 0xe0, 0x86, 0xb4, 0x03, 0x55, 0x85, 0x00, 0x09, 0xa9, 0xca, 0x76, 0x81, 0x4b, 0xe4,
-	0xd8, 0x5e, 0x6a, 0x2d, 0xf1, 0x13, 0xd9, 0xce, 0x58, 0x3f, 0x02, 0x37, 0x8e, 0x1c, 0xf9, 0x18,
-	0x7c, 0x84, 0x1d, 0x77, 0x44, 0x1c, 0x26, 0xd4, 0x7e, 0x11, 0x64, 0x27, 0x4d, 0xbb, 0x89, 0x1d,
-	0x40, 0x9c, 0x12, 0x3f, 0xcf, 0xcf, 0x7f, 0x3f, 0x6f, 0x36, 0x7a, 0x80, 0x89, 0x64, 0x64, 0x82,
-	0xb9, 0x08, 0x73, 0x2e, 0x74, 0x78, 0xbc, 0x9b, 0x30, 0x8d, 0x77, 0xed, 0x22, 0x28, 0x24, 0x68,
-	0x70, 0x37, 0x1b, 0x24, 0xb0, 0xd6, 0x1a, 0xd9, 0xbe, 0x9b, 0x42, 0x0a, 0x16, 0x09, 0xcd, 0x5f,
-	0x45, 0x6f, 0x77, 0x53, 0x80, 0x34, 0x63, 0xa1, 0x5d, 0x25, 0xe5, 0x61, 0xa8, 0x79, 0xce, 0x94,
-	0xc6, 0x79, 0x51, 0x03, 0xf7, 0x2e, 0x03, 0x58, 0x4c, 0x6b, 0x97, 0x7f, 0xd9, 0x45, 0x4b, 0x89,
-	0x35, 0x07, 0x51, 0xf9, 0xfb, 0x9f, 0x1d, 0xd4, 0x79, 0xcf, 0x85, 0x66, 0xd2, 0x7d, 0x84, 0x6e,
-	0x65, 0x58, 0xe9, 0xd8, 0x44, 0x14, 0x9b, 0x23, 0x3c, 0xa7, 0xe7, 0x0c, 0xd6, 0xa2, 0x9b, 0xc6,
-	0x6a, 0x98, 0x03, 0x9e, 0x33, 0xf7, 0x03, 0xba, 0x43, 0x31, 0xcf, 0xa6, 0x71, 0x21, 0xe1, 0x98,
-	0x2b, 0x0e, 0x42, 0x79, 0xd7, 0x7a, 0xce, 0x60, 0x63, 0x18, 0x9c, 0x9e, 0x77, 0x5b, 0x3f, 0xcf,
-	0xbb, 0x8f, 0x53, 0xae, 0x27, 0x65, 0x12, 0x10, 0xc8, 0x43, 0x02, 0x2a, 0x07, 0x55, 0x7f, 0x9e,
-	0x2a, 0x7a, 0x14, 0xea, 0x69, 0xc1, 0x54, 0x30, 0x62, 0x24, 0xba, 0x6d, 0x75, 0xc6, 0x8d, 0x4c,
-	0x9f, 0xa0, 0xad, 0x11, 0x57, 0x5a, 0xf2, 0xa4, 0x34, 0x11, 0x8e, 0x25, 0x14, 0x20, 0xcd, 0x9f,
-	0x72, 0xdf, 0xa0, 0x75, 0xa5, 0xf1, 0x11, 0x17, 0xa9, 0x0d, 0xea, 0xef, 0x0f, 0x5b, 0x6c, 0xef,
-	0x7f, 0x6f, 0xa3, 0xce, 0x18, 0x4b, 0x9c, 0x2b, 0xf7, 0x3e, 0x42, 0x36, 0x57, 0xca, 0x04, 0xe4,
-	0x95, 0x6e, 0xb4, 0x61, 0x2c, 0x23, 0x63, 0x70, 0x27, 0xc8, 0x4b, 0x99, 0x60, 0x8a, 0xab, 0xf8,
-	0x3f, 0x65, 0xbc, 0x59, 0xeb, 0x8d, 0x2e, 0x26, 0xee, 0xee, 0xa1, 0x1d, 0xc9, 0x68, 0x49, 0x4c,
-	0xae, 0x71, 0xc1, 0x24, 0x07, 0x1a, 0x73, 0x11, 0x2b, 0x46, 0x40, 0x50, 0xe5, 0xad, 0xd9, 0x36,
-	0x78, 0x0d, 0x32, 0xb6, 0xc4, 0x5b, 0xb1, 0x5f, 0xf9, 0x4d, 0x4b, 0x96, 0xdb, 0x0f, 0x31, 0xd1,
-	0x20, 0xbd, 0xf6, 0xbf, 0xb5, 0xa4, 0xd1, 0x79, 0x6d, 0x65, 0xdc, 0x02, 0x79, 0x74, 0xa5, 0x25,
-	0xa6, 0x04, 0x8b, 0x9e, 0x78, 0xd7, 0x7b, 0xce, 0xe0, 0xc6, 0xf3, 0x30, 0xf8, 0xf3, 0x2c, 0x07,
-	0x57, 0xb4, 0x72, 0xd8, 0x36, 0x31, 0x45, 0x5b, 0xf4, 0x8a, 0x4e, 0xef, 0xa1, 0x1d, 0xc1, 0x4e,
-	0x74, 0x2c, 0xd9, 0x27, 0x2c, 0xa9, 0x8a, 0x97, 0x99, 0xd9, 0x91, 0xec, 0x54, 0xb5, 0x30, 0x48,
-	0x54, 0x11, 0xd1, 0x02, 0xb0, 0xe3, 0x79, 0x80, 0x9e, 0x98, 0x28, 0xb8, 0x48, 0x1b, 0x85, 0x0b,
-	0x09, 0x28, 0x8d, 0x65, 0x3d, 0xdd, 0xeb, 0x56, 0xea, 0x61, 0x8d, 0xd7, 0x6a, 0xab, 0x51, 0xef,
-	0x1b, 0xd6, 0xa8, 0xbe, 0x6c, 0x7f, 0xfd, 0xd6, 0x6d, 0x0d, 0xdf, 0x9d, 0xce, 0x7c, 0xe7, 0x6c,
-	0xe6, 0x3b, 0xbf, 0x66, 0xbe, 0xf3, 0x65, 0xee, 0xb7, 0xce, 0xe6, 0x7e, 0xeb, 0xc7, 0xdc, 0x6f,
-	0x7d, 0x7c, 0xb6, 0x52, 0xdf, 0x57, 0x12, 0x27, 0x19, 0x1b, 0x9b, 0xdb, 0x45, 0x20, 0x0b, 0x97,
-	0x8f, 0xc1, 0x49, 0xf5, 0x1c, 0xd8, 0x6a, 0x27, 0x1d, 0x7b, 0xfd, 0x5e, 0xfc, 0x0e, 0x00, 0x00,
-	0xff, 0xff, 0x8f, 0x8a, 0x2d, 0xc5, 0x2d, 0x04, 0x00, 0x00,
+func (m *Params) GetMaxElapsedSeconds() int64 {
+	if m != nil {
+		return m.MaxElapsedSeconds
+	}
+	return 0
 }
 
-func (m *Minter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetMintHistoryRetention() uint64 {
+	if m != nil {
+		return m.MintHistoryRetention
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetMintingMode() MintingMode {
+	if m != nil {
+		return m.MintingMode
+	}
+	return MINTING_MODE_END_BLOCK
 }
 
-func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.DailyProvisions.Size()
-		i -= size
-		if _, err := m.DailyProvisions.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+// ProjectedPeriod defines the expected emission of a single reduction period.
+type ProjectedPeriod struct {
+	// start_time is the unix time the period starts at.
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the unix time the period ends at.
+	EndTime int64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// daily_provisions is the daily provisions value during the period.
+	DailyProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=daily_provisions,json=dailyProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"daily_provisions"`
+	// emission is the expected amount minted during the period.
+	Emission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=emission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"emission"`
+}
+
+func (m *ProjectedPeriod) Reset()         { *m = ProjectedPeriod{} }
+func (m *ProjectedPeriod) String() string { return proto.CompactTextString(m) }
+func (*ProjectedPeriod) ProtoMessage()    {}
+func (*ProjectedPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fa6c02acf2a0105, []int{6}
+}
+func (m *ProjectedPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectedPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectedPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.LastMintTime != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.LastMintTime))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *ProjectedPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectedPeriod.Merge(m, src)
+}
+func (m *ProjectedPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectedPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectedPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectedPeriod proto.InternalMessageInfo
+
+func (m *ProjectedPeriod) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProjectedPeriod) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DistributionProportions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ScheduledParamsChange defines a change of the minting parameters that takes
+// effect once its activation time is reached.
+type ScheduledParamsChange struct {
+	// id is the unique identifier of the change.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// activation_time is the unix time the params take effect at.
+	ActivationTime int64 `protobuf:"varint,2,opt,name=activation_time,json=activationTime,proto3" json:"activation_time,omitempty" yaml:"activation_time"`
+	// params are the minting parameters the change was scheduled with.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// changed_params are the keys of the parameters that differed from the
+	// current ones when the change was scheduled. Only these are applied, so
+	// that other parameter changes made in the meantime are kept.
+	ChangedParams []string `protobuf:"bytes,4,rep,name=changed_params,json=changedParams,proto3" json:"changed_params,omitempty" yaml:"changed_params"`
 }
 
-func (m *DistributionProportions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Staking.Size()
-		i -= size
-		if _, err := m.Staking.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *ScheduledParamsChange) Reset()         { *m = ScheduledParamsChange{} }
+func (m *ScheduledParamsChange) String() string { return proto.CompactTextString(m) }
+func (*ScheduledParamsChange) ProtoMessage()    {}
+func (*ScheduledParamsChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fa6c02acf2a0105, []int{7}
+}
+func (m *ScheduledParamsChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledParamsChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledParamsChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *ScheduledParamsChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledParamsChange.Merge(m, src)
+}
+func (m *ScheduledParamsChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledParamsChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledParamsChange.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScheduledParamsChange proto.InternalMessageInfo
+
+func (m *ScheduledParamsChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScheduledParamsChange) GetActivationTime() int64 {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MintingRewardsDistributionStartTime != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.MintingRewardsDistributionStartTime))
-		i--
-		dAtA[i] = 0x38
+func (m *ScheduledParamsChange) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	if m.NextRewardsReductionTime != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.NextRewardsReductionTime))
-		i--
-		dAtA[i] = 0x30
+	return Params{}
+}
+
+func (m *ScheduledParamsChange) GetChangedParams() []string {
+	if m != nil {
+		return m.ChangedParams
 	}
-	{
-		size, err := m.DistributionProportions.MarshalToSizedBuffer(dAtA[:i])
+	return nil
+}
+
+// UpdateParamsProposal is a gov Content type to update the minting parameters,
+// either immediately or at a future activation time.
+type UpdateParamsProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// params are the new minting parameters
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// activation_time is the unix time the params take effect at. Zero applies
+	// them as soon as the proposal passes.
+	ActivationTime int64 `protobuf:"varint,4,opt,name=activation_time,json=activationTime,proto3" json:"activation_time,omitempty" yaml:"activation_time"`
+}
+
+func (m *UpdateParamsProposal) Reset()         { *m = UpdateParamsProposal{} }
+func (m *UpdateParamsProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateParamsProposal) ProtoMessage()    {}
+func (*UpdateParamsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fa6c02acf2a0105, []int{8}
+}
+func (m *UpdateParamsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateParamsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateParamsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMint(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.ReductionFactor.Size()
-		i -= size
-		if _, err := m.ReductionFactor.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *UpdateParamsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateParamsProposal.Merge(m, src)
+}
+func (m *UpdateParamsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateParamsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateParamsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateParamsProposal proto.InternalMessageInfo
+
+func (m *UpdateParamsProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *UpdateParamsProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *UpdateParamsProposal) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *UpdateParamsProposal) GetActivationTime() int64 {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return 0
+}
+
+// MintRecord defines the outcome of a single mint in the mint history.
+type MintRecord struct {
+	// height is the block height of the mint.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the unix time of the mint.
+	Time int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// amount is the amount of the mint denom minted.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// staking is the share of the mint sent to the fee collector for staking
+	// rewards.
+	Staking github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=staking,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"staking"`
+	// community_pool is the share of the mint funded into the community pool.
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"community_pool" yaml:"community_pool"`
+}
+
+func (m *MintRecord) Reset()         { *m = MintRecord{} }
+func (m *MintRecord) String() string { return proto.CompactTextString(m) }
+func (*MintRecord) ProtoMessage()    {}
+func (*MintRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fa6c02acf2a0105, []int{9}
+}
+func (m *MintRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.ReductionPeriodInSeconds != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.ReductionPeriodInSeconds))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *MintRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintRecord.Merge(m, src)
+}
+func (m *MintRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintRecord proto.InternalMessageInfo
+
+func (m *MintRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	{
-		size := m.GenesisDailyProvisions.Size()
-		i -= size
-		if _, err := m.GenesisDailyProvisions.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+func (m *MintRecord) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// IncentiveStream defines a share of the emission paid to a recipient during a
+// limited time.
+type IncentiveStream struct {
+	// id is the unique identifier of the stream.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// recipient is the bech32 address or the module account name receiving the
+	// stream.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// share is the fraction of each mint paid to the recipient. It is taken from
+	// the community pool share.
+	Share github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=share,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"share"`
+	// start_time is the unix time the stream starts at.
+	StartTime int64 `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty" yaml:"start_time"`
+	// end_time is the unix time the stream ends at.
+	EndTime int64 `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty" yaml:"end_time"`
+	// distributed is the amount paid to the recipient so far.
+	Distributed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=distributed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"distributed"`
+}
+
+func (m *IncentiveStream) Reset()         { *m = IncentiveStream{} }
+func (m *IncentiveStream) String() string { return proto.CompactTextString(m) }
+func (*IncentiveStream) ProtoMessage()    {}
+func (*IncentiveStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fa6c02acf2a0105, []int{10}
+}
+func (m *IncentiveStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.MintDenom) > 0 {
-		i -= len(m.MintDenom)
-		copy(dAtA[i:], m.MintDenom)
-		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *IncentiveStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveStream.Merge(m, src)
+}
+func (m *IncentiveStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveStream proto.InternalMessageInfo
+
+func (m *IncentiveStream) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMint(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *IncentiveStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Minter) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *IncentiveStream) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
 	}
-	var l int
-	_ = l
-	if m.LastMintTime != 0 {
-		n += 1 + sovMint(uint64(m.LastMintTime))
+	return 0
+}
+
+func (m *IncentiveStream) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
 	}
-	l = m.DailyProvisions.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
+	return 0
 }
 
-func (m *DistributionProportions) Size() (n int) {
-	if m == nil {
-		return 0
+// RegisterIncentiveStreamProposal is a gov Content type to register an
+// incentive stream funded from the emission.
+type RegisterIncentiveStreamProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// recipient is the bech32 address or the module account name receiving the
+	// stream.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// share is the fraction of each mint paid to the recipient.
+	Share github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=share,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"share"`
+	// start_time is the unix time the stream starts at.
+	StartTime int64 `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty" yaml:"start_time"`
+	// end_time is the unix time the stream ends at.
+	EndTime int64 `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty" yaml:"end_time"`
+}
+
+func (m *RegisterIncentiveStreamProposal) Reset()         { *m = RegisterIncentiveStreamProposal{} }
+func (m *RegisterIncentiveStreamProposal) String() string { return proto.CompactTextString(m) }
+func (*RegisterIncentiveStreamProposal) ProtoMessage()    {}
+func (*RegisterIncentiveStreamProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fa6c02acf2a0105, []int{11}
+}
+func (m *RegisterIncentiveStreamProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterIncentiveStreamProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterIncentiveStreamProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Staking.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
+}
+func (m *RegisterIncentiveStreamProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterIncentiveStreamProposal.Merge(m, src)
+}
+func (m *RegisterIncentiveStreamProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterIncentiveStreamProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterIncentiveStreamProposal.DiscardUnknown(m)
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_RegisterIncentiveStreamProposal proto.InternalMessageInfo
+
+func (m *RegisterIncentiveStreamProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	var l int
-	_ = l
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
+	return ""
+}
+
+func (m *RegisterIncentiveStreamProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	l = m.GenesisDailyProvisions.Size()
-	n += 1 + l + sovMint(uint64(l))
-	if m.ReductionPeriodInSeconds != 0 {
-		n += 1 + sovMint(uint64(m.ReductionPeriodInSeconds))
+	return ""
+}
+
+func (m *RegisterIncentiveStreamProposal) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	l = m.ReductionFactor.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.DistributionProportions.Size()
-	n += 1 + l + sovMint(uint64(l))
-	if m.NextRewardsReductionTime != 0 {
-		n += 1 + sovMint(uint64(m.NextRewardsReductionTime))
+	return ""
+}
+
+func (m *RegisterIncentiveStreamProposal) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
 	}
-	if m.MintingRewardsDistributionStartTime != 0 {
-		n += 1 + sovMint(uint64(m.MintingRewardsDistributionStartTime))
+	return 0
+}
+
+func (m *RegisterIncentiveStreamProposal) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
 	}
-	return n
+	return 0
 }
 
-func sovMint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// CancelIncentiveStreamProposal is a gov Content type to stop an incentive
+// stream before its end time.
+type CancelIncentiveStreamProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// stream_id is the identifier of the stream to cancel.
+	StreamId uint64 `protobuf:"varint,3,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 }
-func sozMint(x uint64) (n int) {
-	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *CancelIncentiveStreamProposal) Reset()         { *m = CancelIncentiveStreamProposal{} }
+func (m *CancelIncentiveStreamProposal) String() string { return proto.CompactTextString(m) }
+func (*CancelIncentiveStreamProposal) ProtoMessage()    {}
+func (*CancelIncentiveStreamProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fa6c02acf2a0105, []int{12}
 }
-func (m *Minter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMint
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+func (m *CancelIncentiveStreamProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelIncentiveStreamProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelIncentiveStreamProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelIncentiveStreamProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelIncentiveStreamProposal.Merge(m, src)
+}
+func (m *CancelIncentiveStreamProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelIncentiveStreamProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelIncentiveStreamProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelIncentiveStreamProposal proto.InternalMessageInfo
+
+func (m *CancelIncentiveStreamProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CancelIncentiveStreamProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CancelIncentiveStreamProposal) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+// CancelParamsChangeProposal is a gov Content type to cancel a scheduled change
+// of the minting parameters before its activation time.
+type CancelParamsChangeProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// change_id is the identifier of the scheduled change to cancel.
+	ChangeId uint64 `protobuf:"varint,3,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+}
+
+func (m *CancelParamsChangeProposal) Reset()         { *m = CancelParamsChangeProposal{} }
+func (m *CancelParamsChangeProposal) String() string { return proto.CompactTextString(m) }
+func (*CancelParamsChangeProposal) ProtoMessage()    {}
+func (*CancelParamsChangeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fa6c02acf2a0105, []int{13}
+}
+func (m *CancelParamsChangeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelParamsChangeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelParamsChangeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelParamsChangeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelParamsChangeProposal.Merge(m, src)
+}
+func (m *CancelParamsChangeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelParamsChangeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelParamsChangeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelParamsChangeProposal proto.InternalMessageInfo
+
+func (m *CancelParamsChangeProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CancelParamsChangeProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CancelParamsChangeProposal) GetChangeId() uint64 {
+	if m != nil {
+		return m.ChangeId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("acrechain.mint.v1beta1.EmissionCurveType", EmissionCurveType_name, EmissionCurveType_value)
+	proto.RegisterEnum("acrechain.mint.v1beta1.MintingMode", MintingMode_name, MintingMode_value)
+	proto.RegisterType((*Minter)(nil), "acrechain.mint.v1beta1.Minter")
+	proto.RegisterType((*PiecewiseScheduleEntry)(nil), "acrechain.mint.v1beta1.PiecewiseScheduleEntry")
+	proto.RegisterType((*EmissionCurve)(nil), "acrechain.mint.v1beta1.EmissionCurve")
+	proto.RegisterType((*WeightedRecipient)(nil), "acrechain.mint.v1beta1.WeightedRecipient")
+	proto.RegisterType((*DistributionProportions)(nil), "acrechain.mint.v1beta1.DistributionProportions")
+	proto.RegisterType((*Params)(nil), "acrechain.mint.v1beta1.Params")
+	proto.RegisterType((*ProjectedPeriod)(nil), "acrechain.mint.v1beta1.ProjectedPeriod")
+	proto.RegisterType((*ScheduledParamsChange)(nil), "acrechain.mint.v1beta1.ScheduledParamsChange")
+	proto.RegisterType((*UpdateParamsProposal)(nil), "acrechain.mint.v1beta1.UpdateParamsProposal")
+	proto.RegisterType((*MintRecord)(nil), "acrechain.mint.v1beta1.MintRecord")
+	proto.RegisterType((*IncentiveStream)(nil), "acrechain.mint.v1beta1.IncentiveStream")
+	proto.RegisterType((*RegisterIncentiveStreamProposal)(nil), "acrechain.mint.v1beta1.RegisterIncentiveStreamProposal")
+	proto.RegisterType((*CancelIncentiveStreamProposal)(nil), "acrechain.mint.v1beta1.CancelIncentiveStreamProposal")
+	proto.RegisterType((*CancelParamsChangeProposal)(nil), "acrechain.mint.v1beta1.CancelParamsChangeProposal")
+}
+
+func init() { proto.RegisterFile("acrechain/mint/v1beta1/mint.proto", fileDescriptor_2fa6c02acf2a0105) }
+
+var fileDescriptor_2fa6c02acf2a0105 = []byte{
+	// 1784 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xbd, 0x73, 0x23, 0x49,
+	0x15, 0xf7, 0xd8, 0xb2, 0x76, 0xf5, 0xb4, 0xfe, 0x6a, 0x7f, 0xec, 0xd8, 0xbb, 0x2b, 0x79, 0xe7,
+	0x0e, 0x30, 0x47, 0x21, 0x71, 0x3e, 0xa2, 0x2d, 0xae, 0x60, 0xf5, 0xb1, 0x3e, 0x2d, 0x27, 0x59,
+	0xd5, 0xf6, 0xb1, 0x05, 0xc9, 0x54, 0x6b, 0xa6, 0x2d, 0x35, 0x3b, 0x5f, 0x35, 0x33, 0xb2, 0x2d,
+	0x02, 0x8a, 0x22, 0x82, 0x8c, 0x22, 0x22, 0xbc, 0x2a, 0x22, 0xa2, 0xcb, 0xa9, 0xba, 0x8c, 0xe0,
+	0x02, 0x82, 0xcb, 0xa0, 0x08, 0x54, 0xd4, 0x6e, 0x42, 0x40, 0xa4, 0xbf, 0x80, 0xea, 0xee, 0xd1,
+	0xcc, 0x48, 0xb6, 0x8e, 0x95, 0xed, 0x48, 0xd3, 0xef, 0xbd, 0xfe, 0xbd, 0x8f, 0x7e, 0xef, 0xf5,
+	0x53, 0xc3, 0x53, 0x62, 0xf8, 0xd4, 0xe8, 0x11, 0xe6, 0x94, 0x6d, 0xe6, 0x84, 0xe5, 0xf3, 0x0f,
+	0x3b, 0x34, 0x24, 0x1f, 0x8a, 0x45, 0xc9, 0xf3, 0xdd, 0xd0, 0x45, 0x3b, 0xb1, 0x48, 0x49, 0x50,
+	0x23, 0x91, 0xbd, 0xad, 0xae, 0xdb, 0x75, 0x85, 0x48, 0x99, 0x7f, 0x49, 0xe9, 0xbd, 0x62, 0xd7,
+	0x75, 0xbb, 0x16, 0x2d, 0x8b, 0x55, 0xa7, 0x7f, 0x56, 0x0e, 0x99, 0x4d, 0x83, 0x90, 0xd8, 0x5e,
+	0x24, 0xb0, 0x3b, 0x2d, 0x40, 0x9c, 0x41, 0xc4, 0x2a, 0x4c, 0xb3, 0xcc, 0xbe, 0x4f, 0x42, 0xe6,
+	0x3a, 0x92, 0xaf, 0xfd, 0x5e, 0x81, 0x6c, 0x93, 0x39, 0x21, 0xf5, 0xd1, 0xfb, 0xb0, 0x6a, 0x91,
+	0x20, 0xd4, 0xb9, 0x45, 0x3a, 0x57, 0xa1, 0x2a, 0xfb, 0xca, 0xc1, 0x12, 0x7e, 0xc0, 0xa9, 0x5c,
+	0xe6, 0x94, 0xd9, 0x14, 0xfd, 0x1c, 0xd6, 0x4d, 0xc2, 0xac, 0x81, 0xee, 0xf9, 0xee, 0x39, 0x0b,
+	0x98, 0xeb, 0x04, 0xea, 0xe2, 0xbe, 0x72, 0x90, 0xab, 0x94, 0xbe, 0x1a, 0x16, 0x17, 0xfe, 0x35,
+	0x2c, 0x7e, 0xbb, 0xcb, 0xc2, 0x5e, 0xbf, 0x53, 0x32, 0x5c, 0xbb, 0x6c, 0xb8, 0x81, 0xed, 0x06,
+	0xd1, 0xcf, 0xf7, 0x03, 0xf3, 0x75, 0x39, 0x1c, 0x78, 0x34, 0x28, 0xd5, 0xa8, 0x81, 0xd7, 0x04,
+	0x4e, 0x3b, 0x86, 0xd1, 0xfe, 0xa6, 0xc0, 0x4e, 0x9b, 0x51, 0x83, 0x5e, 0xb0, 0x80, 0x9e, 0x18,
+	0x3d, 0x6a, 0xf6, 0x2d, 0x5a, 0x77, 0x42, 0x7f, 0x80, 0x7e, 0x08, 0x10, 0x84, 0xc4, 0x4f, 0xdb,
+	0x55, 0xd9, 0x1e, 0x0d, 0x8b, 0x1b, 0x03, 0x62, 0x5b, 0xcf, 0xb4, 0x84, 0xa7, 0xe1, 0x9c, 0x58,
+	0x08, 0x5b, 0xc3, 0x99, 0xb6, 0x36, 0xe6, 0xb3, 0x75, 0x34, 0x2c, 0x3e, 0x94, 0x9a, 0xa6, 0xf1,
+	0xb4, 0xab, 0x6e, 0x7c, 0x91, 0x85, 0x95, 0xba, 0xcd, 0x02, 0xbe, 0xaa, 0xf6, 0xfd, 0x73, 0x8a,
+	0x74, 0x00, 0x83, 0x7f, 0xe8, 0x1c, 0x50, 0x58, 0xbf, 0x7a, 0xf8, 0xdd, 0xd2, 0xf5, 0x39, 0x50,
+	0x9a, 0xd8, 0x7a, 0x3a, 0xf0, 0x68, 0xda, 0xd1, 0x04, 0x46, 0xc3, 0x39, 0x63, 0x2c, 0xc1, 0x1d,
+	0xb5, 0x98, 0x43, 0x89, 0xaf, 0xfb, 0xd4, 0xec, 0x1b, 0xfc, 0x7c, 0x6f, 0xeb, 0xe8, 0x34, 0x9e,
+	0x86, 0xd7, 0x24, 0x09, 0x8f, 0x29, 0xe8, 0x37, 0x0a, 0x20, 0x6f, 0x7c, 0x5e, 0x7a, 0x10, 0x1d,
+	0x98, 0xba, 0xb4, 0xbf, 0x74, 0x90, 0x3f, 0x2c, 0xcd, 0xf2, 0xef, 0xfa, 0x13, 0xae, 0x3c, 0xe5,
+	0x86, 0x8e, 0x86, 0xc5, 0x5d, 0xa9, 0xfe, 0x2a, 0xae, 0x86, 0x37, 0xbc, 0xe9, 0xad, 0x88, 0x42,
+	0xbe, 0xeb, 0x12, 0x4b, 0xef, 0xb8, 0x8e, 0x49, 0x4d, 0x35, 0x23, 0x7c, 0xae, 0xcd, 0xed, 0x33,
+	0x92, 0x4a, 0x53, 0x50, 0x1a, 0x06, 0xbe, 0xaa, 0x88, 0x05, 0x7a, 0x0d, 0x2b, 0xcc, 0x39, 0xb3,
+	0x44, 0xe1, 0xf0, 0xfa, 0x50, 0x97, 0x85, 0xa2, 0x17, 0x73, 0x2b, 0xda, 0x92, 0x8a, 0x26, 0xc0,
+	0x34, 0xfc, 0x20, 0x5e, 0x37, 0x99, 0x33, 0xa5, 0x8c, 0x5c, 0xaa, 0xd9, 0x3b, 0x53, 0x46, 0x2e,
+	0x27, 0x94, 0x91, 0x4b, 0xf4, 0x5b, 0x05, 0xb6, 0x13, 0x01, 0x9f, 0x84, 0x54, 0x37, 0x7a, 0xc4,
+	0xe9, 0x52, 0xf5, 0x9e, 0xd0, 0xda, 0x9a, 0x5b, 0xeb, 0xe3, 0x69, 0xad, 0x29, 0x50, 0x0d, 0x6f,
+	0xc6, 0x74, 0x4c, 0x42, 0x5a, 0x95, 0xd4, 0xcf, 0x15, 0xd8, 0x78, 0x45, 0x59, 0xb7, 0x17, 0x52,
+	0x13, 0x53, 0x83, 0x79, 0x8c, 0x3a, 0x21, 0x3a, 0x84, 0x9c, 0x3f, 0x5e, 0x88, 0xa2, 0xc9, 0x55,
+	0xb6, 0x46, 0xc3, 0xe2, 0xba, 0xc4, 0x8f, 0x59, 0x1a, 0x4e, 0xc4, 0xd0, 0x2b, 0xc8, 0x5e, 0x08,
+	0xa0, 0x28, 0xfd, 0x7f, 0x3c, 0xb7, 0xf9, 0x2b, 0x12, 0x5e, 0xa2, 0x68, 0x38, 0x82, 0xd3, 0xfe,
+	0xbe, 0x04, 0x0f, 0x6b, 0x2c, 0x08, 0x7d, 0xd6, 0xe9, 0x73, 0xeb, 0xdb, 0xbe, 0xeb, 0xb9, 0x3e,
+	0xff, 0x0a, 0xd0, 0x27, 0x70, 0x2f, 0x08, 0xc9, 0x6b, 0xe6, 0x74, 0x55, 0xe5, 0x46, 0x9d, 0x70,
+	0xbc, 0x1d, 0x39, 0xb0, 0x6a, 0xb8, 0xb6, 0xdd, 0x77, 0x58, 0x38, 0xd0, 0x3d, 0xd7, 0xb5, 0x22,
+	0x37, 0x8e, 0xe6, 0x76, 0x63, 0x3b, 0xea, 0x17, 0x13, 0x68, 0x1a, 0x5e, 0x89, 0x09, 0x6d, 0xd7,
+	0xb5, 0xd0, 0xaf, 0x61, 0xf3, 0x22, 0x8a, 0xbb, 0x1e, 0x07, 0x31, 0x88, 0x2a, 0x78, 0x66, 0x87,
+	0xba, 0x72, 0x54, 0x15, 0x2d, 0x2a, 0xde, 0xbd, 0x74, 0xf0, 0x26, 0x30, 0x35, 0x8c, 0x2e, 0xa6,
+	0xb7, 0x05, 0xe8, 0x02, 0x36, 0x4c, 0x7a, 0x4e, 0x2d, 0xd7, 0xa3, 0xbc, 0xd5, 0x5c, 0x10, 0xdf,
+	0x0c, 0xa2, 0x22, 0x7e, 0x39, 0xb7, 0xcb, 0x6a, 0xd4, 0xa1, 0xa7, 0x01, 0x35, 0xbc, 0x1e, 0xd3,
+	0x70, 0x44, 0xfa, 0x12, 0x20, 0xdb, 0x26, 0x3e, 0xb1, 0x03, 0xf4, 0x04, 0x40, 0xdc, 0x78, 0x26,
+	0x75, 0x5c, 0x5b, 0x1e, 0x20, 0xce, 0x71, 0x4a, 0x8d, 0x13, 0x50, 0x0f, 0xd4, 0x2e, 0x75, 0x68,
+	0xc0, 0x02, 0xfd, 0x8e, 0xee, 0xbd, 0x9d, 0x08, 0xaf, 0x36, 0x79, 0x6f, 0xa0, 0x8f, 0xe1, 0x51,
+	0xdc, 0x6d, 0x75, 0x8f, 0xfa, 0xcc, 0x35, 0x75, 0xe6, 0xe8, 0x01, 0x35, 0x5c, 0xc7, 0xe4, 0x87,
+	0xc2, 0x2f, 0x63, 0x35, 0x16, 0x69, 0x0b, 0x89, 0x86, 0x73, 0x22, 0xf9, 0xfc, 0x62, 0x4e, 0xb6,
+	0x9f, 0x11, 0x23, 0x74, 0x7d, 0x35, 0x73, 0x23, 0x03, 0xd7, 0x62, 0x9c, 0x17, 0x02, 0x06, 0x79,
+	0xa0, 0x9a, 0xa9, 0xdc, 0xd7, 0xbd, 0x24, 0xf9, 0x45, 0x27, 0xcc, 0x1f, 0x96, 0x67, 0xe5, 0xca,
+	0x8c, 0x9a, 0xa9, 0x64, 0xb8, 0x4d, 0xf8, 0xa1, 0x39, 0xa3, 0xa4, 0x3e, 0x86, 0x47, 0x0e, 0xbd,
+	0x0c, 0xc7, 0x47, 0x98, 0x5c, 0x43, 0x72, 0x00, 0xc8, 0xca, 0x58, 0x70, 0x91, 0xe8, 0x44, 0xe3,
+	0x5b, 0x49, 0x5c, 0xfc, 0xa7, 0xf0, 0x1d, 0x6e, 0x05, 0x73, 0xba, 0x31, 0xc2, 0x84, 0x03, 0xa9,
+	0x59, 0xe2, 0x9e, 0x80, 0x7a, 0x2f, 0x12, 0x8f, 0xd0, 0xd2, 0x56, 0x9f, 0xc4, 0xe3, 0xc4, 0x17,
+	0x0a, 0xbc, 0x1f, 0xa7, 0xf6, 0x95, 0x34, 0xe3, 0xc9, 0x4e, 0xd9, 0x39, 0xf5, 0x03, 0xf5, 0xfe,
+	0xbc, 0xf5, 0xf3, 0x51, 0x54, 0x3f, 0xdf, 0x9b, 0xaa, 0x9f, 0x6f, 0x50, 0xa2, 0xe1, 0xa7, 0x63,
+	0xb1, 0xda, 0x54, 0x76, 0xe3, 0xb1, 0x0c, 0x7a, 0x0d, 0xab, 0x34, 0x1a, 0x27, 0x74, 0x31, 0x2d,
+	0xa8, 0x39, 0x71, 0x5c, 0xdf, 0x7a, 0xa7, 0xe1, 0xa3, 0xf2, 0x24, 0x32, 0x2b, 0x6a, 0x26, 0x93,
+	0x50, 0x1a, 0x5e, 0xa1, 0x69, 0x69, 0xd4, 0x82, 0x4d, 0x9b, 0x5c, 0xea, 0xd4, 0x22, 0x5e, 0x40,
+	0xcd, 0x38, 0x6f, 0x41, 0x0c, 0x6b, 0x85, 0xa4, 0x3b, 0x5c, 0x23, 0xa4, 0xe1, 0x0d, 0x9b, 0x5c,
+	0xd6, 0x25, 0x71, 0x9c, 0xd0, 0x1d, 0x00, 0x2e, 0x1a, 0xf4, 0x3d, 0xcf, 0x1a, 0xa8, 0x79, 0x91,
+	0xca, 0xd5, 0x39, 0x52, 0xb9, 0xe1, 0x84, 0xc9, 0xe0, 0x94, 0x20, 0x69, 0x38, 0x67, 0x93, 0xcb,
+	0x13, 0xf1, 0x8d, 0x5e, 0xc1, 0x8e, 0x28, 0xfe, 0x1e, 0x0b, 0x42, 0xd7, 0x1f, 0xe8, 0x3e, 0x0d,
+	0xa9, 0x23, 0xc6, 0xa7, 0x07, 0xfb, 0xca, 0x41, 0xa6, 0xf2, 0x74, 0x34, 0x2c, 0x3e, 0x89, 0x10,
+	0xae, 0x95, 0xd3, 0xf0, 0x16, 0x67, 0x7c, 0x22, 0xe9, 0x78, 0x4c, 0x46, 0xe7, 0xb0, 0x71, 0x46,
+	0xa9, 0xde, 0xe9, 0xfb, 0x8e, 0x7e, 0xe6, 0x13, 0x39, 0x92, 0xad, 0xdc, 0xae, 0xb3, 0x5d, 0x01,
+	0xd4, 0xf0, 0xda, 0x19, 0xa5, 0x95, 0xbe, 0xef, 0xbc, 0x88, 0x28, 0x48, 0x87, 0x07, 0xe3, 0xcc,
+	0xb7, 0x5d, 0x93, 0xaa, 0xab, 0x62, 0xd8, 0x7c, 0x6f, 0xd6, 0x79, 0x37, 0xa5, 0x6c, 0xd3, 0x35,
+	0x69, 0xe5, 0xe1, 0x68, 0x58, 0xdc, 0x4c, 0x7c, 0x1d, 0x43, 0x68, 0x38, 0x6f, 0x27, 0x52, 0xcf,
+	0x32, 0x7f, 0xfa, 0xbc, 0xb8, 0xa0, 0x8d, 0x14, 0x58, 0x6b, 0xfb, 0xee, 0x2f, 0xa9, 0x11, 0x52,
+	0x53, 0x76, 0x22, 0xde, 0x48, 0xa7, 0x67, 0xf4, 0xf4, 0x30, 0xbe, 0x0b, 0xf7, 0xa9, 0x63, 0x4a,
+	0xe6, 0xa2, 0x60, 0xde, 0xa3, 0x8e, 0x39, 0xf3, 0x3f, 0xc5, 0xd2, 0x9d, 0xfc, 0xa7, 0x40, 0x2f,
+	0xe1, 0xfe, 0x38, 0x4b, 0x6f, 0xd8, 0x0d, 0xe3, 0xfd, 0xda, 0x7f, 0x15, 0xd8, 0x1e, 0x4f, 0x9e,
+	0xa6, 0xbc, 0x3d, 0xe4, 0x00, 0x83, 0x56, 0x61, 0x91, 0x99, 0xc2, 0xe5, 0x0c, 0x5e, 0x64, 0x26,
+	0xaa, 0xc2, 0x1a, 0x3f, 0x8f, 0x73, 0x92, 0xb4, 0x2c, 0xe1, 0x72, 0x65, 0x6f, 0x34, 0x2c, 0xee,
+	0xc8, 0x18, 0x4f, 0x09, 0x68, 0x78, 0x35, 0xa1, 0x88, 0xa8, 0xfc, 0x08, 0xb2, 0x9e, 0x50, 0x22,
+	0x62, 0x91, 0x3f, 0x2c, 0xcc, 0x9c, 0xa8, 0x85, 0x54, 0xd4, 0x52, 0xa3, 0x3d, 0xe8, 0x27, 0xb0,
+	0x2a, 0x67, 0x2e, 0x53, 0x8f, 0x50, 0x32, 0xfb, 0x4b, 0x07, 0xb9, 0xca, 0x6e, 0x6a, 0x38, 0x98,
+	0xe0, 0xf3, 0xe1, 0x40, 0x12, 0x24, 0x9e, 0xf6, 0x0f, 0x05, 0xb6, 0x3e, 0xf3, 0x4c, 0x12, 0x52,
+	0x49, 0x10, 0xfd, 0x39, 0x20, 0x16, 0xda, 0x82, 0xe5, 0x90, 0x85, 0x16, 0x8d, 0x2e, 0x4b, 0xb9,
+	0x40, 0xfb, 0x90, 0x37, 0x69, 0x60, 0xf8, 0xcc, 0x4b, 0xfe, 0x7e, 0xe0, 0x34, 0xe9, 0x96, 0x0e,
+	0x5d, 0x13, 0xd3, 0xcc, 0xbc, 0x31, 0x7d, 0x96, 0xf9, 0x0f, 0xcf, 0xde, 0xbf, 0x2e, 0x02, 0xf0,
+	0xcc, 0xc7, 0xd4, 0x70, 0x7d, 0x13, 0xed, 0x40, 0xb6, 0x27, 0x87, 0x46, 0x99, 0xb4, 0xd1, 0x0a,
+	0x21, 0xc8, 0xa4, 0xb2, 0x55, 0x7c, 0xa3, 0x17, 0x90, 0x25, 0xb6, 0xdb, 0x77, 0xc2, 0x1b, 0x24,
+	0x68, 0xc3, 0x09, 0x71, 0xb4, 0x3b, 0x3d, 0x33, 0x66, 0x6e, 0x04, 0xf4, 0x0d, 0x33, 0xe3, 0xf2,
+	0xdc, 0x33, 0xa3, 0x6c, 0x95, 0xef, 0x34, 0x33, 0x6a, 0x5f, 0x2e, 0xc2, 0x5a, 0xc3, 0x31, 0x78,
+	0x9f, 0x3b, 0xa7, 0x27, 0xa1, 0x4f, 0x89, 0x7d, 0x25, 0xff, 0x1f, 0xa7, 0x47, 0x77, 0x99, 0x09,
+	0x09, 0x01, 0xd5, 0x60, 0x39, 0xe8, 0x11, 0x9f, 0xde, 0xb0, 0xc6, 0xe5, 0xe6, 0xa9, 0x27, 0x81,
+	0xcc, 0x3b, 0x3e, 0x09, 0x94, 0x52, 0x5d, 0x68, 0x59, 0xec, 0xd9, 0x1c, 0x0d, 0x8b, 0x6b, 0x72,
+	0xcf, 0x98, 0xa3, 0x25, 0xad, 0xa9, 0x0d, 0xf9, 0x78, 0x72, 0xa0, 0xa6, 0x9a, 0x9d, 0xdb, 0x62,
+	0x7e, 0x56, 0x69, 0x08, 0xed, 0x2f, 0x8b, 0x50, 0xc4, 0xb4, 0xcb, 0x82, 0x90, 0xfa, 0x53, 0x71,
+	0xbc, 0x75, 0x85, 0x4d, 0xc4, 0x7d, 0x69, 0x66, 0xdc, 0x33, 0x77, 0x17, 0xf7, 0xe5, 0x1b, 0xc4,
+	0x3d, 0xfb, 0xff, 0xe3, 0x1e, 0x15, 0xea, 0xaf, 0xe0, 0x49, 0x95, 0x38, 0x06, 0xb5, 0xee, 0x3a,
+	0x50, 0x8f, 0x20, 0x17, 0x08, 0x24, 0x9d, 0x99, 0x22, 0x50, 0x19, 0x7c, 0x5f, 0x12, 0x1a, 0x66,
+	0xa4, 0xfb, 0x02, 0xf6, 0xa4, 0xee, 0x74, 0xa7, 0xbf, 0x0b, 0xc5, 0xb2, 0xcb, 0xa6, 0x14, 0x4b,
+	0xc2, 0x58, 0xf1, 0x07, 0x7f, 0x54, 0x60, 0xe3, 0xca, 0x23, 0x10, 0x7a, 0x0c, 0x6a, 0xbd, 0xd9,
+	0x38, 0x39, 0x69, 0x1c, 0xb7, 0xf4, 0xea, 0x67, 0xf8, 0x67, 0x75, 0xfd, 0xa8, 0x7e, 0xdc, 0xac,
+	0x9f, 0xe2, 0x46, 0x75, 0x7d, 0x01, 0xed, 0xc2, 0xf6, 0x14, 0xf7, 0xd3, 0x46, 0xab, 0xfe, 0x1c,
+	0xaf, 0x2b, 0xd7, 0x6c, 0x6c, 0x37, 0xea, 0xd5, 0xfa, 0xab, 0xc6, 0x49, 0x7d, 0x7d, 0x11, 0xed,
+	0xc3, 0xe3, 0x29, 0xee, 0xe9, 0x73, 0x7c, 0x54, 0x3f, 0xd5, 0x2b, 0xc7, 0xad, 0x5a, 0xbd, 0xb6,
+	0xbe, 0xb4, 0x97, 0xf9, 0xdd, 0x9f, 0x0b, 0x0b, 0x1f, 0x34, 0x21, 0x9f, 0x9a, 0x15, 0xd0, 0x1e,
+	0xec, 0x34, 0x1b, 0xad, 0xd3, 0x46, 0xeb, 0x48, 0x6f, 0x1e, 0xd7, 0xea, 0x7a, 0xbd, 0x55, 0xd3,
+	0x2b, 0x9f, 0x1e, 0x57, 0x7f, 0xba, 0xbe, 0xc0, 0x15, 0x4e, 0xf0, 0x2a, 0xf5, 0xa3, 0x46, 0x2b,
+	0xe2, 0x2a, 0x12, 0xae, 0xf2, 0xf2, 0xab, 0x37, 0x05, 0xe5, 0xeb, 0x37, 0x05, 0xe5, 0xdf, 0x6f,
+	0x0a, 0xca, 0x1f, 0xde, 0x16, 0x16, 0xbe, 0x7e, 0x5b, 0x58, 0xf8, 0xe7, 0xdb, 0xc2, 0xc2, 0x2f,
+	0x7e, 0x90, 0xca, 0xc6, 0xe7, 0x3e, 0xe9, 0x58, 0x3c, 0xe6, 0xa1, 0x6b, 0xb8, 0x56, 0x39, 0x79,
+	0x57, 0xbd, 0x94, 0x2f, 0xab, 0x22, 0x37, 0x3b, 0x59, 0xf1, 0x92, 0xf9, 0xd1, 0xff, 0x06, 0x00,
+	0x28, 0xa1, 0x60, 0x44, 0x78, 0x15, 0x00, 0x00,
+}
+
+func (m *Minter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DailyProvisions.Size()
+		i -= size
+		if _, err := m.DailyProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.LastMintTime != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.LastMintTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PiecewiseScheduleEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PiecewiseScheduleEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PiecewiseScheduleEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DailyProvisions.Size()
+		i -= size
+		if _, err := m.DailyProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.StartTime != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InflationRateChange.Size()
+		i -= size
+		if _, err := m.InflationRateChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.InflationMax.Size()
+		i -= size
+		if _, err := m.InflationMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.InflationMin.Size()
+		i -= size
+		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.GoalBonded.Size()
+		i -= size
+		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PiecewiseSchedule) > 0 {
+		for iNdEx := len(m.PiecewiseSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PiecewiseSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.LinearReduction.Size()
+		i -= size
+		if _, err := m.LinearReduction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.CurveType != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.CurveType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightedRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionProportions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionProportions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DeveloperRewards.Size()
+		i -= size
+		if _, err := m.DeveloperRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.WeightedRecipients) > 0 {
+		for iNdEx := len(m.WeightedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightedRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Staking.Size()
+		i -= size
+		if _, err := m.Staking.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MintingMode != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.MintingMode))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.FeeBurnFraction.Size()
+		i -= size
+		if _, err := m.FeeBurnFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.MintHistoryRetention != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.MintHistoryRetention))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.MaxElapsedSeconds != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.MaxElapsedSeconds))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.EmissionCurve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.WeightedDeveloperRewardsReceivers) > 0 {
+		for iNdEx := len(m.WeightedDeveloperRewardsReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightedDeveloperRewardsReceivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.MintingRewardsDistributionStartTime != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.MintingRewardsDistributionStartTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NextRewardsReductionTime != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.NextRewardsReductionTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.DistributionProportions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ReductionFactor.Size()
+		i -= size
+		if _, err := m.ReductionFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ReductionPeriodInSeconds != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ReductionPeriodInSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.GenesisDailyProvisions.Size()
+		i -= size
+		if _, err := m.GenesisDailyProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectedPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectedPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectedPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Emission.Size()
+		i -= size
+		if _, err := m.Emission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.DailyProvisions.Size()
+		i -= size
+		if _, err := m.DailyProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EndTime != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledParamsChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledParamsChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledParamsChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChangedParams) > 0 {
+		for iNdEx := len(m.ChangedParams) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChangedParams[iNdEx])
+			copy(dAtA[i:], m.ChangedParams[iNdEx])
+			i = encodeVarintMint(dAtA, i, uint64(len(m.ChangedParams[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ActivationTime != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ActivationTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateParamsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateParamsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateParamsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationTime != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ActivationTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Staking.Size()
+		i -= size
+		if _, err := m.Staking.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Time != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentiveStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Distributed.Size()
+		i -= size
+		if _, err := m.Distributed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.EndTime != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterIncentiveStreamProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterIncentiveStreamProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterIncentiveStreamProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelIncentiveStreamProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelIncentiveStreamProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelIncentiveStreamProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelParamsChangeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelParamsChangeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelParamsChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChangeId != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ChangeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastMintTime != 0 {
+		n += 1 + sovMint(uint64(m.LastMintTime))
+	}
+	l = m.DailyProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *PiecewiseScheduleEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		n += 1 + sovMint(uint64(m.StartTime))
+	}
+	l = m.DailyProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *EmissionCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurveType != 0 {
+		n += 1 + sovMint(uint64(m.CurveType))
+	}
+	l = m.LinearReduction.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.PiecewiseSchedule) > 0 {
+		for _, e := range m.PiecewiseSchedule {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMin.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationRateChange.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *WeightedRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *DistributionProportions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Staking.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.WeightedRecipients) > 0 {
+		for _, e := range m.WeightedRecipients {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = m.DeveloperRewards.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.GenesisDailyProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.ReductionPeriodInSeconds != 0 {
+		n += 1 + sovMint(uint64(m.ReductionPeriodInSeconds))
+	}
+	l = m.ReductionFactor.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.DistributionProportions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.NextRewardsReductionTime != 0 {
+		n += 1 + sovMint(uint64(m.NextRewardsReductionTime))
+	}
+	if m.MintingRewardsDistributionStartTime != 0 {
+		n += 1 + sovMint(uint64(m.MintingRewardsDistributionStartTime))
+	}
+	if len(m.WeightedDeveloperRewardsReceivers) > 0 {
+		for _, e := range m.WeightedDeveloperRewardsReceivers {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = m.EmissionCurve.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.MaxElapsedSeconds != 0 {
+		n += 1 + sovMint(uint64(m.MaxElapsedSeconds))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.MintHistoryRetention != 0 {
+		n += 1 + sovMint(uint64(m.MintHistoryRetention))
+	}
+	l = m.FeeBurnFraction.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.MintingMode != 0 {
+		n += 1 + sovMint(uint64(m.MintingMode))
+	}
+	return n
+}
+
+func (m *ProjectedPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		n += 1 + sovMint(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovMint(uint64(m.EndTime))
+	}
+	l = m.DailyProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Emission.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *ScheduledParamsChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMint(uint64(m.Id))
+	}
+	if m.ActivationTime != 0 {
+		n += 1 + sovMint(uint64(m.ActivationTime))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.ChangedParams) > 0 {
+		for _, s := range m.ChangedParams {
+			l = len(s)
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateParamsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.ActivationTime != 0 {
+		n += 1 + sovMint(uint64(m.ActivationTime))
+	}
+	return n
+}
+
+func (m *MintRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	if m.Time != 0 {
+		n += 1 + sovMint(uint64(m.Time))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Staking.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *IncentiveStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMint(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.StartTime != 0 {
+		n += 1 + sovMint(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovMint(uint64(m.EndTime))
+	}
+	l = m.Distributed.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *RegisterIncentiveStreamProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.StartTime != 0 {
+		n += 1 + sovMint(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovMint(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *CancelIncentiveStreamProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovMint(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *CancelParamsChangeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.ChangeId != 0 {
+		n += 1 + sovMint(uint64(m.ChangeId))
+	}
+	return n
+}
+
+func sovMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMint(x uint64) (n int) {
+	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMintTime", wireType)
+			}
+			m.LastMintTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastMintTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DailyProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PiecewiseScheduleEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PiecewiseScheduleEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PiecewiseScheduleEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DailyProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionCurve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionCurve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurveType", wireType)
+			}
+			m.CurveType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurveType |= EmissionCurveType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinearReduction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LinearReduction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PiecewiseSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PiecewiseSchedule = append(m.PiecewiseSchedule, PiecewiseScheduleEntry{})
+			if err := m.PiecewiseSchedule[len(m.PiecewiseSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionProportions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionProportions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightedRecipients = append(m.WeightedRecipients, WeightedRecipient{})
+			if err := m.WeightedRecipients[len(m.WeightedRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeveloperRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisDailyProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GenesisDailyProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReductionPeriodInSeconds", wireType)
+			}
+			m.ReductionPeriodInSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReductionPeriodInSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReductionFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReductionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRewardsReductionTime", wireType)
+			}
+			m.NextRewardsReductionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRewardsReductionTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingRewardsDistributionStartTime", wireType)
+			}
+			m.MintingRewardsDistributionStartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintingRewardsDistributionStartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedDeveloperRewardsReceivers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightedDeveloperRewardsReceivers = append(m.WeightedDeveloperRewardsReceivers, WeightedRecipient{})
+			if err := m.WeightedDeveloperRewardsReceivers[len(m.WeightedDeveloperRewardsReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionCurve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxElapsedSeconds", wireType)
+			}
+			m.MaxElapsedSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxElapsedSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintHistoryRetention", wireType)
+			}
+			m.MintHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingMode", wireType)
+			}
+			m.MintingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintingMode |= MintingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectedPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectedPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectedPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DailyProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Emission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledParamsChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledParamsChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledParamsChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			m.ActivationTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedParams", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedParams = append(m.ChangedParams, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateParamsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateParamsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			m.ActivationTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentiveStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentiveStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentiveStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastMintTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			m.LastMintTime = 0
+			m.EndTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -504,14 +4419,14 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastMintTime |= int64(b&0x7F) << shift
+				m.EndTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DailyProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -539,7 +4454,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DailyProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Distributed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -564,7 +4479,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
+func (m *RegisterIncentiveStreamProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -587,15 +4502,15 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DistributionProportions: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterIncentiveStreamProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DistributionProportions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterIncentiveStreamProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -623,10 +4538,144 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -648,7 +4697,7 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *CancelIncentiveStreamProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -671,15 +4720,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelIncentiveStreamProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelIncentiveStreamProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -707,11 +4756,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisDailyProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -739,15 +4788,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GenesisDailyProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReductionPeriodInSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			m.ReductionPeriodInSeconds = 0
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -757,14 +4804,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReductionPeriodInSeconds |= int64(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelParamsChangeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelParamsChangeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelParamsChangeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReductionFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -792,15 +4889,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReductionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -810,49 +4905,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextRewardsReductionTime", wireType)
-			}
-			m.NextRewardsReductionTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextRewardsReductionTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintingRewardsDistributionStartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeId", wireType)
 			}
-			m.MintingRewardsDistributionStartTime = 0
+			m.ChangeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -862,7 +4937,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MintingRewardsDistributionStartTime |= int64(b&0x7F) << shift
+				m.ChangeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -28,9 +28,21 @@ func (m Minter) Validate() error {
 	return nil
 }
 
+// AnnualProvisions returns the provisions minted over a year at the current
+// daily provisions rate.
+func (m Minter) AnnualProvisions() sdk.Dec {
+	return m.DailyProvisions.MulInt64(365)
+}
+
 // BlockProvision returns the provisions for a block based on the block
 // provisions rate.
 func (m Minter) BlockProvision(time int64, params Params) sdk.Coin {
-	provisionAmt := m.DailyProvisions.Mul(sdk.NewDec(time - m.LastMintTime)).Quo(sdk.NewDec(86400))
+	provisionAmt := m.PeriodProvision(time - m.LastMintTime)
 	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
 }
+
+// PeriodProvision returns the provisions for the given number of seconds at
+// the current daily provisions rate.
+func (m Minter) PeriodProvision(seconds int64) sdk.Dec {
+	return m.DailyProvisions.Mul(sdk.NewDec(seconds)).Quo(sdk.NewDec(86400))
+}
@@ -1,8 +1,11 @@
 package types
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"reflect"
+	"regexp"
 	"strings"
 
 	yaml "gopkg.in/yaml.v2"
@@ -21,6 +24,13 @@ var (
 	KeyDistributionProportions             = []byte("DistributionProportions")
 	KeyMintingRewardsDistributionStartTime = []byte("MintingRewardsDistributionStartTime")
 	KeyNextRewardsReductionTime            = []byte("NextRewardsReductionTime")
+	KeyWeightedDeveloperRewardsReceivers   = []byte("WeightedDeveloperRewardsReceivers")
+	KeyEmissionCurve                       = []byte("EmissionCurve")
+	KeyMaxElapsedSeconds                   = []byte("MaxElapsedSeconds")
+	KeyMaxSupply                           = []byte("MaxSupply")
+	KeyMintHistoryRetention                = []byte("MintHistoryRetention")
+	KeyFeeBurnFraction                     = []byte("FeeBurnFraction")
+	KeyMintingMode                         = []byte("MintingMode")
 )
 
 // ParamTable for minting module.
@@ -28,12 +38,30 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+// moduleNameRegex matches the names of module accounts.
+var moduleNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// DefaultMaxElapsedSeconds is the default cap on the time a single block mints
+// for, one day.
+const DefaultMaxElapsedSeconds int64 = 86400
+
+// DefaultMintHistoryRetention is the default number of mint records kept in the
+// mint history, about a week of blocks.
+const DefaultMintHistoryRetention uint64 = 100_000
+
 // NewParams returns new mint module parameters initialized to the given values.
 func NewParams(
 	mintDenom string, genesisDailyProvisions sdk.Dec,
 	ReductionFactor sdk.Dec, reductionPeriodInSeconds int64, distrProportions DistributionProportions,
 	nextRewardsReductionTime int64,
 	mintingRewardsDistributionStartTime int64,
+	weightedDevRewardsReceivers []WeightedRecipient,
+	emissionCurve EmissionCurve,
+	maxElapsedSeconds int64,
+	maxSupply sdk.Int,
+	mintHistoryRetention uint64,
+	feeBurnFraction sdk.Dec,
+	mintingMode MintingMode,
 ) Params {
 	return Params{
 		MintDenom:                           mintDenom,
@@ -43,6 +71,13 @@ func NewParams(
 		DistributionProportions:             distrProportions,
 		NextRewardsReductionTime:            nextRewardsReductionTime,
 		MintingRewardsDistributionStartTime: mintingRewardsDistributionStartTime,
+		WeightedDeveloperRewardsReceivers:   weightedDevRewardsReceivers,
+		EmissionCurve:                       emissionCurve,
+		MaxElapsedSeconds:                   maxElapsedSeconds,
+		MaxSupply:                           maxSupply,
+		MintHistoryRetention:                mintHistoryRetention,
+		FeeBurnFraction:                     feeBurnFraction,
+		MintingMode:                         mintingMode,
 	}
 }
 
@@ -55,10 +90,18 @@ func DefaultParams() Params {
 		ReductionPeriodInSeconds: 31536000,                              // 1 year - 86400 x 365
 		ReductionFactor:          sdk.NewDecWithPrec(6666, 4),           // 0.6666
 		DistributionProportions: DistributionProportions{
-			Staking: sdk.NewDecWithPrec(25, 2), // 25%
+			Staking:          sdk.NewDecWithPrec(25, 2), // 25%
+			CommunityPool:    sdk.NewDecWithPrec(75, 2), // 75%
+			DeveloperRewards: sdk.ZeroDec(),
 		},
 		NextRewardsReductionTime:            0,
 		MintingRewardsDistributionStartTime: 0,
+		EmissionCurve:                       DefaultEmissionCurve(),
+		MaxElapsedSeconds:                   DefaultMaxElapsedSeconds,
+		MaxSupply:                           sdk.ZeroInt(),
+		MintHistoryRetention:                DefaultMintHistoryRetention,
+		FeeBurnFraction:                     sdk.ZeroDec(),
+		MintingMode:                         MINTING_MODE_BEGIN_BLOCK,
 	}
 }
 
@@ -89,6 +132,84 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateWeightedDeveloperRewardsReceivers(p.WeightedDeveloperRewardsReceivers); err != nil {
+		return err
+	}
+
+	if err := validateEmissionCurve(p.EmissionCurve); err != nil {
+		return err
+	}
+
+	if err := validateMaxElapsedSeconds(p.MaxElapsedSeconds); err != nil {
+		return err
+	}
+
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
+
+	if err := validateMintHistoryRetention(p.MintHistoryRetention); err != nil {
+		return err
+	}
+
+	if err := validateFeeBurnFraction(p.FeeBurnFraction); err != nil {
+		return err
+	}
+
+	if err := validateMintingMode(p.MintingMode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ChangedKeys returns the keys of the parameters whose values differ between p and other.
+func (p Params) ChangedKeys(other Params) []string {
+	otherPairs := other.ParamSetPairs()
+
+	var keys []string
+	for i, pair := range p.ParamSetPairs() {
+		if !bytes.Equal(amino.MustMarshalJSON(pair.Value), amino.MustMarshalJSON(otherPairs[i].Value)) {
+			keys = append(keys, string(pair.Key))
+		}
+	}
+	return keys
+}
+
+// WithChanges returns a copy of p with the values of the parameters with the given keys
+// taken from other.
+func (p Params) WithChanges(other Params, keys []string) Params {
+	changed := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		changed[key] = true
+	}
+
+	otherPairs := other.ParamSetPairs()
+	for i, pair := range p.ParamSetPairs() {
+		if changed[string(pair.Key)] {
+			reflect.ValueOf(pair.Value).Elem().Set(reflect.ValueOf(otherPairs[i].Value).Elem())
+		}
+	}
+	return p
+}
+
+// validateParamKeys checks that the keys are known and distinct parameter keys.
+func validateParamKeys(keys []string) error {
+	known := make(map[string]bool)
+	for _, pair := range (&Params{}).ParamSetPairs() {
+		known[string(pair.Key)] = true
+	}
+
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if !known[key] {
+			return fmt.Errorf("unknown parameter key: %s", key)
+		}
+		if seen[key] {
+			return fmt.Errorf("duplicate parameter key: %s", key)
+		}
+		seen[key] = true
+	}
 	return nil
 }
 
@@ -109,6 +230,13 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDistributionProportions, &p.DistributionProportions, validateDistributionProportions),
 		paramtypes.NewParamSetPair(KeyNextRewardsReductionTime, &p.NextRewardsReductionTime, validateNextRewardsReductionTime),
 		paramtypes.NewParamSetPair(KeyMintingRewardsDistributionStartTime, &p.MintingRewardsDistributionStartTime, validateMintingRewardsDistributionStartTime),
+		paramtypes.NewParamSetPair(KeyWeightedDeveloperRewardsReceivers, &p.WeightedDeveloperRewardsReceivers, validateWeightedDeveloperRewardsReceivers),
+		paramtypes.NewParamSetPair(KeyEmissionCurve, &p.EmissionCurve, validateEmissionCurve),
+		paramtypes.NewParamSetPair(KeyMaxElapsedSeconds, &p.MaxElapsedSeconds, validateMaxElapsedSeconds),
+		paramtypes.NewParamSetPair(KeyMaxSupply, &p.MaxSupply, validateMaxSupply),
+		paramtypes.NewParamSetPair(KeyMintHistoryRetention, &p.MintHistoryRetention, validateMintHistoryRetention),
+		paramtypes.NewParamSetPair(KeyFeeBurnFraction, &p.FeeBurnFraction, validateFeeBurnFraction),
+		paramtypes.NewParamSetPair(KeyMintingMode, &p.MintingMode, validateMintingMode),
 	}
 }
 
@@ -177,10 +305,60 @@ func validateDistributionProportions(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if v.Staking.IsNegative() {
+	if v.Staking.IsNil() || v.Staking.IsNegative() {
 		return errors.New("staking distribution ratio should not be negative")
 	}
 
+	if v.CommunityPool.IsNil() || v.CommunityPool.IsNegative() {
+		return errors.New("community pool distribution ratio should not be negative")
+	}
+
+	if v.DeveloperRewards.IsNil() || v.DeveloperRewards.IsNegative() {
+		return errors.New("developer rewards distribution ratio should not be negative")
+	}
+
+	totalProportions := v.Staking.Add(v.CommunityPool).Add(v.DeveloperRewards)
+
+	recipients := make(map[string]bool, len(v.WeightedRecipients))
+	for _, w := range v.WeightedRecipients {
+		if err := validateRecipient(w.Recipient); err != nil {
+			return err
+		}
+		if recipients[w.Recipient] {
+			return fmt.Errorf("duplicate weighted recipient: %s", w.Recipient)
+		}
+		recipients[w.Recipient] = true
+
+		if w.Weight.IsNil() || !w.Weight.IsPositive() {
+			return fmt.Errorf("weight of recipient %s should be positive", w.Recipient)
+		}
+		totalProportions = totalProportions.Add(w.Weight)
+	}
+
+	if !totalProportions.Equal(sdk.OneDec()) {
+		return fmt.Errorf("total distributions ratio should be 1, got %s", totalProportions)
+	}
+
+	return nil
+}
+
+// validateRecipient performs a stateless check of a mint recipient, which is either
+// a bech32 account address or the name of a module account.
+func validateRecipient(recipient string) error {
+	if strings.TrimSpace(recipient) == "" {
+		return errors.New("recipient cannot be blank")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(recipient); err == nil {
+		return nil
+	}
+	if strings.HasPrefix(recipient, sdk.GetConfig().GetBech32AccountAddrPrefix()+"1") {
+		return fmt.Errorf("invalid recipient address: %s", recipient)
+	}
+	if !moduleNameRegex.MatchString(recipient) {
+		return fmt.Errorf("recipient is neither an address nor a module account name: %s", recipient)
+	}
+
 	return nil
 }
 
@@ -209,3 +387,106 @@ func validateMintingRewardsDistributionStartTime(i interface{}) error {
 
 	return nil
 }
+
+func validateWeightedDeveloperRewardsReceivers(i interface{}) error {
+	v, ok := i.([]WeightedRecipient)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// fund community pool when rewards address is empty
+	if len(v) == 0 {
+		return nil
+	}
+
+	weightSum := sdk.ZeroDec()
+	for i, w := range v {
+		// we allow address to be "" to go to community pool
+		if w.Recipient != "" {
+			if _, err := sdk.AccAddressFromBech32(w.Recipient); err != nil {
+				return fmt.Errorf("invalid address at %dth: %w", i, err)
+			}
+		}
+		if w.Weight.IsNil() || !w.Weight.IsPositive() {
+			return fmt.Errorf("non-positive weight at %dth", i)
+		}
+		if w.Weight.GT(sdk.OneDec()) {
+			return fmt.Errorf("more than 1 weight at %dth", i)
+		}
+		weightSum = weightSum.Add(w.Weight)
+	}
+
+	if !weightSum.Equal(sdk.OneDec()) {
+		return fmt.Errorf("invalid weight sum: %s", weightSum.String())
+	}
+
+	return nil
+}
+
+func validateMaxElapsedSeconds(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("max elapsed seconds cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxSupply(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("max supply cannot be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max supply cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMintHistoryRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateFeeBurnFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("fee burn fraction cannot be nil")
+	}
+
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee burn fraction must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateMintingMode(i interface{}) error {
+	v, ok := i.(MintingMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := MintingMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid minting mode: %d", v)
+	}
+
+	return nil
+}
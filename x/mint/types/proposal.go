@@ -0,0 +1,146 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// constants
+const (
+	ProposalTypeUpdateParams            string = "UpdateMintParams"
+	ProposalTypeCancelParamsChange      string = "CancelMintParamsChange"
+	ProposalTypeRegisterIncentiveStream string = "RegisterIncentiveStream"
+	ProposalTypeCancelIncentiveStream   string = "CancelIncentiveStream"
+)
+
+// Implements Proposal Interface
+var (
+	_ govtypes.Content = &UpdateParamsProposal{}
+	_ govtypes.Content = &CancelParamsChangeProposal{}
+	_ govtypes.Content = &RegisterIncentiveStreamProposal{}
+	_ govtypes.Content = &CancelIncentiveStreamProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateParams)
+	govtypes.RegisterProposalType(ProposalTypeCancelParamsChange)
+	govtypes.RegisterProposalType(ProposalTypeRegisterIncentiveStream)
+	govtypes.RegisterProposalType(ProposalTypeCancelIncentiveStream)
+	govtypes.RegisterProposalTypeCodec(&UpdateParamsProposal{}, "mint/UpdateParamsProposal")
+	govtypes.RegisterProposalTypeCodec(&CancelParamsChangeProposal{}, "mint/CancelParamsChangeProposal")
+	govtypes.RegisterProposalTypeCodec(&RegisterIncentiveStreamProposal{}, "mint/RegisterIncentiveStreamProposal")
+	govtypes.RegisterProposalTypeCodec(&CancelIncentiveStreamProposal{}, "mint/CancelIncentiveStreamProposal")
+}
+
+// NewUpdateParamsProposal returns new instance of UpdateParamsProposal
+func NewUpdateParamsProposal(title, description string, params Params, activationTime int64) govtypes.Content {
+	return &UpdateParamsProposal{
+		Title:          title,
+		Description:    description,
+		Params:         params,
+		ActivationTime: activationTime,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*UpdateParamsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*UpdateParamsProposal) ProposalType() string {
+	return ProposalTypeUpdateParams
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (upp *UpdateParamsProposal) ValidateBasic() error {
+	if upp.ActivationTime < 0 {
+		return fmt.Errorf("activation time cannot be negative: %d", upp.ActivationTime)
+	}
+	if err := upp.Params.Validate(); err != nil {
+		return err
+	}
+
+	return govtypes.ValidateAbstract(upp)
+}
+
+// NewCancelParamsChangeProposal returns new instance of CancelParamsChangeProposal
+func NewCancelParamsChangeProposal(title, description string, changeID uint64) govtypes.Content {
+	return &CancelParamsChangeProposal{
+		Title:       title,
+		Description: description,
+		ChangeId:    changeID,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*CancelParamsChangeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*CancelParamsChangeProposal) ProposalType() string {
+	return ProposalTypeCancelParamsChange
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (cpcp *CancelParamsChangeProposal) ValidateBasic() error {
+	if cpcp.ChangeId == 0 {
+		return fmt.Errorf("invalid scheduled params change id %d", cpcp.ChangeId)
+	}
+
+	return govtypes.ValidateAbstract(cpcp)
+}
+
+// NewRegisterIncentiveStreamProposal returns new instance of RegisterIncentiveStreamProposal
+func NewRegisterIncentiveStreamProposal(title, description, recipient string, share sdk.Dec, startTime, endTime int64) govtypes.Content {
+	return &RegisterIncentiveStreamProposal{
+		Title:       title,
+		Description: description,
+		Recipient:   recipient,
+		Share:       share,
+		StartTime:   startTime,
+		EndTime:     endTime,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*RegisterIncentiveStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*RegisterIncentiveStreamProposal) ProposalType() string {
+	return ProposalTypeRegisterIncentiveStream
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (risp *RegisterIncentiveStreamProposal) ValidateBasic() error {
+	if err := validateIncentiveStream(risp.Recipient, risp.Share, risp.StartTime, risp.EndTime); err != nil {
+		return err
+	}
+
+	return govtypes.ValidateAbstract(risp)
+}
+
+// NewCancelIncentiveStreamProposal returns new instance of CancelIncentiveStreamProposal
+func NewCancelIncentiveStreamProposal(title, description string, streamID uint64) govtypes.Content {
+	return &CancelIncentiveStreamProposal{
+		Title:       title,
+		Description: description,
+		StreamId:    streamID,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*CancelIncentiveStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*CancelIncentiveStreamProposal) ProposalType() string {
+	return ProposalTypeCancelIncentiveStream
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (cisp *CancelIncentiveStreamProposal) ValidateBasic() error {
+	if cisp.StreamId == 0 {
+		return fmt.Errorf("invalid incentive stream id %d", cisp.StreamId)
+	}
+
+	return govtypes.ValidateAbstract(cisp)
+}
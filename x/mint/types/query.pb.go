@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -191,337 +193,3574 @@ func (m *QueryDailyProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryDailyProvisionsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "acrechain.mint.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "acrechain.mint.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryDailyProvisionsRequest)(nil), "acrechain.mint.v1beta1.QueryDailyProvisionsRequest")
-	proto.RegisterType((*QueryDailyProvisionsResponse)(nil), "acrechain.mint.v1beta1.QueryDailyProvisionsResponse")
+// QueryDeveloperVestingBalanceRequest is the request type for the
+// Query/DeveloperVestingBalance RPC method.
+type QueryDeveloperVestingBalanceRequest struct {
 }
 
-func init() {
-	proto.RegisterFile("acrechain/mint/v1beta1/query.proto", fileDescriptor_180eee932334b6dc)
+func (m *QueryDeveloperVestingBalanceRequest) Reset()         { *m = QueryDeveloperVestingBalanceRequest{} }
+func (m *QueryDeveloperVestingBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeveloperVestingBalanceRequest) ProtoMessage()    {}
+func (*QueryDeveloperVestingBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_180eee932334b6dc, []int{4}
 }
-
-var fileDescriptor_180eee932334b6dc = []byte{
-	// 402 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0x4c, 0x2e, 0x4a,
-	0x4d, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x83, 0xab, 0xd1, 0x03, 0xa9, 0xd1, 0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x64, 0xd2, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0x13, 0x0b,
-	0x32, 0xf5, 0x13, 0xf3, 0xf2, 0xf2, 0x4b, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x8a, 0xa1, 0xb2, 0x8a,
-	0x38, 0xec, 0x03, 0x1b, 0x0c, 0x56, 0xa2, 0x24, 0xc2, 0x25, 0x14, 0x08, 0xb2, 0x3d, 0x20, 0xb1,
-	0x28, 0x31, 0xb7, 0x38, 0x28, 0xb5, 0xb0, 0x34, 0xb5, 0xb8, 0x44, 0x29, 0x98, 0x4b, 0x18, 0x45,
-	0xb4, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0xc8, 0x86, 0x8b, 0xad, 0x00, 0x2c, 0x22, 0xc1, 0xa8,
-	0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa7, 0x87, 0xdd, 0xb1, 0x7a, 0x10, 0x7d, 0x4e, 0x2c, 0x27, 0xee,
-	0xc9, 0x33, 0x04, 0x41, 0xf5, 0x28, 0xc9, 0x72, 0x49, 0x83, 0x0d, 0x75, 0x49, 0xcc, 0xcc, 0xa9,
-	0x0c, 0x28, 0xca, 0x2f, 0xcb, 0x2c, 0x06, 0xb9, 0x15, 0x66, 0x67, 0x25, 0x97, 0x0c, 0x76, 0x69,
-	0xa8, 0xe5, 0x91, 0x5c, 0x02, 0x29, 0x20, 0xa9, 0xf8, 0x02, 0xb8, 0x1c, 0xd8, 0x19, 0x3c, 0x4e,
-	0x7a, 0x20, 0x6b, 0x6e, 0xdd, 0x93, 0x57, 0x4b, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce,
-	0xcf, 0xd5, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0x86, 0x52, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x25,
-	0x95, 0x05, 0xa9, 0xc5, 0x7a, 0x2e, 0xa9, 0xc9, 0x41, 0xfc, 0x29, 0xa8, 0x56, 0x18, 0x1d, 0x61,
-	0xe2, 0x62, 0x05, 0xdb, 0x2d, 0xd4, 0xc9, 0xc8, 0xc5, 0x06, 0x71, 0xbc, 0x90, 0x16, 0x2e, 0xcf,
-	0x61, 0x86, 0x97, 0x94, 0x36, 0x51, 0x6a, 0x21, 0x1e, 0x51, 0x52, 0x6b, 0xba, 0xfc, 0x64, 0x32,
-	0x93, 0x82, 0x90, 0x9c, 0x3e, 0x8e, 0xe8, 0x81, 0x84, 0x97, 0xd0, 0x3a, 0x46, 0x2e, 0x7e, 0xb4,
-	0xc0, 0x10, 0x32, 0xc6, 0x6b, 0x11, 0xf6, 0x90, 0x95, 0x32, 0x21, 0x4d, 0x13, 0xd4, 0x99, 0x06,
-	0x60, 0x67, 0x6a, 0x09, 0x69, 0xe0, 0x72, 0x26, 0x7a, 0x6c, 0x38, 0x79, 0x9d, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
-	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x01, 0x52, 0xcc, 0x38, 0x16, 0x25, 0x26, 0xe5, 0xa4,
-	0x06, 0x80, 0x52, 0x5f, 0x72, 0x7e, 0x0e, 0x92, 0xe1, 0x15, 0x10, 0xe3, 0xc1, 0xf1, 0x94, 0xc4,
-	0x06, 0x4e, 0x9e, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4a, 0xfa, 0xb1, 0xd0, 0x33, 0x03,
-	0x00, 0x00,
+func (m *QueryDeveloperVestingBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeveloperVestingBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeveloperVestingBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeveloperVestingBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeveloperVestingBalanceRequest.Merge(m, src)
+}
+func (m *QueryDeveloperVestingBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeveloperVestingBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeveloperVestingBalanceRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryDeveloperVestingBalanceRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the total set of minting parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// DailyProvisions current minting epoch provisions value.
-	DailyProvisions(ctx context.Context, in *QueryDailyProvisionsRequest, opts ...grpc.CallOption) (*QueryDailyProvisionsResponse, error)
+// QueryDeveloperVestingBalanceResponse is the response type for the
+// Query/DeveloperVestingBalance RPC method.
+type QueryDeveloperVestingBalanceResponse struct {
+	// balance is the remaining balance of the developer vesting module account.
+	Balance types.Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+	// projected_depletion_time is the unix time at which the balance is
+	// projected to be exhausted with the current emission schedule. It is zero
+	// when the balance is not projected to be exhausted.
+	ProjectedDepletionTime int64 `protobuf:"varint,2,opt,name=projected_depletion_time,json=projectedDepletionTime,proto3" json:"projected_depletion_time,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryDeveloperVestingBalanceResponse) Reset()         { *m = QueryDeveloperVestingBalanceResponse{} }
+func (m *QueryDeveloperVestingBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeveloperVestingBalanceResponse) ProtoMessage()    {}
+func (*QueryDeveloperVestingBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_180eee932334b6dc, []int{5}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryDeveloperVestingBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/acrechain.mint.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDeveloperVestingBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeveloperVestingBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDeveloperVestingBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeveloperVestingBalanceResponse.Merge(m, src)
+}
+func (m *QueryDeveloperVestingBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeveloperVestingBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeveloperVestingBalanceResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) DailyProvisions(ctx context.Context, in *QueryDailyProvisionsRequest, opts ...grpc.CallOption) (*QueryDailyProvisionsResponse, error) {
-	out := new(QueryDailyProvisionsResponse)
-	err := c.cc.Invoke(ctx, "/acrechain.mint.v1beta1.Query/DailyProvisions", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDeveloperVestingBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryDeveloperVestingBalanceResponse) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the total set of minting parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// DailyProvisions current minting epoch provisions value.
-	DailyProvisions(context.Context, *QueryDailyProvisionsRequest) (*QueryDailyProvisionsResponse, error)
+func (m *QueryDeveloperVestingBalanceResponse) GetProjectedDepletionTime() int64 {
+	if m != nil {
+		return m.ProjectedDepletionTime
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryAnnualProvisionsRequest is the request type for the
+// Query/AnnualProvisions RPC method.
+type QueryAnnualProvisionsRequest struct {
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAnnualProvisionsRequest) Reset()         { *m = QueryAnnualProvisionsRequest{} }
+func (m *QueryAnnualProvisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAnnualProvisionsRequest) ProtoMessage()    {}
+func (*QueryAnnualProvisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_180eee932334b6dc, []int{6}
 }
-func (*UnimplementedQueryServer) DailyProvisions(ctx context.Context, req *QueryDailyProvisionsRequest) (*QueryDailyProvisionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DailyProvisions not implemented")
-}
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryAnnualProvisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/acrechain.mint.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryAnnualProvisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnnualProvisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_DailyProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDailyProvisionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DailyProvisions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/acrechain.mint.v1beta1.Query/DailyProvisions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DailyProvisions(ctx, req.(*QueryDailyProvisionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryAnnualProvisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnnualProvisionsRequest.Merge(m, src)
+}
+func (m *QueryAnnualProvisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnnualProvisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnnualProvisionsRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "acrechain.mint.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "DailyProvisions",
-			Handler:    _Query_DailyProvisions_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "acrechain/mint/v1beta1/query.proto",
+var xxx_messageInfo_QueryAnnualProvisionsRequest proto.InternalMessageInfo
+
+// QueryAnnualProvisionsResponse is the response type for the
+// Query/AnnualProvisions RPC method.
+type QueryAnnualProvisionsResponse struct {
+	// annual_provisions is the current annual minting provisions value.
+	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAnnualProvisionsResponse) Reset()         { *m = QueryAnnualProvisionsResponse{} }
+func (m *QueryAnnualProvisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAnnualProvisionsResponse) ProtoMessage()    {}
+func (*QueryAnnualProvisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_180eee932334b6dc, []int{7}
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnnualProvisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAnnualProvisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnnualProvisionsResponse.Merge(m, src)
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnnualProvisionsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
+
+// QueryInflationRequest is the request type for the Query/Inflation RPC
+// method.
+type QueryInflationRequest struct {
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryInflationRequest) Reset()         { *m = QueryInflationRequest{} }
+func (m *QueryInflationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationRequest) ProtoMessage()    {}
+func (*QueryInflationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_180eee932334b6dc, []int{8}
+}
+func (m *QueryInflationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryInflationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationRequest.Merge(m, src)
+}
+func (m *QueryInflationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryInflationRequest proto.InternalMessageInfo
+
+// QueryInflationResponse is the response type for the Query/Inflation RPC
+// method.
+type QueryInflationResponse struct {
+	// inflation is the current effective inflation rate.
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryInflationResponse) Reset()         { *m = QueryInflationResponse{} }
+func (m *QueryInflationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationResponse) ProtoMessage()    {}
+func (*QueryInflationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_180eee932334b6dc, []int{9}
+}
+func (m *QueryInflationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDailyProvisionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryInflationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationResponse.Merge(m, src)
 }
-
-func (m *QueryDailyProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryInflationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDailyProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryInflationResponse proto.InternalMessageInfo
+
+// QueryNextReductionTimeRequest is the request type for the
+// Query/NextReductionTime RPC method.
+type QueryNextReductionTimeRequest struct {
 }
 
-func (m *QueryDailyProvisionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryNextReductionTimeRequest) Reset()         { *m = QueryNextReductionTimeRequest{} }
+func (m *QueryNextReductionTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextReductionTimeRequest) ProtoMessage()    {}
+func (*QueryNextReductionTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_180eee932334b6dc, []int{10}
+}
+func (m *QueryNextReductionTimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextReductionTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextReductionTimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryNextReductionTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextReductionTimeRequest.Merge(m, src)
+}
+func (m *QueryNextReductionTimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextReductionTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextReductionTimeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDailyProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryNextReductionTimeRequest proto.InternalMessageInfo
+
+// QueryNextReductionTimeResponse is the response type for the
+// Query/NextReductionTime RPC method.
+type QueryNextReductionTimeResponse struct {
+	// next_reduction_time is the unix time of the next minting reduction.
+	NextReductionTime int64 `protobuf:"varint,1,opt,name=next_reduction_time,json=nextReductionTime,proto3" json:"next_reduction_time,omitempty"`
 }
 
-func (m *QueryDailyProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.DailyProvisions.Size()
-		i -= size
-		if _, err := m.DailyProvisions.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryNextReductionTimeResponse) Reset()         { *m = QueryNextReductionTimeResponse{} }
+func (m *QueryNextReductionTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextReductionTimeResponse) ProtoMessage()    {}
+func (*QueryNextReductionTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_180eee932334b6dc, []int{11}
+}
+func (m *QueryNextReductionTimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextReductionTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextReductionTimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
+func (m *QueryNextReductionTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextReductionTimeResponse.Merge(m, src)
+}
+func (m *QueryNextReductionTimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextReductionTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextReductionTimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextReductionTimeResponse proto.InternalMessageInfo
+
+func (m *QueryNextReductionTimeResponse) GetNextReductionTime() int64 {
+	if m != nil {
+		return m.NextReductionTime
+	}
+	return 0
+}
+
+// QueryProjectedScheduleRequest is the request type for the
+// Query/ProjectedSchedule RPC method.
+type QueryProjectedScheduleRequest struct {
+	// periods is the number of reduction periods to project.
+	Periods uint32 `protobuf:"varint,1,opt,name=periods,proto3" json:"periods,omitempty"`
+}
+
+func (m *QueryProjectedScheduleRequest) Reset()         { *m = QueryProjectedScheduleRequest{} }
+func (m *QueryProjectedScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedScheduleRequest) ProtoMessage()    {}
+func (*QueryProjectedScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_180eee932334b6dc, []int{12}
+}
+func (m *QueryProjectedScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedScheduleRequest.Merge(m, src)
+}
+func (m *QueryProjectedScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryProjectedScheduleRequest) GetPeriods() uint32 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
+// QueryProjectedScheduleResponse is the response type for the
+// Query/ProjectedSchedule RPC method.
+type QueryProjectedScheduleResponse struct {
+	// schedule is the expected emission of each projected period.
+	Schedule []ProjectedPeriod `protobuf:"bytes,1,rep,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *QueryProjectedScheduleResponse) Reset()         { *m = QueryProjectedScheduleResponse{} }
+func (m *QueryProjectedScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedScheduleResponse) ProtoMessage()    {}
+func (*QueryProjectedScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_180eee932334b6dc, []int{13}
+}
+func (m *QueryProjectedScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedScheduleResponse.Merge(m, src)
+}
+func (m *QueryProjectedScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedScheduleResponse) GetSchedule() []ProjectedPeriod {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+// QueryRemainingMintableRequest is the request type for the
+// Query/RemainingMintable RPC method.
+type QueryRemainingMintableRequest struct {
+}
+
+func (m *QueryRemainingMintableRequest) Reset()         { *m = QueryRemainingMintableRequest{} }
+func (m *QueryRemainingMintableRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainingMintableRequest) ProtoMessage()    {}
+func (*QueryRemainingMintableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_180eee932334b6dc, []int{14}
+}
+func (m *QueryRemainingMintableRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemainingMintableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainingMintableRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRemainingMintableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainingMintableRequest.Merge(m, src)
+}
+func (m *QueryRemainingMintableRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainingMintableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainingMintableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRemainingMintableRequest proto.InternalMessageInfo
+
+// QueryRemainingMintableResponse is the response type for the
+// Query/RemainingMintable RPC method.
+type QueryRemainingMintableResponse struct {
+	// max_supply is the hard cap on the total supply of the mint denom, zero
+	// when the supply is not capped.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
+	// remaining_mintable is the amount that can still be minted before the max
+	// supply is reached. It is zero when the supply is not capped.
+	RemainingMintable github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=remaining_mintable,json=remainingMintable,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_mintable"`
+}
+
+func (m *QueryRemainingMintableResponse) Reset()         { *m = QueryRemainingMintableResponse{} }
+func (m *QueryRemainingMintableResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainingMintableResponse) ProtoMessage()    {}
+func (*QueryRemainingMintableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_180eee932334b6dc, []int{15}
+}
+func (m *QueryRemainingMintableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemainingMintableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainingMintableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRemainingMintableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainingMintableResponse.Merge(m, src)
+}
+func (m *QueryRemainingMintableResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainingMintableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainingMintableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRemainingMintableResponse proto.InternalMessageInfo
+
+// QueryScheduledParamsChangesRequest is the request type for the
+// Query/ScheduledParamsChanges RPC method.
+type QueryScheduledParamsChangesRequest struct {
+}
+
+func (m *QueryScheduledParamsChangesRequest) Reset()         { *m = QueryScheduledParamsChangesRequest{} }
+func (m *QueryScheduledParamsChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledParamsChangesRequest) ProtoMessage()    {}
+func (*QueryScheduledParamsChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_180eee932334b6dc, []int{16}
+}
+func (m *QueryScheduledParamsChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledParamsChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledParamsChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledParamsChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledParamsChangesRequest.Merge(m, src)
+}
+func (m *QueryScheduledParamsChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledParamsChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledParamsChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledParamsChangesRequest proto.InternalMessageInfo
+
+// QueryScheduledParamsChangesResponse is the response type for the
+// Query/ScheduledParamsChanges RPC method.
+type QueryScheduledParamsChangesResponse struct {
+	// changes are the pending parameter changes, ordered by activation time.
+	Changes []ScheduledParamsChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+}
+
+func (m *QueryScheduledParamsChangesResponse) Reset()         { *m = QueryScheduledParamsChangesResponse{} }
+func (m *QueryScheduledParamsChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledParamsChangesResponse) ProtoMessage()    {}
+func (*QueryScheduledParamsChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_180eee932334b6dc, []int{17}
+}
+func (m *QueryScheduledParamsChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledParamsChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledParamsChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledParamsChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledParamsChangesResponse.Merge(m, src)
+}
+func (m *QueryScheduledParamsChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledParamsChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledParamsChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledParamsChangesResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledParamsChangesResponse) GetChanges() []ScheduledParamsChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// QueryMintHistoryRequest is the request type for the Query/MintHistory RPC
+// method.
+type QueryMintHistoryRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMintHistoryRequest) Reset()         { *m = QueryMintHistoryRequest{} }
+func (m *QueryMintHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintHistoryRequest) ProtoMessage()    {}
+func (*QueryMintHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_180eee932334b6dc, []int{18}
+}
+func (m *QueryMintHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintHistoryRequest.Merge(m, src)
+}
+func (m *QueryMintHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryMintHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMintHistoryResponse is the response type for the Query/MintHistory RPC
+// method.
+type QueryMintHistoryResponse struct {
+	// records are the recorded mints.
+	Records []MintRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMintHistoryResponse) Reset()         { *m = QueryMintHistoryResponse{} }
+func (m *QueryMintHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintHistoryResponse) ProtoMessage()    {}
+func (*QueryMintHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_180eee932334b6dc, []int{19}
+}
+func (m *QueryMintHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintHistoryResponse.Merge(m, src)
+}
+func (m *QueryMintHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryMintHistoryResponse) GetRecords() []MintRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryMintHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIncentiveStreamsRequest is the request type for the
+// Query/IncentiveStreams RPC method.
+type QueryIncentiveStreamsRequest struct {
+}
+
+func (m *QueryIncentiveStreamsRequest) Reset()         { *m = QueryIncentiveStreamsRequest{} }
+func (m *QueryIncentiveStreamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentiveStreamsRequest) ProtoMessage()    {}
+func (*QueryIncentiveStreamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_180eee932334b6dc, []int{20}
+}
+func (m *QueryIncentiveStreamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentiveStreamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentiveStreamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentiveStreamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentiveStreamsRequest.Merge(m, src)
+}
+func (m *QueryIncentiveStreamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentiveStreamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentiveStreamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentiveStreamsRequest proto.InternalMessageInfo
+
+// QueryIncentiveStreamsResponse is the response type for the
+// Query/IncentiveStreams RPC method.
+type QueryIncentiveStreamsResponse struct {
+	// streams are the registered incentive streams, ordered by identifier.
+	Streams []IncentiveStream `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams"`
+}
+
+func (m *QueryIncentiveStreamsResponse) Reset()         { *m = QueryIncentiveStreamsResponse{} }
+func (m *QueryIncentiveStreamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentiveStreamsResponse) ProtoMessage()    {}
+func (*QueryIncentiveStreamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_180eee932334b6dc, []int{21}
+}
+func (m *QueryIncentiveStreamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentiveStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentiveStreamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentiveStreamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentiveStreamsResponse.Merge(m, src)
+}
+func (m *QueryIncentiveStreamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentiveStreamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentiveStreamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentiveStreamsResponse proto.InternalMessageInfo
+
+func (m *QueryIncentiveStreamsResponse) GetStreams() []IncentiveStream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "acrechain.mint.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "acrechain.mint.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryDailyProvisionsRequest)(nil), "acrechain.mint.v1beta1.QueryDailyProvisionsRequest")
+	proto.RegisterType((*QueryDailyProvisionsResponse)(nil), "acrechain.mint.v1beta1.QueryDailyProvisionsResponse")
+	proto.RegisterType((*QueryDeveloperVestingBalanceRequest)(nil), "acrechain.mint.v1beta1.QueryDeveloperVestingBalanceRequest")
+	proto.RegisterType((*QueryDeveloperVestingBalanceResponse)(nil), "acrechain.mint.v1beta1.QueryDeveloperVestingBalanceResponse")
+	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "acrechain.mint.v1beta1.QueryAnnualProvisionsRequest")
+	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "acrechain.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryInflationRequest)(nil), "acrechain.mint.v1beta1.QueryInflationRequest")
+	proto.RegisterType((*QueryInflationResponse)(nil), "acrechain.mint.v1beta1.QueryInflationResponse")
+	proto.RegisterType((*QueryNextReductionTimeRequest)(nil), "acrechain.mint.v1beta1.QueryNextReductionTimeRequest")
+	proto.RegisterType((*QueryNextReductionTimeResponse)(nil), "acrechain.mint.v1beta1.QueryNextReductionTimeResponse")
+	proto.RegisterType((*QueryProjectedScheduleRequest)(nil), "acrechain.mint.v1beta1.QueryProjectedScheduleRequest")
+	proto.RegisterType((*QueryProjectedScheduleResponse)(nil), "acrechain.mint.v1beta1.QueryProjectedScheduleResponse")
+	proto.RegisterType((*QueryRemainingMintableRequest)(nil), "acrechain.mint.v1beta1.QueryRemainingMintableRequest")
+	proto.RegisterType((*QueryRemainingMintableResponse)(nil), "acrechain.mint.v1beta1.QueryRemainingMintableResponse")
+	proto.RegisterType((*QueryScheduledParamsChangesRequest)(nil), "acrechain.mint.v1beta1.QueryScheduledParamsChangesRequest")
+	proto.RegisterType((*QueryScheduledParamsChangesResponse)(nil), "acrechain.mint.v1beta1.QueryScheduledParamsChangesResponse")
+	proto.RegisterType((*QueryMintHistoryRequest)(nil), "acrechain.mint.v1beta1.QueryMintHistoryRequest")
+	proto.RegisterType((*QueryMintHistoryResponse)(nil), "acrechain.mint.v1beta1.QueryMintHistoryResponse")
+	proto.RegisterType((*QueryIncentiveStreamsRequest)(nil), "acrechain.mint.v1beta1.QueryIncentiveStreamsRequest")
+	proto.RegisterType((*QueryIncentiveStreamsResponse)(nil), "acrechain.mint.v1beta1.QueryIncentiveStreamsResponse")
+}
+
+func init() {
+	proto.RegisterFile("acrechain/mint/v1beta1/query.proto", fileDescriptor_180eee932334b6dc)
+}
+
+var fileDescriptor_180eee932334b6dc = []byte{
+	// 1195 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x29, 0x34, 0xe4, 0x05, 0x94, 0x78, 0x5a, 0x12, 0xb3, 0x34, 0x9b, 0x74, 0x13,
+	0xd2, 0x34, 0x69, 0x76, 0xf3, 0xa3, 0x8d, 0x1a, 0x9a, 0x4b, 0xd3, 0x88, 0x12, 0x44, 0x91, 0x71,
+	0x10, 0x12, 0x20, 0xb4, 0x1a, 0xef, 0x4e, 0xed, 0xa5, 0xf6, 0xcc, 0x76, 0x77, 0x1d, 0xc5, 0x02,
+	0x2e, 0xdc, 0xb8, 0x21, 0x21, 0x4e, 0x48, 0x5c, 0xb9, 0x71, 0xe0, 0x00, 0x27, 0x24, 0x8e, 0x95,
+	0x90, 0xa0, 0x12, 0x17, 0xc4, 0xa1, 0x42, 0x09, 0x7f, 0x08, 0xda, 0xd9, 0x99, 0x8d, 0x63, 0x7b,
+	0xd6, 0x71, 0x38, 0xb5, 0x99, 0xf7, 0xeb, 0xf3, 0xde, 0xd8, 0xef, 0x3b, 0x06, 0x13, 0xbb, 0x21,
+	0x71, 0x6b, 0xd8, 0xa7, 0x76, 0xc3, 0xa7, 0xb1, 0x7d, 0xb0, 0x56, 0x21, 0x31, 0x5e, 0xb3, 0x1f,
+	0x37, 0x49, 0xd8, 0xb2, 0x82, 0x90, 0xc5, 0x0c, 0x4d, 0x66, 0x3e, 0x56, 0xe2, 0x63, 0x09, 0x1f,
+	0xfd, 0x72, 0x95, 0x55, 0x19, 0x77, 0xb1, 0x93, 0xff, 0xa5, 0xde, 0xfa, 0x95, 0x2a, 0x63, 0xd5,
+	0x3a, 0xb1, 0x71, 0xe0, 0xdb, 0x98, 0x52, 0x16, 0xe3, 0xd8, 0x67, 0x34, 0x12, 0x56, 0xc3, 0x65,
+	0x51, 0x83, 0x45, 0x76, 0x05, 0x47, 0x24, 0x2b, 0xe6, 0x32, 0x9f, 0x0a, 0xfb, 0x52, 0xbb, 0x9d,
+	0x43, 0x64, 0x5e, 0x01, 0xae, 0xfa, 0x94, 0x27, 0x13, 0xbe, 0x57, 0x15, 0xec, 0x1c, 0x92, 0xbb,
+	0x98, 0x97, 0x01, 0xbd, 0x9b, 0x24, 0x29, 0xe1, 0x10, 0x37, 0xa2, 0x32, 0x79, 0xdc, 0x24, 0x51,
+	0x6c, 0xee, 0xc3, 0xa5, 0x53, 0xa7, 0x51, 0xc0, 0x68, 0x44, 0xd0, 0x36, 0x5c, 0x0c, 0xf8, 0x49,
+	0x51, 0x9b, 0xd5, 0x16, 0xc7, 0xd6, 0x0d, 0xab, 0x77, 0xe3, 0x56, 0x1a, 0xb7, 0xf3, 0xdc, 0x93,
+	0x67, 0x33, 0x43, 0x65, 0x11, 0x63, 0x4e, 0xc3, 0xab, 0x3c, 0xe9, 0x2e, 0xf6, 0xeb, 0xad, 0x52,
+	0xc8, 0x0e, 0xfc, 0x28, 0xe9, 0x5b, 0xd6, 0x6c, 0xc1, 0x95, 0xde, 0x66, 0x51, 0xfc, 0x03, 0x98,
+	0xf0, 0x12, 0x93, 0x13, 0x64, 0x36, 0x8e, 0xf1, 0xe2, 0x8e, 0x95, 0x94, 0xf9, 0xfb, 0xd9, 0xcc,
+	0x42, 0xd5, 0x8f, 0x6b, 0xcd, 0x8a, 0xe5, 0xb2, 0x86, 0x2d, 0xa6, 0x94, 0xfe, 0xb3, 0x12, 0x79,
+	0x8f, 0xec, 0xb8, 0x15, 0x90, 0xc8, 0xda, 0x25, 0x6e, 0x79, 0xdc, 0x3b, 0x5d, 0xc2, 0x7c, 0x0d,
+	0xe6, 0xd2, 0xd2, 0xe4, 0x80, 0xd4, 0x59, 0x40, 0xc2, 0xf7, 0x49, 0x14, 0xfb, 0xb4, 0xba, 0x83,
+	0xeb, 0x98, 0xba, 0x44, 0x12, 0x7e, 0xab, 0xc1, 0x7c, 0xbe, 0x9f, 0x40, 0xdd, 0x82, 0x91, 0x4a,
+	0x7a, 0x24, 0x06, 0xf5, 0x8a, 0x95, 0x82, 0x58, 0xc9, 0xad, 0x65, 0x53, 0xba, 0xc7, 0x7c, 0x2a,
+	0x66, 0x24, 0xfd, 0xd1, 0x6d, 0x28, 0x06, 0x21, 0xfb, 0x84, 0xb8, 0x31, 0xf1, 0x1c, 0x8f, 0x04,
+	0x75, 0x92, 0xdc, 0xa7, 0x13, 0xfb, 0x0d, 0x52, 0x1c, 0x9e, 0xd5, 0x16, 0x2f, 0x94, 0x27, 0x33,
+	0xfb, 0xae, 0x34, 0xbf, 0xe7, 0x37, 0x88, 0x69, 0x88, 0xf9, 0xdd, 0xa5, 0xb4, 0x89, 0xeb, 0xdd,
+	0xf3, 0xfd, 0x0c, 0xa6, 0x15, 0x76, 0x41, 0xfd, 0x11, 0x14, 0x30, 0xb7, 0xfd, 0xff, 0x09, 0x4f,
+	0xe0, 0x8e, 0x22, 0xe6, 0x14, 0xbc, 0xcc, 0xab, 0xef, 0xd1, 0x87, 0x75, 0xfe, 0x11, 0x95, 0x58,
+	0x0f, 0x61, 0xb2, 0xd3, 0x20, 0x78, 0xde, 0x86, 0x51, 0x5f, 0x1e, 0x9e, 0x93, 0xe3, 0x24, 0x81,
+	0x39, 0x23, 0xda, 0x7f, 0x87, 0x1c, 0xc6, 0x65, 0xe2, 0x35, 0x5d, 0x39, 0x38, 0x09, 0x52, 0x02,
+	0x43, 0xe5, 0x20, 0x80, 0x2c, 0xb8, 0x44, 0xc9, 0x61, 0xec, 0x84, 0xd2, 0x9a, 0x5e, 0x8b, 0xc6,
+	0xaf, 0xa5, 0x40, 0x3b, 0xe3, 0xcc, 0x2d, 0x51, 0xb2, 0x24, 0x2f, 0x6c, 0xdf, 0xad, 0x11, 0xaf,
+	0x59, 0x97, 0x25, 0x51, 0x11, 0x46, 0x02, 0x12, 0xfa, 0xcc, 0x4b, 0xe7, 0xfc, 0x52, 0x59, 0xfe,
+	0x69, 0x3e, 0x02, 0x43, 0x15, 0x2a, 0x60, 0xf6, 0xe0, 0x85, 0x48, 0x9c, 0x15, 0xb5, 0xd9, 0x0b,
+	0x8b, 0x63, 0xeb, 0xd7, 0x94, 0xdf, 0x46, 0x99, 0xa4, 0xc4, 0xb3, 0x8b, 0x8f, 0x5c, 0x16, 0x9e,
+	0x8d, 0xa6, 0x4c, 0x1a, 0xd8, 0xa7, 0x3e, 0xad, 0x3e, 0xf0, 0x69, 0x8c, 0x2b, 0x19, 0xa7, 0xf9,
+	0xbb, 0x06, 0x86, 0xca, 0x43, 0xe0, 0x3c, 0x00, 0x68, 0xe0, 0x43, 0x27, 0x6a, 0x06, 0x41, 0xbd,
+	0xc5, 0xbb, 0x19, 0x1d, 0xe8, 0xb6, 0xf6, 0x68, 0x5c, 0x1e, 0x6d, 0xe0, 0xc3, 0x7d, 0x9e, 0x00,
+	0x7d, 0x0c, 0x28, 0x94, 0xb5, 0x9c, 0x86, 0x28, 0x56, 0x1c, 0x3e, 0x57, 0xda, 0x42, 0xd8, 0x49,
+	0x6d, 0xce, 0x83, 0xc9, 0xfb, 0x91, 0x53, 0xf5, 0xd2, 0x85, 0x75, 0xaf, 0x86, 0x69, 0x95, 0x64,
+	0xdf, 0x98, 0x18, 0xe6, 0x72, 0xbd, 0xb2, 0xd6, 0x47, 0xdc, 0xf4, 0x48, 0x5c, 0xc4, 0x8a, 0xea,
+	0x22, 0x7a, 0x26, 0x92, 0x1b, 0x40, 0xe4, 0x30, 0x31, 0x4c, 0xf1, 0xaa, 0x09, 0xec, 0x9b, 0x7e,
+	0x14, 0xb3, 0xb0, 0x25, 0x80, 0xd0, 0x1b, 0x00, 0x27, 0x3b, 0x5e, 0xac, 0x96, 0x85, 0x53, 0xab,
+	0x25, 0x55, 0xa5, 0x93, 0x35, 0x5c, 0x95, 0x77, 0x58, 0x6e, 0x8b, 0x34, 0xbf, 0xd7, 0xa0, 0xd8,
+	0x5d, 0x43, 0xb4, 0xb3, 0x03, 0x23, 0x21, 0x71, 0x59, 0xe8, 0xc9, 0x76, 0x4c, 0x55, 0x3b, 0x49,
+	0x74, 0x99, 0xbb, 0xca, 0x1e, 0x44, 0x20, 0xba, 0x7f, 0x0a, 0x74, 0x98, 0x83, 0x5e, 0xeb, 0x0b,
+	0x9a, 0x02, 0x9c, 0x22, 0x95, 0x4b, 0x6d, 0x8f, 0xba, 0x84, 0xc6, 0xfe, 0x01, 0xd9, 0x8f, 0x43,
+	0xd2, 0x26, 0x54, 0x35, 0x98, 0x56, 0xd8, 0x45, 0x37, 0xf7, 0x61, 0x24, 0x4a, 0x8f, 0xfa, 0x7d,
+	0x4b, 0x3a, 0x52, 0xc8, 0x96, 0x44, 0xf4, 0xfa, 0x2f, 0xe3, 0xf0, 0x3c, 0x2f, 0x85, 0xbe, 0xd4,
+	0xe0, 0x62, 0x7a, 0x81, 0x68, 0x49, 0x95, 0xac, 0x5b, 0x53, 0xf5, 0xe5, 0x33, 0xf9, 0xa6, 0xd8,
+	0xe6, 0xc2, 0x17, 0x7f, 0xfe, 0xfb, 0xf5, 0xf0, 0x2c, 0x32, 0x6c, 0x85, 0x84, 0xa7, 0x9a, 0x8a,
+	0x7e, 0xd0, 0x60, 0xbc, 0x43, 0x30, 0xd1, 0x46, 0x6e, 0xa1, 0xde, 0xea, 0xab, 0xdf, 0x1c, 0x2c,
+	0x48, 0x60, 0xae, 0x72, 0xcc, 0x25, 0xb4, 0xa8, 0xc2, 0xec, 0x54, 0x6c, 0xf4, 0x87, 0x06, 0x53,
+	0x0a, 0xf9, 0x44, 0x77, 0xf2, 0x19, 0x72, 0xc5, 0x59, 0xdf, 0x3e, 0x5f, 0xb0, 0x68, 0x64, 0x8b,
+	0x37, 0xb2, 0x81, 0xd6, 0x94, 0x8d, 0xc8, 0x04, 0xce, 0x41, 0x9a, 0xc1, 0x91, 0x8a, 0xfd, 0xa3,
+	0x06, 0x13, 0x9d, 0x9a, 0x8a, 0xf2, 0xc7, 0xa9, 0x90, 0x68, 0xfd, 0xd6, 0x80, 0x51, 0x02, 0x7e,
+	0x8d, 0xc3, 0x2f, 0xa3, 0xeb, 0x2a, 0xf8, 0x2e, 0x59, 0x47, 0xdf, 0x68, 0x30, 0x9a, 0x29, 0x2e,
+	0x5a, 0xc9, 0xad, 0xdb, 0x29, 0xd9, 0xba, 0x75, 0x56, 0x77, 0xc1, 0x77, 0x9d, 0xf3, 0xcd, 0xa1,
+	0xab, 0x2a, 0xbe, 0x4c, 0xa5, 0xd1, 0xcf, 0x1a, 0x14, 0xba, 0x04, 0x18, 0xe5, 0xcf, 0x45, 0xa5,
+	0xe8, 0xfa, 0xe6, 0xa0, 0x61, 0x82, 0x77, 0x83, 0xf3, 0xae, 0xa0, 0x65, 0x15, 0x6f, 0x8f, 0x57,
+	0x00, 0xfa, 0x55, 0x83, 0x42, 0x97, 0x5a, 0xf7, 0x21, 0x57, 0x3d, 0x0c, 0xf4, 0xcd, 0x41, 0xc3,
+	0x04, 0xf9, 0x36, 0x27, 0xdf, 0x44, 0x37, 0x95, 0x6b, 0x23, 0x7b, 0x5b, 0x4a, 0xf5, 0xb7, 0x3f,
+	0x15, 0x6f, 0x8e, 0xcf, 0xd1, 0x4f, 0x1a, 0x14, 0xba, 0x14, 0xbe, 0x4f, 0x0b, 0xaa, 0x37, 0x83,
+	0xbe, 0x39, 0x68, 0x98, 0x68, 0x61, 0x9d, 0xb7, 0x70, 0x03, 0x2d, 0xa9, 0x5a, 0xe8, 0x7e, 0x17,
+	0xa0, 0xdf, 0x34, 0x98, 0xec, 0x2d, 0xd2, 0xe8, 0xf5, 0x5c, 0x8c, 0x5c, 0xfd, 0xd7, 0xef, 0x9c,
+	0x2b, 0x56, 0xf4, 0x71, 0x9b, 0xf7, 0xb1, 0x8e, 0x56, 0x55, 0x7d, 0xc8, 0x0b, 0xf0, 0x9c, 0x74,
+	0x97, 0x3b, 0xe2, 0x01, 0x80, 0xbe, 0xd3, 0x60, 0xac, 0x4d, 0x98, 0x91, 0x9d, 0x8b, 0xd1, 0xfd,
+	0x4c, 0xd0, 0x57, 0xcf, 0x1e, 0x20, 0x60, 0x6f, 0x70, 0xd8, 0x05, 0x34, 0x6f, 0xe7, 0xfc, 0x62,
+	0x74, 0x6a, 0x02, 0x28, 0xd9, 0x78, 0x9d, 0x82, 0xdb, 0x67, 0xe3, 0x29, 0xf4, 0x5b, 0xbf, 0x35,
+	0x60, 0xd4, 0x59, 0x37, 0x9e, 0x2f, 0x23, 0x1d, 0xa1, 0xdf, 0x3b, 0x6f, 0x3d, 0x39, 0x32, 0xb4,
+	0xa7, 0x47, 0x86, 0xf6, 0xcf, 0x91, 0xa1, 0x7d, 0x75, 0x6c, 0x0c, 0x3d, 0x3d, 0x36, 0x86, 0xfe,
+	0x3a, 0x36, 0x86, 0x3e, 0x5c, 0x6d, 0x7b, 0x47, 0xde, 0x0d, 0x93, 0x0f, 0x54, 0x29, 0xf9, 0x69,
+	0xec, 0xb2, 0x7a, 0x5b, 0xf6, 0xc3, 0x34, 0x3f, 0x7f, 0x55, 0x56, 0x2e, 0xf2, 0xdf, 0xce, 0x1b,
+	0xff, 0x0d, 0x00, 0x4d, 0xf9, 0x3e, 0xae, 0x1c, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the total set of minting parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// DailyProvisions current minting epoch provisions value.
+	DailyProvisions(ctx context.Context, in *QueryDailyProvisionsRequest, opts ...grpc.CallOption) (*QueryDailyProvisionsResponse, error)
+	// DeveloperVestingBalance returns the remaining balance of the developer
+	// vesting module account and its projected depletion time.
+	DeveloperVestingBalance(ctx context.Context, in *QueryDeveloperVestingBalanceRequest, opts ...grpc.CallOption) (*QueryDeveloperVestingBalanceResponse, error)
+	// AnnualProvisions returns the current annual minting provisions value.
+	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// Inflation returns the current effective inflation rate, i.e. the annual
+	// provisions relative to the total supply of the mint denom.
+	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
+	// NextReductionTime returns the time of the next minting reduction.
+	NextReductionTime(ctx context.Context, in *QueryNextReductionTimeRequest, opts ...grpc.CallOption) (*QueryNextReductionTimeResponse, error)
+	// ProjectedSchedule returns the expected emission of the upcoming reduction
+	// periods.
+	ProjectedSchedule(ctx context.Context, in *QueryProjectedScheduleRequest, opts ...grpc.CallOption) (*QueryProjectedScheduleResponse, error)
+	// RemainingMintable returns the amount that can still be minted before the
+	// total supply of the mint denom reaches the max supply.
+	RemainingMintable(ctx context.Context, in *QueryRemainingMintableRequest, opts ...grpc.CallOption) (*QueryRemainingMintableResponse, error)
+	// ScheduledParamsChanges returns the pending minting parameter changes,
+	// ordered by activation time.
+	ScheduledParamsChanges(ctx context.Context, in *QueryScheduledParamsChangesRequest, opts ...grpc.CallOption) (*QueryScheduledParamsChangesResponse, error)
+	// MintHistory returns the recorded mints, oldest first unless the
+	// pagination is reversed.
+	MintHistory(ctx context.Context, in *QueryMintHistoryRequest, opts ...grpc.CallOption) (*QueryMintHistoryResponse, error)
+	// IncentiveStreams returns the registered incentive streams.
+	IncentiveStreams(ctx context.Context, in *QueryIncentiveStreamsRequest, opts ...grpc.CallOption) (*QueryIncentiveStreamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/acrechain.mint.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DailyProvisions(ctx context.Context, in *QueryDailyProvisionsRequest, opts ...grpc.CallOption) (*QueryDailyProvisionsResponse, error) {
+	out := new(QueryDailyProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/acrechain.mint.v1beta1.Query/DailyProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeveloperVestingBalance(ctx context.Context, in *QueryDeveloperVestingBalanceRequest, opts ...grpc.CallOption) (*QueryDeveloperVestingBalanceResponse, error) {
+	out := new(QueryDeveloperVestingBalanceResponse)
+	err := c.cc.Invoke(ctx, "/acrechain.mint.v1beta1.Query/DeveloperVestingBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error) {
+	out := new(QueryAnnualProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/acrechain.mint.v1beta1.Query/AnnualProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error) {
+	out := new(QueryInflationResponse)
+	err := c.cc.Invoke(ctx, "/acrechain.mint.v1beta1.Query/Inflation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextReductionTime(ctx context.Context, in *QueryNextReductionTimeRequest, opts ...grpc.CallOption) (*QueryNextReductionTimeResponse, error) {
+	out := new(QueryNextReductionTimeResponse)
+	err := c.cc.Invoke(ctx, "/acrechain.mint.v1beta1.Query/NextReductionTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectedSchedule(ctx context.Context, in *QueryProjectedScheduleRequest, opts ...grpc.CallOption) (*QueryProjectedScheduleResponse, error) {
+	out := new(QueryProjectedScheduleResponse)
+	err := c.cc.Invoke(ctx, "/acrechain.mint.v1beta1.Query/ProjectedSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RemainingMintable(ctx context.Context, in *QueryRemainingMintableRequest, opts ...grpc.CallOption) (*QueryRemainingMintableResponse, error) {
+	out := new(QueryRemainingMintableResponse)
+	err := c.cc.Invoke(ctx, "/acrechain.mint.v1beta1.Query/RemainingMintable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledParamsChanges(ctx context.Context, in *QueryScheduledParamsChangesRequest, opts ...grpc.CallOption) (*QueryScheduledParamsChangesResponse, error) {
+	out := new(QueryScheduledParamsChangesResponse)
+	err := c.cc.Invoke(ctx, "/acrechain.mint.v1beta1.Query/ScheduledParamsChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintHistory(ctx context.Context, in *QueryMintHistoryRequest, opts ...grpc.CallOption) (*QueryMintHistoryResponse, error) {
+	out := new(QueryMintHistoryResponse)
+	err := c.cc.Invoke(ctx, "/acrechain.mint.v1beta1.Query/MintHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IncentiveStreams(ctx context.Context, in *QueryIncentiveStreamsRequest, opts ...grpc.CallOption) (*QueryIncentiveStreamsResponse, error) {
+	out := new(QueryIncentiveStreamsResponse)
+	err := c.cc.Invoke(ctx, "/acrechain.mint.v1beta1.Query/IncentiveStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the total set of minting parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// DailyProvisions current minting epoch provisions value.
+	DailyProvisions(context.Context, *QueryDailyProvisionsRequest) (*QueryDailyProvisionsResponse, error)
+	// DeveloperVestingBalance returns the remaining balance of the developer
+	// vesting module account and its projected depletion time.
+	DeveloperVestingBalance(context.Context, *QueryDeveloperVestingBalanceRequest) (*QueryDeveloperVestingBalanceResponse, error)
+	// AnnualProvisions returns the current annual minting provisions value.
+	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// Inflation returns the current effective inflation rate, i.e. the annual
+	// provisions relative to the total supply of the mint denom.
+	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
+	// NextReductionTime returns the time of the next minting reduction.
+	NextReductionTime(context.Context, *QueryNextReductionTimeRequest) (*QueryNextReductionTimeResponse, error)
+	// ProjectedSchedule returns the expected emission of the upcoming reduction
+	// periods.
+	ProjectedSchedule(context.Context, *QueryProjectedScheduleRequest) (*QueryProjectedScheduleResponse, error)
+	// RemainingMintable returns the amount that can still be minted before the
+	// total supply of the mint denom reaches the max supply.
+	RemainingMintable(context.Context, *QueryRemainingMintableRequest) (*QueryRemainingMintableResponse, error)
+	// ScheduledParamsChanges returns the pending minting parameter changes,
+	// ordered by activation time.
+	ScheduledParamsChanges(context.Context, *QueryScheduledParamsChangesRequest) (*QueryScheduledParamsChangesResponse, error)
+	// MintHistory returns the recorded mints, oldest first unless the
+	// pagination is reversed.
+	MintHistory(context.Context, *QueryMintHistoryRequest) (*QueryMintHistoryResponse, error)
+	// IncentiveStreams returns the registered incentive streams.
+	IncentiveStreams(context.Context, *QueryIncentiveStreamsRequest) (*QueryIncentiveStreamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) DailyProvisions(ctx context.Context, req *QueryDailyProvisionsRequest) (*QueryDailyProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DailyProvisions not implemented")
+}
+func (*UnimplementedQueryServer) DeveloperVestingBalance(ctx context.Context, req *QueryDeveloperVestingBalanceRequest) (*QueryDeveloperVestingBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeveloperVestingBalance not implemented")
+}
+func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
+}
+func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflationRequest) (*QueryInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
+}
+func (*UnimplementedQueryServer) NextReductionTime(ctx context.Context, req *QueryNextReductionTimeRequest) (*QueryNextReductionTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextReductionTime not implemented")
+}
+func (*UnimplementedQueryServer) ProjectedSchedule(ctx context.Context, req *QueryProjectedScheduleRequest) (*QueryProjectedScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedSchedule not implemented")
+}
+func (*UnimplementedQueryServer) RemainingMintable(ctx context.Context, req *QueryRemainingMintableRequest) (*QueryRemainingMintableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemainingMintable not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledParamsChanges(ctx context.Context, req *QueryScheduledParamsChangesRequest) (*QueryScheduledParamsChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledParamsChanges not implemented")
+}
+func (*UnimplementedQueryServer) MintHistory(ctx context.Context, req *QueryMintHistoryRequest) (*QueryMintHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintHistory not implemented")
+}
+func (*UnimplementedQueryServer) IncentiveStreams(ctx context.Context, req *QueryIncentiveStreamsRequest) (*QueryIncentiveStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentiveStreams not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/acrechain.mint.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DailyProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDailyProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DailyProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/acrechain.mint.v1beta1.Query/DailyProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DailyProvisions(ctx, req.(*QueryDailyProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeveloperVestingBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeveloperVestingBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeveloperVestingBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/acrechain.mint.v1beta1.Query/DeveloperVestingBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeveloperVestingBalance(ctx, req.(*QueryDeveloperVestingBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAnnualProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AnnualProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/acrechain.mint.v1beta1.Query/AnnualProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AnnualProvisions(ctx, req.(*QueryAnnualProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Inflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/acrechain.mint.v1beta1.Query/Inflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Inflation(ctx, req.(*QueryInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextReductionTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextReductionTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextReductionTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/acrechain.mint.v1beta1.Query/NextReductionTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextReductionTime(ctx, req.(*QueryNextReductionTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/acrechain.mint.v1beta1.Query/ProjectedSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedSchedule(ctx, req.(*QueryProjectedScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemainingMintable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemainingMintableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemainingMintable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/acrechain.mint.v1beta1.Query/RemainingMintable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemainingMintable(ctx, req.(*QueryRemainingMintableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledParamsChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledParamsChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledParamsChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/acrechain.mint.v1beta1.Query/ScheduledParamsChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledParamsChanges(ctx, req.(*QueryScheduledParamsChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/acrechain.mint.v1beta1.Query/MintHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintHistory(ctx, req.(*QueryMintHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IncentiveStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentiveStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentiveStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/acrechain.mint.v1beta1.Query/IncentiveStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentiveStreams(ctx, req.(*QueryIncentiveStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "acrechain.mint.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "DailyProvisions",
+			Handler:    _Query_DailyProvisions_Handler,
+		},
+		{
+			MethodName: "DeveloperVestingBalance",
+			Handler:    _Query_DeveloperVestingBalance_Handler,
+		},
+		{
+			MethodName: "AnnualProvisions",
+			Handler:    _Query_AnnualProvisions_Handler,
+		},
+		{
+			MethodName: "Inflation",
+			Handler:    _Query_Inflation_Handler,
+		},
+		{
+			MethodName: "NextReductionTime",
+			Handler:    _Query_NextReductionTime_Handler,
+		},
+		{
+			MethodName: "ProjectedSchedule",
+			Handler:    _Query_ProjectedSchedule_Handler,
+		},
+		{
+			MethodName: "RemainingMintable",
+			Handler:    _Query_RemainingMintable_Handler,
+		},
+		{
+			MethodName: "ScheduledParamsChanges",
+			Handler:    _Query_ScheduledParamsChanges_Handler,
+		},
+		{
+			MethodName: "MintHistory",
+			Handler:    _Query_MintHistory_Handler,
+		},
+		{
+			MethodName: "IncentiveStreams",
+			Handler:    _Query_IncentiveStreams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "acrechain/mint/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDailyProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDailyProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDailyProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDailyProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDailyProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDailyProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DailyProvisions.Size()
+		i -= size
+		if _, err := m.DailyProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeveloperVestingBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeveloperVestingBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeveloperVestingBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeveloperVestingBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeveloperVestingBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeveloperVestingBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProjectedDepletionTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProjectedDepletionTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextReductionTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextReductionTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextReductionTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextReductionTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextReductionTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextReductionTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextReductionTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextReductionTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Periods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Periods))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedule) > 0 {
+		for iNdEx := len(m.Schedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemainingMintableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemainingMintableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemainingMintableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemainingMintableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemainingMintableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemainingMintableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RemainingMintable.Size()
+		i -= size
+		if _, err := m.RemainingMintable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledParamsChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledParamsChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledParamsChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledParamsChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledParamsChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledParamsChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentiveStreamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentiveStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentiveStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentiveStreamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentiveStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentiveStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDailyProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDailyProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DailyProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDeveloperVestingBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDeveloperVestingBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ProjectedDepletionTime != 0 {
+		n += 1 + sovQuery(uint64(m.ProjectedDepletionTime))
+	}
+	return n
+}
+
+func (m *QueryAnnualProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAnnualProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNextReductionTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNextReductionTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextReductionTime != 0 {
+		n += 1 + sovQuery(uint64(m.NextReductionTime))
+	}
+	return n
+}
+
+func (m *QueryProjectedScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Periods != 0 {
+		n += 1 + sovQuery(uint64(m.Periods))
+	}
+	return n
+}
+
+func (m *QueryProjectedScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedule) > 0 {
+		for _, e := range m.Schedule {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRemainingMintableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRemainingMintableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingMintable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryScheduledParamsChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryScheduledParamsChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMintHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncentiveStreamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIncentiveStreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDailyProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDailyProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDailyProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDailyProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDailyProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDailyProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyProvisions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DailyProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeveloperVestingBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeveloperVestingBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeveloperVestingBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeveloperVestingBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeveloperVestingBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeveloperVestingBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedDepletionTime", wireType)
+			}
+			m.ProjectedDepletionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProjectedDepletionTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextReductionTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextReductionTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextReductionTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextReductionTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextReductionTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextReductionTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextReductionTime", wireType)
+			}
+			m.NextReductionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextReductionTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = append(m.Schedule, ProjectedPeriod{})
+			if err := m.Schedule[len(m.Schedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemainingMintableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemainingMintableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemainingMintableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemainingMintableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemainingMintableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemainingMintableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingMintable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingMintable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryScheduledParamsChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledParamsChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledParamsChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *QueryDailyProvisionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryScheduledParamsChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledParamsChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledParamsChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, ScheduledParamsChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryDailyProvisionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.DailyProvisions.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMintHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -544,12 +3783,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -571,7 +3846,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMintHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -594,15 +3869,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -629,7 +3904,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, MintRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -654,7 +3966,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDailyProvisionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIncentiveStreamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -677,10 +3989,10 @@ func (m *QueryDailyProvisionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDailyProvisionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIncentiveStreamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDailyProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIncentiveStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -704,7 +4016,7 @@ func (m *QueryDailyProvisionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDailyProvisionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIncentiveStreamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -727,17 +4039,17 @@ func (m *QueryDailyProvisionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDailyProvisionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIncentiveStreamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDailyProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIncentiveStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DailyProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -747,22 +4059,23 @@ func (m *QueryDailyProvisionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DailyProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Streams = append(m.Streams, IncentiveStream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex